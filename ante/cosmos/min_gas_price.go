@@ -6,7 +6,6 @@ import (
 	"slices"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -40,7 +39,7 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	minGasPrice := mpd.feemarketKeeper.GetParams(ctx).MinGasPrice
 
 	feeCoins := feeTx.GetFee()
-	evmDenom := evmtypes.GetEVMCoinDenom()
+	evmDenom := mpd.evmKeeper.EVMAppConfig().CoinInfo.Denom
 
 	// only allow user to pass in aatom and stake native token as transaction fees
 	// allow use stake native tokens for fees is just for unit tests to pass
@@ -4,6 +4,10 @@ import (
 	"errors"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -53,6 +57,38 @@ func checkDisabledCreateCall(
 	return nil
 }
 
+// ValidateSetCodeTx validates an EIP-7702 set code transaction. It checks the
+// following requirements:
+// - the Prague fork MUST be enabled
+// - the transaction MUST NOT be a contract creation
+// - the authorization list MUST NOT be empty
+//
+// The authorizations themselves are checked during the execution, which skips
+// the invalid ones. The transactions of other types are not checked.
+func ValidateSetCodeTx(
+	rules params.Rules,
+	txData evmtypes.TxData,
+) error {
+	if txData.TxType() != ethtypes.SetCodeTxType {
+		return nil
+	}
+	if !rules.IsPrague {
+		return errorsmod.Wrap(core.ErrTxTypeNotSupported, "set code transactions require the Prague fork")
+	}
+	if txData.GetTo() == nil {
+		return core.ErrSetCodeTxCreate
+	}
+
+	setCodeTx, ok := txData.(*evmtypes.SetCodeTx)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid set code transaction type %T", txData)
+	}
+	if len(setCodeTx.GetAuthorizationList()) == 0 {
+		return core.ErrEmptyAuthList
+	}
+	return nil
+}
+
 // ValidateTx validates an Ethereum specific transaction type and returns an error if invalid.
 //
 // FIXME: this shouldn't be required if the tx was an Ethereum transaction type.
@@ -103,8 +139,8 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 // CheckTxFee checks if the Amount and GasLimit fields of the txFeeInfo input
 // are equal to the txFee coins and the txGasLimit value.
 // The function expects txFeeInfo to contains coins in the original decimal
-// representation.
-func CheckTxFee(txFeeInfo *tx.Fee, txFee *big.Int, txGasLimit uint64) error {
+// representation, with the fees in the extended denom of the EVM coin.
+func CheckTxFee(txFeeInfo *tx.Fee, txFee *big.Int, txGasLimit uint64, evmExtendedDenom string) error {
 	if txFeeInfo == nil {
 		return nil
 	}
@@ -113,7 +149,6 @@ func CheckTxFee(txFeeInfo *tx.Fee, txFee *big.Int, txGasLimit uint64) error {
 	// to MsgEthereumTx, which is a sdk tx. Here, the denom will be a uatom, not aatom.
 	// BuildTx then converts uatom to aatom meaning that logic that interacts with the user
 	// will use uatom and internal processing such as the ante handler will operate based on aatom.
-	evmExtendedDenom := evmtypes.GetEVMCoinExtendedDenom()
 	if !txFeeInfo.Amount.AmountOf(evmExtendedDenom).Equal(sdkmath.NewIntFromBigInt(txFee)) {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid AuthInfo Fee Amount (%s != %s)", txFeeInfo.Amount, txFee)
 	}
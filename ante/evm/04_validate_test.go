@@ -0,0 +1,111 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/ante/evm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestValidateSetCodeTx(t *testing.T) {
+	chainID := big.NewInt(9001)
+	to := common.HexToAddress("0x1")
+	prague := params.Rules{IsPrague: true}
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signAuth := func(chainID uint64, nonce uint64) ethtypes.SetCodeAuthorization {
+		auth, err := ethtypes.SignSetCode(key, ethtypes.SetCodeAuthorization{
+			ChainID: *uint256.NewInt(chainID),
+			Address: common.HexToAddress("0x2"),
+			Nonce:   nonce,
+		})
+		require.NoError(t, err)
+		return auth
+	}
+	newTxData := func(to *common.Address, authList []ethtypes.SetCodeAuthorization) evmtypes.TxData {
+		ethTx := &ethtypes.SetCodeTx{
+			ChainID:   uint256.MustFromBig(chainID),
+			GasTipCap: uint256.NewInt(1),
+			GasFeeCap: uint256.NewInt(1),
+			Gas:       100_000,
+			Value:     uint256.NewInt(0),
+			AuthList:  authList,
+		}
+		if to != nil {
+			ethTx.To = *to
+		}
+		txData, err := evmtypes.NewSetCodeTx(ethtypes.NewTx(ethTx))
+		require.NoError(t, err)
+		if to == nil {
+			txData.To = ""
+		}
+		return txData
+	}
+
+	invalidSig := signAuth(0, 0)
+	invalidSig.R = *uint256.NewInt(0)
+
+	testCases := []struct {
+		name   string
+		rules  params.Rules
+		txData evmtypes.TxData
+		expErr error
+	}{
+		{
+			name:   "fail - before Prague",
+			rules:  params.Rules{},
+			txData: newTxData(&to, []ethtypes.SetCodeAuthorization{signAuth(0, 0)}),
+			expErr: core.ErrTxTypeNotSupported,
+		},
+		{
+			name:   "fail - contract creation",
+			rules:  prague,
+			txData: newTxData(nil, []ethtypes.SetCodeAuthorization{signAuth(0, 0)}),
+			expErr: core.ErrSetCodeTxCreate,
+		},
+		{
+			name:   "fail - empty authorization list",
+			rules:  prague,
+			txData: newTxData(&to, nil),
+			expErr: core.ErrEmptyAuthList,
+		},
+		{
+			name:  "pass - invalid authorizations are left to the execution",
+			rules: prague,
+			txData: newTxData(&to, []ethtypes.SetCodeAuthorization{
+				signAuth(1, 0),
+				signAuth(0, ^uint64(0)),
+				invalidSig,
+				signAuth(chainID.Uint64(), 0),
+				signAuth(0, 1),
+			}),
+		},
+		{
+			name:   "pass - other transaction types are not checked",
+			rules:  params.Rules{},
+			txData: &evmtypes.DynamicFeeTx{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := evm.ValidateSetCodeTx(tc.rules, tc.txData)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
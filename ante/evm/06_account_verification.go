@@ -2,6 +2,7 @@ package evm
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	"github.com/cosmos/evm/x/vm/keeper"
@@ -18,17 +19,21 @@ import (
 // VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
-// - from address is NOT an EOA
-// - account balance is lower than the transaction cost
+// - from address is NOT an EOA. Accounts with an EIP-7702 delegation designator
+// as code are considered EOAs.
+// - account balance is lower than the transaction cost, unless the fees are
+// paid by a fee payer, in which case the value is checked by CanTransfer.
 func VerifyAccountBalance(
 	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
 	accountKeeper anteinterfaces.AccountKeeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+	sponsored bool,
 ) error {
 	// Only EOA are allowed to send transactions.
-	if account != nil && account.IsContract() {
+	if account != nil && account.IsContract() && !isDelegated(ctx, evmKeeper, account) {
 		return errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
@@ -41,9 +46,21 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
+	if sponsored {
+		return nil
+	}
+
 	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
 
 	return nil
 }
+
+// isDelegated returns true if the account code is an EIP-7702 delegation
+// designator.
+func isDelegated(ctx sdk.Context, evmKeeper anteinterfaces.EVMKeeper, account *statedb.Account) bool {
+	code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
+	_, ok := ethtypes.ParseDelegation(code)
+	return ok
+}
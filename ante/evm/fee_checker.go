@@ -25,7 +25,7 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(k anteinterfaces.FeeMarketKeeper) authante.TxFeeChecker {
+func NewDynamicFeeChecker(evmKeeper anteinterfaces.EVMKeeper, k anteinterfaces.FeeMarketKeeper) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
@@ -37,8 +37,8 @@ func NewDynamicFeeChecker(k anteinterfaces.FeeMarketKeeper) authante.TxFeeChecke
 			// genesis transactions: fallback to min-gas-price logic
 			return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
 		}
-		denom := evmtypes.GetEVMCoinDenom()
-		ethCfg := evmtypes.GetEthChainConfig()
+		denom := evmKeeper.EVMAppConfig().CoinInfo.Denom
+		ethCfg := evmKeeper.GetEthChainConfig(ctx)
 
 		return FeeChecker(ctx, k, denom, ethCfg, feeTx)
 	}
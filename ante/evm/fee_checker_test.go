@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -25,7 +26,25 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
-var _ anteinterfaces.FeeMarketKeeper = MockFeemarketKeeper{}
+var (
+	_ anteinterfaces.FeeMarketKeeper = MockFeemarketKeeper{}
+	_ anteinterfaces.EVMKeeper       = MockChainConfigKeeper{}
+)
+
+// MockChainConfigKeeper only implements the chain config and app config
+// getters of the EVM keeper used by the fee checker.
+type MockChainConfigKeeper struct {
+	anteinterfaces.EVMKeeper
+	ChainConfig *params.ChainConfig
+}
+
+func (m MockChainConfigKeeper) GetEthChainConfig(_ sdk.Context) *params.ChainConfig {
+	return m.ChainConfig
+}
+
+func (m MockChainConfigKeeper) EVMAppConfig() *evmtypes.EVMAppConfig {
+	return evmtypes.GlobalEVMAppConfig()
+}
 
 type MockFeemarketKeeper struct {
 	BaseFee math.LegacyDec
@@ -258,7 +277,8 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			} else {
 				cfg.LondonBlock = big.NewInt(0)
 			}
-			fees, priority, err := evm.NewDynamicFeeChecker(tc.keeper)(tc.ctx, tc.buildTx())
+			evmKeeper := MockChainConfigKeeper{ChainConfig: cfg}
+			fees, priority, err := evm.NewDynamicFeeChecker(evmKeeper, tc.keeper)(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
@@ -24,6 +24,7 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	feegrantKeeper  anteinterfaces.FeegrantKeeper
 	maxGasWanted    uint64
 }
 
@@ -33,16 +34,21 @@ type MonoDecorator struct {
 // This runs all the default checks for EVM transactions enable through Cosmos EVM.
 // Any partner chains can use this in their ante handler logic and build additional EVM
 // decorators using the returned DecoratorUtils
+//
+// The feegrant keeper pays the fees of the transactions naming a fee payer. It
+// can be nil, in which case such transactions are rejected.
 func NewEVMMonoDecorator(
 	accountKeeper anteinterfaces.AccountKeeper,
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
 	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper anteinterfaces.FeegrantKeeper,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
 		accountKeeper:   accountKeeper,
 		feeMarketKeeper: feeMarketKeeper,
 		evmKeeper:       evmKeeper,
+		feegrantKeeper:  feegrantKeeper,
 		maxGasWanted:    maxGasWanted,
 	}
 }
@@ -61,8 +67,6 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 	}
 
-	evmDenom := evmtypes.GetEVMCoinDenom()
-
 	// 1. setup ctx
 	ctx, err = SetupContextAndResetTransientGas(ctx, tx, md.evmKeeper)
 	if err != nil {
@@ -75,166 +79,215 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	// NOTE: the protocol does not support multiple EVM messages currently so
-	// this loop will complete after the first message.
+	// The messages of the transaction are executed as an atomic batch, each of
+	// them is checked and pays its fees on top of the state left by the
+	// previous ones.
 	msgs := tx.GetMsgs()
-	if len(msgs) != 1 {
-		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "expected 1 message, got %d", len(msgs))
+	if len(msgs) == 0 {
+		return ctx, errorsmod.Wrap(errortypes.ErrUnknownRequest, "invalid transaction. Transaction without messages")
 	}
-	msgIndex := 0
+	md.evmKeeper.SetTxMsgCountTransient(ctx, uint64(len(msgs))) //nolint:gosec // G115
 
-	ethMsg, txData, err := evmtypes.UnpackEthMsg(msgs[msgIndex])
+	// The fees of the messages are paid by the fee payer, if any, out of the
+	// fee allowances granted to their senders. Its signature over the
+	// messages binds its allowances to them.
+	feePayer, err := evmtypes.GetFeePayer(tx)
 	if err != nil {
 		return ctx, err
 	}
-
-	feeAmt := txData.Fee()
-	gas := txData.GetGas()
-	fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
-	gasLimit := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
-
-	// TODO: computation for mempool and global fee can be made using only
-	// the price instead of the fee. This would save some computation.
-	//
-	// 2. mempool inclusion fee
-	if ctx.IsCheckTx() && !simulate {
-		// FIX: Mempool dec should be converted
-		if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
+	if feePayer != nil {
+		if md.feegrantKeeper == nil {
+			return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+		}
+		if err := evmtypes.VerifyFeePayerSig(tx, decUtils.ChainID, feePayer); err != nil {
 			return ctx, err
 		}
 	}
+	md.evmKeeper.SetFeePayerTransient(ctx, feePayer)
 
-	if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
-		// If the base fee is not empty, we compute the effective gas price
-		// according to current base fee price. The gas limit is specified
-		// by the user, while the price is given by the minimum between the
-		// max price paid for the entire tx, and the sum between the price
-		// for the tip and the base fee.
-		feeAmt = txData.EffectiveFee(decUtils.BaseFee)
-		fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
-	}
+	for msgIndex, msg := range msgs {
+		ethMsg, txData, err := evmtypes.UnpackEthMsg(msg)
+		if err != nil {
+			return ctx, err
+		}
 
-	// 3. min gas price (global min fee)
-	if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
-		return ctx, err
-	}
+		feeAmt := txData.Fee()
+		gas := txData.GetGas()
+		fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
+		gasLimit := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
+
+		// TODO: computation for mempool and global fee can be made using only
+		// the price instead of the fee. This would save some computation.
+		//
+		// 2. mempool inclusion fee
+		if ctx.IsCheckTx() && !simulate {
+			// FIX: Mempool dec should be converted
+			if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
+				return ctx, err
+			}
+		}
 
-	// 4. validate msg contents
-	if err := ValidateMsg(
-		decUtils.EvmParams,
-		txData,
-		ethMsg.GetFrom(),
-	); err != nil {
-		return ctx, err
-	}
+		if (txData.TxType() == ethtypes.DynamicFeeTxType || txData.TxType() == ethtypes.SetCodeTxType) && decUtils.BaseFee != nil {
+			// If the base fee is not empty, we compute the effective gas price
+			// according to current base fee price. The gas limit is specified
+			// by the user, while the price is given by the minimum between the
+			// max price paid for the entire tx, and the sum between the price
+			// for the tip and the base fee.
+			feeAmt = txData.EffectiveFee(decUtils.BaseFee)
+			fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
+		}
 
-	// 5. signature verification
-	if err := SignatureVerification(
-		ethMsg,
-		decUtils.Signer,
-		decUtils.EvmParams.AllowUnprotectedTxs,
-	); err != nil {
-		return ctx, err
-	}
+		// 3. min gas price (global min fee)
+		if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
+			return ctx, err
+		}
 
-	from := ethMsg.GetFrom()
-	fromAddr := common.BytesToAddress(from)
-
-	// 6. account balance verification
-	// We get the account with the balance from the EVM keeper because it is
-	// using a wrapper of the bank keeper as a dependency to scale all
-	// balances to 18 decimals.
-	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
-		ctx,
-		md.accountKeeper,
-		account,
-		fromAddr,
-		txData,
-	); err != nil {
-		return ctx, err
-	}
+		// 4. validate msg contents
+		if err := ValidateMsg(
+			decUtils.EvmParams,
+			txData,
+			ethMsg.GetFrom(),
+		); err != nil {
+			return ctx, err
+		}
 
-	// 7. can transfer
-	coreMsg, err := ethMsg.AsMessage(decUtils.BaseFee)
-	if err != nil {
-		return ctx, errorsmod.Wrapf(
-			err,
-			"failed to create an ethereum core.Message from signer %T", decUtils.Signer,
+		if err := ValidateSetCodeTx(
+			decUtils.Rules,
+			txData,
+		); err != nil {
+			return ctx, err
+		}
+
+		// 5. signature verification
+		if err := SignatureVerification(
+			ethMsg,
+			decUtils.Signer,
+			decUtils.EvmParams.AllowUnprotectedTxs,
+		); err != nil {
+			return ctx, err
+		}
+
+		from := ethMsg.GetFrom()
+		fromAddr := common.BytesToAddress(from)
+		sponsored := feePayer != nil && !feePayer.Equals(from)
+
+		// 6. account balance verification
+		// We get the account with the balance from the EVM keeper because it is
+		// using a wrapper of the bank keeper as a dependency to scale all
+		// balances to 18 decimals.
+		account := md.evmKeeper.GetAccount(ctx, fromAddr)
+		if err := VerifyAccountBalance(
+			ctx,
+			md.evmKeeper,
+			md.accountKeeper,
+			account,
+			fromAddr,
+			txData,
+			sponsored,
+		); err != nil {
+			return ctx, err
+		}
+
+		// 7. can transfer
+		coreMsg, err := ethMsg.AsMessage(decUtils.BaseFee)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(
+				err,
+				"failed to create an ethereum core.Message from signer %T", decUtils.Signer,
+			)
+		}
+
+		if err := CanTransfer(
+			ctx,
+			md.evmKeeper,
+			*coreMsg,
+			decUtils.BaseFee,
+			decUtils.EvmParams,
+			decUtils.Rules.IsLondon,
+		); err != nil {
+			return ctx, err
+		}
+
+		// 8. gas consumption
+		msgFees, err := evmkeeper.VerifyFee(
+			txData,
+			decUtils.EvmCoinInfo.Denom,
+			decUtils.BaseFee,
+			decUtils.Rules.IsHomestead,
+			decUtils.Rules.IsIstanbul,
+			decUtils.Rules.IsShanghai,
+			ctx.IsCheckTx(),
 		)
-	}
+		if err != nil {
+			return ctx, err
+		}
 
-	if err := CanTransfer(
-		ctx,
-		md.evmKeeper,
-		*coreMsg,
-		decUtils.BaseFee,
-		decUtils.EvmParams,
-		decUtils.Rules.IsLondon,
-	); err != nil {
-		return ctx, err
-	}
+		msgFeePayer := from
+		if sponsored {
+			// the allowances are in the extended denom the fees are deducted in
+			if err := md.feegrantKeeper.UseGrantedFees(
+				ctx,
+				feePayer,
+				from,
+				decUtils.EvmCoinInfo.ConvertCoinsDenomToExtendedDenom(msgFees),
+				[]sdk.Msg{msg},
+			); err != nil {
+				return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feePayer, from)
+			}
+			msgFeePayer = feePayer
+		}
 
-	// 8. gas consumption
-	msgFees, err := evmkeeper.VerifyFee(
-		txData,
-		evmDenom,
-		decUtils.BaseFee,
-		decUtils.Rules.IsHomestead,
-		decUtils.Rules.IsIstanbul,
-		decUtils.Rules.IsShanghai,
-		ctx.IsCheckTx(),
-	)
-	if err != nil {
-		return ctx, err
-	}
+		err = ConsumeFeesAndEmitEvent(
+			ctx,
+			md.evmKeeper,
+			msgFees,
+			msgFeePayer,
+		)
+		if err != nil {
+			return ctx, err
+		}
 
-	err = ConsumeFeesAndEmitEvent(
-		ctx,
-		md.evmKeeper,
-		msgFees,
-		from,
-	)
-	if err != nil {
-		return ctx, err
-	}
+		gasWanted := UpdateCumulativeGasWanted(
+			ctx,
+			gas,
+			md.maxGasWanted,
+			decUtils.GasWanted,
+		)
+		decUtils.GasWanted = gasWanted
 
-	gasWanted := UpdateCumulativeGasWanted(
-		ctx,
-		gas,
-		md.maxGasWanted,
-		decUtils.GasWanted,
-	)
-	decUtils.GasWanted = gasWanted
-
-	minPriority := GetMsgPriority(
-		txData,
-		decUtils.MinPriority,
-		decUtils.BaseFee,
-	)
-	decUtils.MinPriority = minPriority
-
-	// Update the fee to be paid for the tx adding the fee specified for the
-	// current message.
-	decUtils.TxFee.Add(decUtils.TxFee, txData.Fee())
-
-	// Update the transaction gas limit adding the gas specified in the
-	// current message.
-	decUtils.TxGasLimit += gas
-
-	// 9. increment sequence
-	acc := md.accountKeeper.GetAccount(ctx, from)
-	if acc == nil {
-		// safety check: shouldn't happen
-		return ctx, errorsmod.Wrapf(
-			errortypes.ErrUnknownAddress,
-			"account %s does not exist",
-			from,
+		minPriority := GetMsgPriority(
+			txData,
+			decUtils.MinPriority,
+			decUtils.BaseFee,
 		)
-	}
+		decUtils.MinPriority = minPriority
+
+		// Update the fee to be paid for the tx adding the fee specified for the
+		// current message.
+		decUtils.TxFee.Add(decUtils.TxFee, txData.Fee())
+
+		// Update the transaction gas limit adding the gas specified in the
+		// current message.
+		decUtils.TxGasLimit += gas
+
+		// 9. increment sequence
+		acc := md.accountKeeper.GetAccount(ctx, from)
+		if acc == nil {
+			// safety check: shouldn't happen
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrUnknownAddress,
+				"account %s does not exist",
+				from,
+			)
+		}
 
-	if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
-		return ctx, err
+		if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+			return ctx, err
+		}
+
+		// 11. emit events
+		txIdx := uint64(msgIndex) //nolint:gosec // G115
+		EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
 	}
 
 	// 10. gas wanted
@@ -242,11 +295,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	// 11. emit events
-	txIdx := uint64(msgIndex) //nolint:gosec // G115
-	EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
-
-	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
+	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit, decUtils.EvmCoinInfo.ExtendedDenom); err != nil {
 		return ctx, err
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
@@ -65,9 +66,14 @@ func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
-func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int           { return big.NewInt(0) }
-func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
-func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64    { return 0 }
+func (k *ExtendedEVMKeeper) GetEthChainConfig(_ sdk.Context) *params.ChainConfig {
+	return evmsdktypes.GetEthChainConfig()
+}
+func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int                    { return big.NewInt(0) }
+func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec          { return math.LegacyZeroDec() }
+func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64             { return 0 }
+func (k *ExtendedEVMKeeper) SetTxMsgCountTransient(_ sdk.Context, _ uint64)       {}
+func (k *ExtendedEVMKeeper) SetFeePayerTransient(_ sdk.Context, _ sdk.AccAddress) {}
 
 // only methods called by EVMMonoDecorator
 type MockFeeMarketKeeper struct{}
@@ -85,15 +91,23 @@ func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec { return m
 // matches the actual signatures
 type MockAccountKeeper struct {
 	FundedAddr sdk.AccAddress
+	sequences  map[string]uint64
+}
+
+func NewMockAccountKeeper(fundedAddr sdk.AccAddress) MockAccountKeeper {
+	return MockAccountKeeper{FundedAddr: fundedAddr, sequences: make(map[string]uint64)}
 }
 
 func (m MockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
 	if m.FundedAddr != nil && addr.Equals(m.FundedAddr) {
-		return &authtypes.BaseAccount{Address: addr.String()}
+		return &authtypes.BaseAccount{Address: addr.String(), Sequence: m.sequences[addr.String()]}
 	}
 	return nil
 }
-func (m MockAccountKeeper) SetAccount(_ context.Context, _ sdk.AccountI) {}
+
+func (m MockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	m.sequences[acc.GetAddress().String()] = acc.GetSequence()
+}
 func (m MockAccountKeeper) NewAccountWithAddress(_ context.Context, _ sdk.AccAddress) sdk.AccountI {
 	return nil
 }
@@ -168,7 +182,7 @@ func TestMonoDecorator(t *testing.T) {
 			"",
 		},
 		{
-			"failure with two evm txs",
+			"success with two evm txs",
 			true,
 			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
 				args1 := &evmsdktypes.EvmTxArgs{
@@ -188,7 +202,30 @@ func TestMonoDecorator(t *testing.T) {
 					signMsgEthereumTx(t, privKey, args2),
 				}
 			},
-			"expected 1 message, got 2",
+			"",
+		},
+		{
+			"failure with two evm txs with the same nonce",
+			true,
+			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
+				args1 := &evmsdktypes.EvmTxArgs{
+					Nonce:    0,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(1),
+					Input:    []byte("test"),
+				}
+				args2 := &evmsdktypes.EvmTxArgs{
+					Nonce:    0,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(1),
+					Input:    []byte("test2"),
+				}
+				return []*evmsdktypes.MsgEthereumTx{
+					signMsgEthereumTx(t, privKey, args1),
+					signMsgEthereumTx(t, privKey, args2),
+				}
+			},
+			"invalid nonce; got 0, expected 1",
 		},
 	}
 
@@ -196,9 +233,9 @@ func TestMonoDecorator(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			privKey, _ := ethsecp256k1.GenerateKey()
 			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
-			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
+			accountKeeper := NewMockAccountKeeper(cosmosAddr)
 
-			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, 0)
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, nil, 0)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
 
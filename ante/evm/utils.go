@@ -20,7 +20,9 @@ import (
 // throughout the verification of an Ethereum transaction.
 type DecoratorUtils struct {
 	EvmParams          evmtypes.Params
+	EvmCoinInfo        evmtypes.EvmCoinInfo
 	Rules              params.Rules
+	ChainID            *big.Int
 	Signer             ethtypes.Signer
 	BaseFee            *big.Int
 	MempoolMinGasPrice sdkmath.LegacyDec
@@ -45,8 +47,8 @@ func NewMonoDecoratorUtils(
 	ek anteinterfaces.EVMKeeper,
 ) (*DecoratorUtils, error) {
 	evmParams := ek.GetParams(ctx)
-	ethCfg := evmtypes.GetEthChainConfig()
-	evmDenom := evmtypes.GetEVMCoinDenom()
+	ethCfg := ek.GetEthChainConfig(ctx)
+	coinInfo := ek.EVMAppConfig().CoinInfo
 	blockHeight := big.NewInt(ctx.BlockHeight())
 	rules := ethCfg.Rules(blockHeight, true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	baseFee := ek.GetBaseFee(ctx)
@@ -62,11 +64,13 @@ func NewMonoDecoratorUtils(
 
 	// Mempool gas price should be scaled to the 18 decimals representation.
 	// If it is already a 18 decimal token, this is a no-op.
-	mempoolMinGasPrice := evmtypes.ConvertAmountTo18DecimalsLegacy(ctx.MinGasPrices().AmountOf(evmDenom))
+	mempoolMinGasPrice := coinInfo.ConvertAmountTo18DecimalsLegacy(ctx.MinGasPrices().AmountOf(coinInfo.Denom))
 
 	return &DecoratorUtils{
 		EvmParams:          evmParams,
+		EvmCoinInfo:        coinInfo,
 		Rules:              rules,
+		ChainID:            ethCfg.ChainID,
 		Signer:             ethtypes.MakeSigner(ethCfg, blockHeight, uint64(ctx.BlockTime().Unix())), //#nosec G115 -- int overflow is not a concern here
 		BaseFee:            baseFee,
 		MempoolMinGasPrice: mempoolMinGasPrice,
@@ -24,6 +24,12 @@ type AccountKeeper interface {
 	TryAddUnorderedNonce(ctx sdk.Context, sender []byte, timestamp time.Time) error
 }
 
+// FeegrantKeeper defines the expected x/feegrant keeper used to pay the fees of
+// sponsored transactions.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
 type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
@@ -29,7 +30,11 @@ type EVMKeeper interface {
 	GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	SetTxMsgCountTransient(ctx sdk.Context, count uint64)
+	SetFeePayerTransient(ctx sdk.Context, feePayer sdk.AccAddress)
 	GetParams(ctx sdk.Context) evmtypes.Params
+	// GetEthChainConfig returns the chain config of the current block
+	GetEthChainConfig(ctx sdk.Context) *params.ChainConfig
 	// GetBaseFee returns the BaseFee param from the fee market module
 	// adapted according to the evm denom decimals
 	GetBaseFee(ctx sdk.Context) *big.Int
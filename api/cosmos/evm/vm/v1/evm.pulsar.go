@@ -160,6 +160,8 @@ var (
 	fd_Params_evm_channels              protoreflect.FieldDescriptor
 	fd_Params_access_control            protoreflect.FieldDescriptor
 	fd_Params_active_static_precompiles protoreflect.FieldDescriptor
+	fd_Params_dev_mode                  protoreflect.FieldDescriptor
+	fd_Params_dev_authority             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -171,6 +173,8 @@ func init() {
 	fd_Params_evm_channels = md_Params.Fields().ByName("evm_channels")
 	fd_Params_access_control = md_Params.Fields().ByName("access_control")
 	fd_Params_active_static_precompiles = md_Params.Fields().ByName("active_static_precompiles")
+	fd_Params_dev_mode = md_Params.Fields().ByName("dev_mode")
+	fd_Params_dev_authority = md_Params.Fields().ByName("dev_authority")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -274,6 +278,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DevMode != false {
+		value := protoreflect.ValueOfBool(x.DevMode)
+		if !f(fd_Params_dev_mode, value) {
+			return
+		}
+	}
+	if x.DevAuthority != "" {
+		value := protoreflect.ValueOfString(x.DevAuthority)
+		if !f(fd_Params_dev_authority, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -301,6 +317,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AccessControl != nil
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		return len(x.ActiveStaticPrecompiles) != 0
+	case "cosmos.evm.vm.v1.Params.dev_mode":
+		return x.DevMode != false
+	case "cosmos.evm.vm.v1.Params.dev_authority":
+		return x.DevAuthority != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -329,6 +349,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AccessControl = nil
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		x.ActiveStaticPrecompiles = nil
+	case "cosmos.evm.vm.v1.Params.dev_mode":
+		x.DevMode = false
+	case "cosmos.evm.vm.v1.Params.dev_authority":
+		x.DevAuthority = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -372,6 +396,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_9_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Params.dev_mode":
+		value := x.DevMode
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.Params.dev_authority":
+		value := x.DevAuthority
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -410,6 +440,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_9_list)
 		x.ActiveStaticPrecompiles = *clv.list
+	case "cosmos.evm.vm.v1.Params.dev_mode":
+		x.DevMode = value.Bool()
+	case "cosmos.evm.vm.v1.Params.dev_authority":
+		x.DevAuthority = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -457,6 +491,10 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field evm_denom of message cosmos.evm.vm.v1.Params is not mutable"))
 	case "cosmos.evm.vm.v1.Params.allow_unprotected_txs":
 		panic(fmt.Errorf("field allow_unprotected_txs of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.dev_mode":
+		panic(fmt.Errorf("field dev_mode of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.dev_authority":
+		panic(fmt.Errorf("field dev_authority of message cosmos.evm.vm.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -486,6 +524,10 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "cosmos.evm.vm.v1.Params.dev_mode":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.Params.dev_authority":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -585,6 +627,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.DevMode {
+			n += 2
+		}
+		l = len(x.DevAuthority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -614,6 +663,23 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DevAuthority) > 0 {
+			i -= len(x.DevAuthority)
+			copy(dAtA[i:], x.DevAuthority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DevAuthority)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.DevMode {
+			i--
+			if x.DevMode {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
 		if len(x.ActiveStaticPrecompiles) > 0 {
 			for iNdEx := len(x.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ActiveStaticPrecompiles[iNdEx])
@@ -961,6 +1027,58 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.ActiveStaticPrecompiles = append(x.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DevMode", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DevMode = bool(v != 0)
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DevAuthority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DevAuthority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7168,45 +7286,35 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TraceConfig                    protoreflect.MessageDescriptor
-	fd_TraceConfig_tracer             protoreflect.FieldDescriptor
-	fd_TraceConfig_timeout            protoreflect.FieldDescriptor
-	fd_TraceConfig_reexec             protoreflect.FieldDescriptor
-	fd_TraceConfig_disable_stack      protoreflect.FieldDescriptor
-	fd_TraceConfig_disable_storage    protoreflect.FieldDescriptor
-	fd_TraceConfig_debug              protoreflect.FieldDescriptor
-	fd_TraceConfig_limit              protoreflect.FieldDescriptor
-	fd_TraceConfig_overrides          protoreflect.FieldDescriptor
-	fd_TraceConfig_enable_memory      protoreflect.FieldDescriptor
-	fd_TraceConfig_enable_return_data protoreflect.FieldDescriptor
-	fd_TraceConfig_tracer_json_config protoreflect.FieldDescriptor
+	md_SetCodeAuthorization          protoreflect.MessageDescriptor
+	fd_SetCodeAuthorization_chain_id protoreflect.FieldDescriptor
+	fd_SetCodeAuthorization_address  protoreflect.FieldDescriptor
+	fd_SetCodeAuthorization_nonce    protoreflect.FieldDescriptor
+	fd_SetCodeAuthorization_v        protoreflect.FieldDescriptor
+	fd_SetCodeAuthorization_r        protoreflect.FieldDescriptor
+	fd_SetCodeAuthorization_s        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_TraceConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TraceConfig")
-	fd_TraceConfig_tracer = md_TraceConfig.Fields().ByName("tracer")
-	fd_TraceConfig_timeout = md_TraceConfig.Fields().ByName("timeout")
-	fd_TraceConfig_reexec = md_TraceConfig.Fields().ByName("reexec")
-	fd_TraceConfig_disable_stack = md_TraceConfig.Fields().ByName("disable_stack")
-	fd_TraceConfig_disable_storage = md_TraceConfig.Fields().ByName("disable_storage")
-	fd_TraceConfig_debug = md_TraceConfig.Fields().ByName("debug")
-	fd_TraceConfig_limit = md_TraceConfig.Fields().ByName("limit")
-	fd_TraceConfig_overrides = md_TraceConfig.Fields().ByName("overrides")
-	fd_TraceConfig_enable_memory = md_TraceConfig.Fields().ByName("enable_memory")
-	fd_TraceConfig_enable_return_data = md_TraceConfig.Fields().ByName("enable_return_data")
-	fd_TraceConfig_tracer_json_config = md_TraceConfig.Fields().ByName("tracer_json_config")
+	md_SetCodeAuthorization = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("SetCodeAuthorization")
+	fd_SetCodeAuthorization_chain_id = md_SetCodeAuthorization.Fields().ByName("chain_id")
+	fd_SetCodeAuthorization_address = md_SetCodeAuthorization.Fields().ByName("address")
+	fd_SetCodeAuthorization_nonce = md_SetCodeAuthorization.Fields().ByName("nonce")
+	fd_SetCodeAuthorization_v = md_SetCodeAuthorization.Fields().ByName("v")
+	fd_SetCodeAuthorization_r = md_SetCodeAuthorization.Fields().ByName("r")
+	fd_SetCodeAuthorization_s = md_SetCodeAuthorization.Fields().ByName("s")
 }
 
-var _ protoreflect.Message = (*fastReflection_TraceConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_SetCodeAuthorization)(nil)
 
-type fastReflection_TraceConfig TraceConfig
+type fastReflection_SetCodeAuthorization SetCodeAuthorization
 
-func (x *TraceConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TraceConfig)(x)
+func (x *SetCodeAuthorization) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SetCodeAuthorization)(x)
 }
 
-func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
+func (x *SetCodeAuthorization) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7218,43 +7326,43 @@ func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TraceConfig_messageType fastReflection_TraceConfig_messageType
-var _ protoreflect.MessageType = fastReflection_TraceConfig_messageType{}
+var _fastReflection_SetCodeAuthorization_messageType fastReflection_SetCodeAuthorization_messageType
+var _ protoreflect.MessageType = fastReflection_SetCodeAuthorization_messageType{}
 
-type fastReflection_TraceConfig_messageType struct{}
+type fastReflection_SetCodeAuthorization_messageType struct{}
 
-func (x fastReflection_TraceConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TraceConfig)(nil)
+func (x fastReflection_SetCodeAuthorization_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SetCodeAuthorization)(nil)
 }
-func (x fastReflection_TraceConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_TraceConfig)
+func (x fastReflection_SetCodeAuthorization_messageType) New() protoreflect.Message {
+	return new(fastReflection_SetCodeAuthorization)
 }
-func (x fastReflection_TraceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TraceConfig
+func (x fastReflection_SetCodeAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SetCodeAuthorization
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TraceConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_TraceConfig
+func (x *fastReflection_SetCodeAuthorization) Descriptor() protoreflect.MessageDescriptor {
+	return md_SetCodeAuthorization
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TraceConfig) Type() protoreflect.MessageType {
-	return _fastReflection_TraceConfig_messageType
+func (x *fastReflection_SetCodeAuthorization) Type() protoreflect.MessageType {
+	return _fastReflection_SetCodeAuthorization_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TraceConfig) New() protoreflect.Message {
-	return new(fastReflection_TraceConfig)
+func (x *fastReflection_SetCodeAuthorization) New() protoreflect.Message {
+	return new(fastReflection_SetCodeAuthorization)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
-	return (*TraceConfig)(x)
+func (x *fastReflection_SetCodeAuthorization) Interface() protoreflect.ProtoMessage {
+	return (*SetCodeAuthorization)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7262,70 +7370,40 @@ func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tracer != "" {
-		value := protoreflect.ValueOfString(x.Tracer)
-		if !f(fd_TraceConfig_tracer, value) {
-			return
-		}
-	}
-	if x.Timeout != "" {
-		value := protoreflect.ValueOfString(x.Timeout)
-		if !f(fd_TraceConfig_timeout, value) {
-			return
-		}
-	}
-	if x.Reexec != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Reexec)
-		if !f(fd_TraceConfig_reexec, value) {
-			return
-		}
-	}
-	if x.DisableStack != false {
-		value := protoreflect.ValueOfBool(x.DisableStack)
-		if !f(fd_TraceConfig_disable_stack, value) {
-			return
-		}
-	}
-	if x.DisableStorage != false {
-		value := protoreflect.ValueOfBool(x.DisableStorage)
-		if !f(fd_TraceConfig_disable_storage, value) {
-			return
-		}
-	}
-	if x.Debug != false {
-		value := protoreflect.ValueOfBool(x.Debug)
-		if !f(fd_TraceConfig_debug, value) {
+func (x *fastReflection_SetCodeAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_SetCodeAuthorization_chain_id, value) {
 			return
 		}
 	}
-	if x.Limit != int32(0) {
-		value := protoreflect.ValueOfInt32(x.Limit)
-		if !f(fd_TraceConfig_limit, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_SetCodeAuthorization_address, value) {
 			return
 		}
 	}
-	if x.Overrides != nil {
-		value := protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
-		if !f(fd_TraceConfig_overrides, value) {
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_SetCodeAuthorization_nonce, value) {
 			return
 		}
 	}
-	if x.EnableMemory != false {
-		value := protoreflect.ValueOfBool(x.EnableMemory)
-		if !f(fd_TraceConfig_enable_memory, value) {
+	if x.V != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.V)
+		if !f(fd_SetCodeAuthorization_v, value) {
 			return
 		}
 	}
-	if x.EnableReturnData != false {
-		value := protoreflect.ValueOfBool(x.EnableReturnData)
-		if !f(fd_TraceConfig_enable_return_data, value) {
+	if len(x.R) != 0 {
+		value := protoreflect.ValueOfBytes(x.R)
+		if !f(fd_SetCodeAuthorization_r, value) {
 			return
 		}
 	}
-	if x.TracerJsonConfig != "" {
-		value := protoreflect.ValueOfString(x.TracerJsonConfig)
-		if !f(fd_TraceConfig_tracer_json_config, value) {
+	if len(x.S) != 0 {
+		value := protoreflect.ValueOfBytes(x.S)
+		if !f(fd_SetCodeAuthorization_s, value) {
 			return
 		}
 	}
@@ -7342,35 +7420,25 @@ func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SetCodeAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		return x.Tracer != ""
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		return x.Timeout != ""
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		return x.Reexec != uint64(0)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		return x.DisableStack != false
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		return x.DisableStorage != false
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		return x.Debug != false
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		return x.Limit != int32(0)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		return x.Overrides != nil
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		return x.EnableMemory != false
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		return x.EnableReturnData != false
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		return x.TracerJsonConfig != ""
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.chain_id":
+		return x.ChainId != ""
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.nonce":
+		return x.Nonce != uint64(0)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.v":
+		return x.V != uint32(0)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.r":
+		return len(x.R) != 0
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.s":
+		return len(x.S) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SetCodeAuthorization"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SetCodeAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7380,35 +7448,25 @@ func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SetCodeAuthorization) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		x.Tracer = ""
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		x.Timeout = ""
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		x.Reexec = uint64(0)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		x.DisableStack = false
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		x.DisableStorage = false
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		x.Debug = false
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		x.Limit = int32(0)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		x.Overrides = nil
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		x.EnableMemory = false
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		x.EnableReturnData = false
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		x.TracerJsonConfig = ""
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.chain_id":
+		x.ChainId = ""
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.nonce":
+		x.Nonce = uint64(0)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.v":
+		x.V = uint32(0)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.r":
+		x.R = nil
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.s":
+		x.S = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SetCodeAuthorization"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SetCodeAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7418,46 +7476,31 @@ func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SetCodeAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		value := x.Tracer
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.chain_id":
+		value := x.ChainId
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		value := x.Timeout
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		value := x.Reexec
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.nonce":
+		value := x.Nonce
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		value := x.DisableStack
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		value := x.DisableStorage
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		value := x.Debug
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		value := x.Limit
-		return protoreflect.ValueOfInt32(value)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		value := x.Overrides
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		value := x.EnableMemory
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		value := x.EnableReturnData
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		value := x.TracerJsonConfig
-		return protoreflect.ValueOfString(value)
-	default:
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.v":
+		value := x.V
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.r":
+		value := x.R
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.s":
+		value := x.S
+		return protoreflect.ValueOfBytes(value)
+	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SetCodeAuthorization"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SetCodeAuthorization does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7471,35 +7514,25 @@ func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SetCodeAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		x.Tracer = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		x.Timeout = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		x.Reexec = value.Uint()
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		x.DisableStack = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		x.DisableStorage = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		x.Debug = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		x.Limit = int32(value.Int())
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		x.Overrides = value.Message().Interface().(*ChainConfig)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		x.EnableMemory = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		x.EnableReturnData = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		x.TracerJsonConfig = value.Interface().(string)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.nonce":
+		x.Nonce = value.Uint()
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.v":
+		x.V = uint32(value.Uint())
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.r":
+		x.R = value.Bytes()
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.s":
+		x.S = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SetCodeAuthorization"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SetCodeAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7513,84 +7546,60 @@ func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SetCodeAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		if x.Overrides == nil {
-			x.Overrides = new(ChainConfig)
-		}
-		return protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		panic(fmt.Errorf("field tracer of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		panic(fmt.Errorf("field timeout of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		panic(fmt.Errorf("field reexec of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		panic(fmt.Errorf("field disable_stack of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		panic(fmt.Errorf("field disable_storage of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		panic(fmt.Errorf("field debug of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		panic(fmt.Errorf("field limit of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		panic(fmt.Errorf("field enable_memory of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		panic(fmt.Errorf("field enable_return_data of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		panic(fmt.Errorf("field tracer_json_config of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.SetCodeAuthorization is not mutable"))
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.SetCodeAuthorization is not mutable"))
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.nonce":
+		panic(fmt.Errorf("field nonce of message cosmos.evm.vm.v1.SetCodeAuthorization is not mutable"))
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.v":
+		panic(fmt.Errorf("field v of message cosmos.evm.vm.v1.SetCodeAuthorization is not mutable"))
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.r":
+		panic(fmt.Errorf("field r of message cosmos.evm.vm.v1.SetCodeAuthorization is not mutable"))
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.s":
+		panic(fmt.Errorf("field s of message cosmos.evm.vm.v1.SetCodeAuthorization is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SetCodeAuthorization"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SetCodeAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TraceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SetCodeAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.chain_id":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.nonce":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		return protoreflect.ValueOfInt32(int32(0))
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		m := new(ChainConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.v":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.r":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.SetCodeAuthorization.s":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SetCodeAuthorization"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SetCodeAuthorization does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SetCodeAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TraceConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.SetCodeAuthorization", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7598,7 +7607,7 @@ func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SetCodeAuthorization) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7609,7 +7618,7 @@ func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SetCodeAuthorization) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7621,7 +7630,7 @@ func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TraceConfig) IsValid() bool {
+func (x *fastReflection_SetCodeAuthorization) IsValid() bool {
 	return x != nil
 }
 
@@ -7631,9 +7640,9 @@ func (x *fastReflection_TraceConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SetCodeAuthorization) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TraceConfig)
+		x := input.Message.Interface().(*SetCodeAuthorization)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7645,40 +7654,25 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Tracer)
+		l = len(x.ChainId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Timeout)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Reexec != 0 {
-			n += 1 + runtime.Sov(uint64(x.Reexec))
-		}
-		if x.DisableStack {
-			n += 2
-		}
-		if x.DisableStorage {
-			n += 2
-		}
-		if x.Debug {
-			n += 2
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
 		}
-		if x.Limit != 0 {
-			n += 1 + runtime.Sov(uint64(x.Limit))
+		if x.V != 0 {
+			n += 1 + runtime.Sov(uint64(x.V))
 		}
-		if x.Overrides != nil {
-			l = options.Size(x.Overrides)
+		l = len(x.R)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.EnableMemory {
-			n += 2
-		}
-		if x.EnableReturnData {
-			n += 2
-		}
-		l = len(x.TracerJsonConfig)
+		l = len(x.S)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7692,7 +7686,7 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TraceConfig)
+		x := input.Message.Interface().(*SetCodeAuthorization)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7711,98 +7705,41 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TracerJsonConfig) > 0 {
-			i -= len(x.TracerJsonConfig)
-			copy(dAtA[i:], x.TracerJsonConfig)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TracerJsonConfig)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if x.EnableReturnData {
-			i--
-			if x.EnableReturnData {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x60
-		}
-		if x.EnableMemory {
-			i--
-			if x.EnableMemory {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x58
-		}
-		if x.Overrides != nil {
-			encoded, err := options.Marshal(x.Overrides)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if x.Limit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.Debug {
-			i--
-			if x.Debug {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.S) > 0 {
+			i -= len(x.S)
+			copy(dAtA[i:], x.S)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.S)))
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x32
 		}
-		if x.DisableStorage {
-			i--
-			if x.DisableStorage {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.R) > 0 {
+			i -= len(x.R)
+			copy(dAtA[i:], x.R)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
 			i--
-			dAtA[i] = 0x30
+			dAtA[i] = 0x2a
 		}
-		if x.DisableStack {
-			i--
-			if x.DisableStack {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if x.V != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.V))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x20
 		}
-		if x.Reexec != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reexec))
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.Timeout) > 0 {
-			i -= len(x.Timeout)
-			copy(dAtA[i:], x.Timeout)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Timeout)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Tracer) > 0 {
-			i -= len(x.Tracer)
-			copy(dAtA[i:], x.Tracer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tracer)))
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7817,7 +7754,7 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TraceConfig)
+		x := input.Message.Interface().(*SetCodeAuthorization)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7849,15 +7786,15 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SetCodeAuthorization: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SetCodeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7885,11 +7822,11 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Tracer = string(dAtA[iNdEx:postIndex])
+				x.ChainId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7917,13 +7854,13 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Timeout = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 				}
-				x.Reexec = 0
+				x.Nonce = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7933,16 +7870,16 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Reexec |= uint64(b&0x7F) << shift
+					x.Nonce |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 				}
-				var v int
+				x.V = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7952,17 +7889,16 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.V |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.DisableStack = bool(v != 0)
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 				}
-				var v int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7972,17 +7908,31 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.DisableStorage = bool(v != 0)
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				var v int
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.R = append(x.R[:0], dAtA[iNdEx:postIndex]...)
+				if x.R == nil {
+					x.R = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				}
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7992,36 +7942,1476 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Debug = bool(v != 0)
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.S = append(x.S[:0], dAtA[iNdEx:postIndex]...)
+				if x.S == nil {
+					x.S = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TraceConfig                    protoreflect.MessageDescriptor
+	fd_TraceConfig_tracer             protoreflect.FieldDescriptor
+	fd_TraceConfig_timeout            protoreflect.FieldDescriptor
+	fd_TraceConfig_reexec             protoreflect.FieldDescriptor
+	fd_TraceConfig_disable_stack      protoreflect.FieldDescriptor
+	fd_TraceConfig_disable_storage    protoreflect.FieldDescriptor
+	fd_TraceConfig_debug              protoreflect.FieldDescriptor
+	fd_TraceConfig_limit              protoreflect.FieldDescriptor
+	fd_TraceConfig_overrides          protoreflect.FieldDescriptor
+	fd_TraceConfig_enable_memory      protoreflect.FieldDescriptor
+	fd_TraceConfig_enable_return_data protoreflect.FieldDescriptor
+	fd_TraceConfig_tracer_json_config protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_TraceConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TraceConfig")
+	fd_TraceConfig_tracer = md_TraceConfig.Fields().ByName("tracer")
+	fd_TraceConfig_timeout = md_TraceConfig.Fields().ByName("timeout")
+	fd_TraceConfig_reexec = md_TraceConfig.Fields().ByName("reexec")
+	fd_TraceConfig_disable_stack = md_TraceConfig.Fields().ByName("disable_stack")
+	fd_TraceConfig_disable_storage = md_TraceConfig.Fields().ByName("disable_storage")
+	fd_TraceConfig_debug = md_TraceConfig.Fields().ByName("debug")
+	fd_TraceConfig_limit = md_TraceConfig.Fields().ByName("limit")
+	fd_TraceConfig_overrides = md_TraceConfig.Fields().ByName("overrides")
+	fd_TraceConfig_enable_memory = md_TraceConfig.Fields().ByName("enable_memory")
+	fd_TraceConfig_enable_return_data = md_TraceConfig.Fields().ByName("enable_return_data")
+	fd_TraceConfig_tracer_json_config = md_TraceConfig.Fields().ByName("tracer_json_config")
+}
+
+var _ protoreflect.Message = (*fastReflection_TraceConfig)(nil)
+
+type fastReflection_TraceConfig TraceConfig
+
+func (x *TraceConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TraceConfig)(x)
+}
+
+func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TraceConfig_messageType fastReflection_TraceConfig_messageType
+var _ protoreflect.MessageType = fastReflection_TraceConfig_messageType{}
+
+type fastReflection_TraceConfig_messageType struct{}
+
+func (x fastReflection_TraceConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TraceConfig)(nil)
+}
+func (x fastReflection_TraceConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_TraceConfig)
+}
+func (x fastReflection_TraceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TraceConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TraceConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_TraceConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TraceConfig) Type() protoreflect.MessageType {
+	return _fastReflection_TraceConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TraceConfig) New() protoreflect.Message {
+	return new(fastReflection_TraceConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
+	return (*TraceConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tracer != "" {
+		value := protoreflect.ValueOfString(x.Tracer)
+		if !f(fd_TraceConfig_tracer, value) {
+			return
+		}
+	}
+	if x.Timeout != "" {
+		value := protoreflect.ValueOfString(x.Timeout)
+		if !f(fd_TraceConfig_timeout, value) {
+			return
+		}
+	}
+	if x.Reexec != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Reexec)
+		if !f(fd_TraceConfig_reexec, value) {
+			return
+		}
+	}
+	if x.DisableStack != false {
+		value := protoreflect.ValueOfBool(x.DisableStack)
+		if !f(fd_TraceConfig_disable_stack, value) {
+			return
+		}
+	}
+	if x.DisableStorage != false {
+		value := protoreflect.ValueOfBool(x.DisableStorage)
+		if !f(fd_TraceConfig_disable_storage, value) {
+			return
+		}
+	}
+	if x.Debug != false {
+		value := protoreflect.ValueOfBool(x.Debug)
+		if !f(fd_TraceConfig_debug, value) {
+			return
+		}
+	}
+	if x.Limit != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Limit)
+		if !f(fd_TraceConfig_limit, value) {
+			return
+		}
+	}
+	if x.Overrides != nil {
+		value := protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
+		if !f(fd_TraceConfig_overrides, value) {
+			return
+		}
+	}
+	if x.EnableMemory != false {
+		value := protoreflect.ValueOfBool(x.EnableMemory)
+		if !f(fd_TraceConfig_enable_memory, value) {
+			return
+		}
+	}
+	if x.EnableReturnData != false {
+		value := protoreflect.ValueOfBool(x.EnableReturnData)
+		if !f(fd_TraceConfig_enable_return_data, value) {
+			return
+		}
+	}
+	if x.TracerJsonConfig != "" {
+		value := protoreflect.ValueOfString(x.TracerJsonConfig)
+		if !f(fd_TraceConfig_tracer_json_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		return x.Tracer != ""
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		return x.Timeout != ""
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		return x.Reexec != uint64(0)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		return x.DisableStack != false
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		return x.DisableStorage != false
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		return x.Debug != false
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		return x.Limit != int32(0)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		return x.Overrides != nil
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		return x.EnableMemory != false
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		return x.EnableReturnData != false
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		return x.TracerJsonConfig != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		x.Tracer = ""
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		x.Timeout = ""
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		x.Reexec = uint64(0)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		x.DisableStack = false
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		x.DisableStorage = false
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		x.Debug = false
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		x.Limit = int32(0)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		x.Overrides = nil
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		x.EnableMemory = false
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		x.EnableReturnData = false
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		x.TracerJsonConfig = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		value := x.Tracer
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		value := x.Timeout
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		value := x.Reexec
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		value := x.DisableStack
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		value := x.DisableStorage
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		value := x.Debug
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		value := x.Limit
+		return protoreflect.ValueOfInt32(value)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		value := x.Overrides
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		value := x.EnableMemory
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		value := x.EnableReturnData
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		value := x.TracerJsonConfig
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		x.Tracer = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		x.Timeout = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		x.Reexec = value.Uint()
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		x.DisableStack = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		x.DisableStorage = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		x.Debug = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		x.Limit = int32(value.Int())
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		x.Overrides = value.Message().Interface().(*ChainConfig)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		x.EnableMemory = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		x.EnableReturnData = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		x.TracerJsonConfig = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TraceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		if x.Overrides == nil {
+			x.Overrides = new(ChainConfig)
+		}
+		return protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		panic(fmt.Errorf("field tracer of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		panic(fmt.Errorf("field timeout of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		panic(fmt.Errorf("field reexec of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		panic(fmt.Errorf("field disable_stack of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		panic(fmt.Errorf("field disable_storage of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		panic(fmt.Errorf("field debug of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		panic(fmt.Errorf("field limit of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		panic(fmt.Errorf("field enable_memory of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		panic(fmt.Errorf("field enable_return_data of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		panic(fmt.Errorf("field tracer_json_config of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TraceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		m := new(ChainConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TraceConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TraceConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TraceConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Tracer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Timeout)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reexec != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reexec))
+		}
+		if x.DisableStack {
+			n += 2
+		}
+		if x.DisableStorage {
+			n += 2
+		}
+		if x.Debug {
+			n += 2
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.Overrides != nil {
+			l = options.Size(x.Overrides)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EnableMemory {
+			n += 2
+		}
+		if x.EnableReturnData {
+			n += 2
+		}
+		l = len(x.TracerJsonConfig)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TraceConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TracerJsonConfig) > 0 {
+			i -= len(x.TracerJsonConfig)
+			copy(dAtA[i:], x.TracerJsonConfig)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TracerJsonConfig)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.EnableReturnData {
+			i--
+			if x.EnableReturnData {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.EnableMemory {
+			i--
+			if x.EnableMemory {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Overrides != nil {
+			encoded, err := options.Marshal(x.Overrides)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Debug {
+			i--
+			if x.Debug {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DisableStorage {
+			i--
+			if x.DisableStorage {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.DisableStack {
+			i--
+			if x.DisableStack {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Reexec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reexec))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Timeout) > 0 {
+			i -= len(x.Timeout)
+			copy(dAtA[i:], x.Timeout)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Timeout)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Tracer) > 0 {
+			i -= len(x.Tracer)
+			copy(dAtA[i:], x.Tracer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tracer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TraceConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tracer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Timeout = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
+				}
+				x.Reexec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reexec |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableStack = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableStorage = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Debug = bool(v != 0)
 			case 9:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 				}
-				x.Limit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Limit |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Overrides == nil {
+					x.Overrides = &ChainConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Overrides); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableMemory = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableReturnData = bool(v != 0)
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Preinstall         protoreflect.MessageDescriptor
+	fd_Preinstall_name    protoreflect.FieldDescriptor
+	fd_Preinstall_address protoreflect.FieldDescriptor
+	fd_Preinstall_code    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_Preinstall = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Preinstall")
+	fd_Preinstall_name = md_Preinstall.Fields().ByName("name")
+	fd_Preinstall_address = md_Preinstall.Fields().ByName("address")
+	fd_Preinstall_code = md_Preinstall.Fields().ByName("code")
+}
+
+var _ protoreflect.Message = (*fastReflection_Preinstall)(nil)
+
+type fastReflection_Preinstall Preinstall
+
+func (x *Preinstall) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Preinstall)(x)
+}
+
+func (x *Preinstall) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Preinstall_messageType fastReflection_Preinstall_messageType
+var _ protoreflect.MessageType = fastReflection_Preinstall_messageType{}
+
+type fastReflection_Preinstall_messageType struct{}
+
+func (x fastReflection_Preinstall_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Preinstall)(nil)
+}
+func (x fastReflection_Preinstall_messageType) New() protoreflect.Message {
+	return new(fastReflection_Preinstall)
+}
+func (x fastReflection_Preinstall_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Preinstall
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Preinstall) Descriptor() protoreflect.MessageDescriptor {
+	return md_Preinstall
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Preinstall) Type() protoreflect.MessageType {
+	return _fastReflection_Preinstall_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Preinstall) New() protoreflect.Message {
+	return new(fastReflection_Preinstall)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Preinstall) Interface() protoreflect.ProtoMessage {
+	return (*Preinstall)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Preinstall) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Preinstall_name, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Preinstall_address, value) {
+			return
+		}
+	}
+	if x.Code != "" {
+		value := protoreflect.ValueOfString(x.Code)
+		if !f(fd_Preinstall_code, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Preinstall) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		return x.Name != ""
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		return x.Code != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Preinstall) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		x.Name = ""
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		x.Code = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Preinstall) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		value := x.Code
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Preinstall) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		x.Code = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Preinstall) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		panic(fmt.Errorf("field name of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Preinstall) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Preinstall) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Preinstall", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Preinstall) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Preinstall) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Preinstall) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Preinstall)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Code)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Preinstall)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Preinstall)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 10:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8031,33 +9421,29 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Overrides == nil {
-					x.Overrides = &ChainConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Overrides); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 11:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8067,35 +9453,27 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.EnableMemory = bool(v != 0)
-			case 12:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.EnableReturnData = bool(v != 0)
-			case 13:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8123,7 +9501,7 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+				x.Code = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8161,30 +9539,30 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Preinstall         protoreflect.MessageDescriptor
-	fd_Preinstall_name    protoreflect.FieldDescriptor
-	fd_Preinstall_address protoreflect.FieldDescriptor
-	fd_Preinstall_code    protoreflect.FieldDescriptor
+	md_PendingUnbondingCallback                   protoreflect.MessageDescriptor
+	fd_PendingUnbondingCallback_contract_address  protoreflect.FieldDescriptor
+	fd_PendingUnbondingCallback_validator_address protoreflect.FieldDescriptor
+	fd_PendingUnbondingCallback_amount            protoreflect.FieldDescriptor
 )
 
 func init() {
-	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_Preinstall = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Preinstall")
-	fd_Preinstall_name = md_Preinstall.Fields().ByName("name")
-	fd_Preinstall_address = md_Preinstall.Fields().ByName("address")
-	fd_Preinstall_code = md_Preinstall.Fields().ByName("code")
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_PendingUnbondingCallback = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("PendingUnbondingCallback")
+	fd_PendingUnbondingCallback_contract_address = md_PendingUnbondingCallback.Fields().ByName("contract_address")
+	fd_PendingUnbondingCallback_validator_address = md_PendingUnbondingCallback.Fields().ByName("validator_address")
+	fd_PendingUnbondingCallback_amount = md_PendingUnbondingCallback.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_Preinstall)(nil)
+var _ protoreflect.Message = (*fastReflection_PendingUnbondingCallback)(nil)
 
-type fastReflection_Preinstall Preinstall
+type fastReflection_PendingUnbondingCallback PendingUnbondingCallback
 
-func (x *Preinstall) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Preinstall)(x)
+func (x *PendingUnbondingCallback) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingUnbondingCallback)(x)
 }
 
-func (x *Preinstall) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+func (x *PendingUnbondingCallback) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8195,43 +9573,43 @@ func (x *Preinstall) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Preinstall_messageType fastReflection_Preinstall_messageType
-var _ protoreflect.MessageType = fastReflection_Preinstall_messageType{}
+var _fastReflection_PendingUnbondingCallback_messageType fastReflection_PendingUnbondingCallback_messageType
+var _ protoreflect.MessageType = fastReflection_PendingUnbondingCallback_messageType{}
 
-type fastReflection_Preinstall_messageType struct{}
+type fastReflection_PendingUnbondingCallback_messageType struct{}
 
-func (x fastReflection_Preinstall_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Preinstall)(nil)
+func (x fastReflection_PendingUnbondingCallback_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingUnbondingCallback)(nil)
 }
-func (x fastReflection_Preinstall_messageType) New() protoreflect.Message {
-	return new(fastReflection_Preinstall)
+func (x fastReflection_PendingUnbondingCallback_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingUnbondingCallback)
 }
-func (x fastReflection_Preinstall_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Preinstall
+func (x fastReflection_PendingUnbondingCallback_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingUnbondingCallback
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Preinstall) Descriptor() protoreflect.MessageDescriptor {
-	return md_Preinstall
+func (x *fastReflection_PendingUnbondingCallback) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingUnbondingCallback
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Preinstall) Type() protoreflect.MessageType {
-	return _fastReflection_Preinstall_messageType
+func (x *fastReflection_PendingUnbondingCallback) Type() protoreflect.MessageType {
+	return _fastReflection_PendingUnbondingCallback_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Preinstall) New() protoreflect.Message {
-	return new(fastReflection_Preinstall)
+func (x *fastReflection_PendingUnbondingCallback) New() protoreflect.Message {
+	return new(fastReflection_PendingUnbondingCallback)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Preinstall) Interface() protoreflect.ProtoMessage {
-	return (*Preinstall)(x)
+func (x *fastReflection_PendingUnbondingCallback) Interface() protoreflect.ProtoMessage {
+	return (*PendingUnbondingCallback)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8239,22 +9617,22 @@ func (x *fastReflection_Preinstall) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Preinstall) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_Preinstall_name, value) {
+func (x *fastReflection_PendingUnbondingCallback) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_PendingUnbondingCallback_contract_address, value) {
 			return
 		}
 	}
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Preinstall_address, value) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_PendingUnbondingCallback_validator_address, value) {
 			return
 		}
 	}
-	if x.Code != "" {
-		value := protoreflect.ValueOfString(x.Code)
-		if !f(fd_Preinstall_code, value) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_PendingUnbondingCallback_amount, value) {
 			return
 		}
 	}
@@ -8271,19 +9649,19 @@ func (x *fastReflection_Preinstall) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Preinstall) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PendingUnbondingCallback) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		return x.Name != ""
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		return x.Address != ""
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		return x.Code != ""
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.contract_address":
+		return x.ContractAddress != ""
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.amount":
+		return x.Amount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.PendingUnbondingCallback"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.PendingUnbondingCallback does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8293,19 +9671,19 @@ func (x *fastReflection_Preinstall) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Preinstall) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PendingUnbondingCallback) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		x.Name = ""
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		x.Address = ""
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		x.Code = ""
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.contract_address":
+		x.ContractAddress = ""
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.amount":
+		x.Amount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.PendingUnbondingCallback"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.PendingUnbondingCallback does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8315,22 +9693,22 @@ func (x *fastReflection_Preinstall) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Preinstall) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingUnbondingCallback) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		value := x.Name
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.contract_address":
+		value := x.ContractAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		value := x.Address
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.validator_address":
+		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		value := x.Code
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.amount":
+		value := x.Amount
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.PendingUnbondingCallback"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.PendingUnbondingCallback does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8344,19 +9722,19 @@ func (x *fastReflection_Preinstall) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Preinstall) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PendingUnbondingCallback) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		x.Name = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		x.Code = value.Interface().(string)
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.amount":
+		x.Amount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.PendingUnbondingCallback"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.PendingUnbondingCallback does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8370,48 +9748,48 @@ func (x *fastReflection_Preinstall) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Preinstall) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingUnbondingCallback) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		panic(fmt.Errorf("field name of message cosmos.evm.vm.v1.Preinstall is not mutable"))
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Preinstall is not mutable"))
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.contract_address":
+		panic(fmt.Errorf("field contract_address of message cosmos.evm.vm.v1.PendingUnbondingCallback is not mutable"))
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.evm.vm.v1.PendingUnbondingCallback is not mutable"))
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.amount":
+		panic(fmt.Errorf("field amount of message cosmos.evm.vm.v1.PendingUnbondingCallback is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.PendingUnbondingCallback"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.PendingUnbondingCallback does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Preinstall) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingUnbondingCallback) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.contract_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Preinstall.address":
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.validator_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Preinstall.code":
+	case "cosmos.evm.vm.v1.PendingUnbondingCallback.amount":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.PendingUnbondingCallback"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.PendingUnbondingCallback does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Preinstall) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PendingUnbondingCallback) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Preinstall", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.PendingUnbondingCallback", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8419,7 +9797,7 @@ func (x *fastReflection_Preinstall) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Preinstall) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PendingUnbondingCallback) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8430,7 +9808,7 @@ func (x *fastReflection_Preinstall) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Preinstall) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PendingUnbondingCallback) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8442,7 +9820,7 @@ func (x *fastReflection_Preinstall) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Preinstall) IsValid() bool {
+func (x *fastReflection_PendingUnbondingCallback) IsValid() bool {
 	return x != nil
 }
 
@@ -8452,9 +9830,9 @@ func (x *fastReflection_Preinstall) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PendingUnbondingCallback) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Preinstall)
+		x := input.Message.Interface().(*PendingUnbondingCallback)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8466,15 +9844,15 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
+		l = len(x.ContractAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Address)
+		l = len(x.ValidatorAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Code)
+		l = len(x.Amount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8488,7 +9866,7 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Preinstall)
+		x := input.Message.Interface().(*PendingUnbondingCallback)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8507,24 +9885,24 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Code) > 0 {
-			i -= len(x.Code)
-			copy(dAtA[i:], x.Code)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8539,7 +9917,7 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Preinstall)
+		x := input.Message.Interface().(*PendingUnbondingCallback)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8571,15 +9949,15 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingUnbondingCallback: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingUnbondingCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8607,11 +9985,11 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8639,11 +10017,11 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8671,7 +10049,7 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Code = string(dAtA[iNdEx:postIndex])
+				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8795,6 +10173,14 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// dev_mode enables the dev messages and the `dev` JSON-RPC namespace, that
+	// override the EVM state and the block time for testing. It must only be
+	// enabled on local and test networks.
+	DevMode bool `protobuf:"varint,10,opt,name=dev_mode,json=devMode,proto3" json:"dev_mode,omitempty"`
+	// dev_authority is the address of the only account allowed to submit the dev
+	// messages. The dev messages are restricted to the module authority when it
+	// is empty.
+	DevAuthority string `protobuf:"bytes,11,opt,name=dev_authority,json=devAuthority,proto3" json:"dev_authority,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -8859,6 +10245,20 @@ func (x *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (x *Params) GetDevMode() bool {
+	if x != nil {
+		return x.DevMode
+	}
+	return false
+}
+
+func (x *Params) GetDevAuthority() string {
+	if x != nil {
+		return x.DevAuthority
+	}
+	return ""
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -9554,6 +10954,90 @@ func (x *AccessTuple) GetStorageKeys() []string {
 	return nil
 }
 
+// SetCodeAuthorization is an authorization from an account to delegate its
+// code execution to the code deployed at another address (EIP-7702).
+type SetCodeAuthorization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chain_id of the chain where the authorization is valid. A zero value means
+	// the authorization is valid on every chain.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// address is the hex formatted address of the code the authority delegates to
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// nonce is the expected nonce of the authority account
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// v defines the signature y parity
+	V uint32 `protobuf:"varint,4,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the signature value
+	R []byte `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value
+	S []byte `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *SetCodeAuthorization) Reset() {
+	*x = SetCodeAuthorization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCodeAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCodeAuthorization) ProtoMessage() {}
+
+// Deprecated: Use SetCodeAuthorization.ProtoReflect.Descriptor instead.
+func (*SetCodeAuthorization) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetCodeAuthorization) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SetCodeAuthorization) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetCodeAuthorization) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *SetCodeAuthorization) GetV() uint32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *SetCodeAuthorization) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *SetCodeAuthorization) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
 	state         protoimpl.MessageState
@@ -9588,7 +11072,7 @@ type TraceConfig struct {
 func (x *TraceConfig) Reset() {
 	*x = TraceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9602,7 +11086,7 @@ func (*TraceConfig) ProtoMessage() {}
 
 // Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TraceConfig) GetTracer() string {
@@ -9700,7 +11184,7 @@ type Preinstall struct {
 func (x *Preinstall) Reset() {
 	*x = Preinstall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9714,7 +11198,7 @@ func (*Preinstall) ProtoMessage() {}
 
 // Deprecated: Use Preinstall.ProtoReflect.Descriptor instead.
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Preinstall) GetName() string {
@@ -9738,6 +11222,62 @@ func (x *Preinstall) GetCode() string {
 	return ""
 }
 
+// PendingUnbondingCallback defines an onUnbondingCompleted callback waiting to
+// be called, as the number of callbacks called per block is capped
+type PendingUnbondingCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract_address in hex format of the contract to call
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// validator_address of the completed unbonding delegation
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount of the completed unbonding delegation
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PendingUnbondingCallback) Reset() {
+	*x = PendingUnbondingCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingUnbondingCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingUnbondingCallback) ProtoMessage() {}
+
+// Deprecated: Use PendingUnbondingCallback.ProtoReflect.Descriptor instead.
+func (*PendingUnbondingCallback) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PendingUnbondingCallback) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *PendingUnbondingCallback) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *PendingUnbondingCallback) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 var File_cosmos_evm_vm_v1_evm_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
@@ -9746,7 +11286,7 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x65, 0x76, 0x6d,
@@ -9770,7 +11310,11 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x3a, 0x0a, 0x19, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
 	0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
 	0x28, 0x09, 0x52, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
-	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0,
+	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x64,
+	0x65, 0x76, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
+	0x65, 0x76, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
+	0x65, 0x76, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0,
 	0x2a, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x76,
 	0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04,
 	0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x41,
@@ -9980,69 +11524,92 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f,
 	0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73, 0x74,
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22,
-	0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
-	0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b,
-	0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea,
-	0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
-	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a,
-	0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea,
-	0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x10,
-	0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12,
-	0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
-	0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20,
-	0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x45,
-	0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08,
-	0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xc6, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x44, 0xea, 0xde, 0x1f, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x01, 0x76, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x01, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78,
+	0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12,
+	0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72,
+	0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
+	0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0a, 0x50,
+	0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x18,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x4c, 0x45, 0x53, 0x53, 0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x6c, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14, 0x63,
+	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f,
+	0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d,
+	0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d,
+	0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10058,20 +11625,22 @@ func file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_evm_vm_v1_evm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_cosmos_evm_vm_v1_evm_proto_goTypes = []interface{}{
-	(AccessType)(0),           // 0: cosmos.evm.vm.v1.AccessType
-	(*Params)(nil),            // 1: cosmos.evm.vm.v1.Params
-	(*AccessControl)(nil),     // 2: cosmos.evm.vm.v1.AccessControl
-	(*AccessControlType)(nil), // 3: cosmos.evm.vm.v1.AccessControlType
-	(*ChainConfig)(nil),       // 4: cosmos.evm.vm.v1.ChainConfig
-	(*State)(nil),             // 5: cosmos.evm.vm.v1.State
-	(*TransactionLogs)(nil),   // 6: cosmos.evm.vm.v1.TransactionLogs
-	(*Log)(nil),               // 7: cosmos.evm.vm.v1.Log
-	(*TxResult)(nil),          // 8: cosmos.evm.vm.v1.TxResult
-	(*AccessTuple)(nil),       // 9: cosmos.evm.vm.v1.AccessTuple
-	(*TraceConfig)(nil),       // 10: cosmos.evm.vm.v1.TraceConfig
-	(*Preinstall)(nil),        // 11: cosmos.evm.vm.v1.Preinstall
+	(AccessType)(0),                  // 0: cosmos.evm.vm.v1.AccessType
+	(*Params)(nil),                   // 1: cosmos.evm.vm.v1.Params
+	(*AccessControl)(nil),            // 2: cosmos.evm.vm.v1.AccessControl
+	(*AccessControlType)(nil),        // 3: cosmos.evm.vm.v1.AccessControlType
+	(*ChainConfig)(nil),              // 4: cosmos.evm.vm.v1.ChainConfig
+	(*State)(nil),                    // 5: cosmos.evm.vm.v1.State
+	(*TransactionLogs)(nil),          // 6: cosmos.evm.vm.v1.TransactionLogs
+	(*Log)(nil),                      // 7: cosmos.evm.vm.v1.Log
+	(*TxResult)(nil),                 // 8: cosmos.evm.vm.v1.TxResult
+	(*AccessTuple)(nil),              // 9: cosmos.evm.vm.v1.AccessTuple
+	(*SetCodeAuthorization)(nil),     // 10: cosmos.evm.vm.v1.SetCodeAuthorization
+	(*TraceConfig)(nil),              // 11: cosmos.evm.vm.v1.TraceConfig
+	(*Preinstall)(nil),               // 12: cosmos.evm.vm.v1.Preinstall
+	(*PendingUnbondingCallback)(nil), // 13: cosmos.evm.vm.v1.PendingUnbondingCallback
 }
 var file_cosmos_evm_vm_v1_evm_proto_depIdxs = []int32{
 	2, // 0: cosmos.evm.vm.v1.Params.access_control:type_name -> cosmos.evm.vm.v1.AccessControl
@@ -10203,7 +11772,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraceConfig); i {
+			switch v := v.(*SetCodeAuthorization); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10215,6 +11784,18 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Preinstall); i {
 			case 0:
 				return &v.state
@@ -10226,6 +11807,18 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingUnbondingCallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10233,7 +11826,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_vm_v1_evm_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
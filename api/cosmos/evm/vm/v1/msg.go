@@ -14,6 +14,7 @@ import (
 // Use a function to return a new pointer and avoid
 // possible reuse or racing conditions when using the same pointer
 var supportedTxs = map[string]func() TxDataV2{
+	"/cosmos.evm.vm.v1.SetCodeTx":    func() TxDataV2 { return &SetCodeTx{} },
 	"/cosmos.evm.vm.v1.DynamicFeeTx": func() TxDataV2 { return &DynamicFeeTx{} },
 	"/cosmos.evm.vm.v1.AccessListTx": func() TxDataV2 { return &AccessListTx{} },
 	"/cosmos.evm.vm.v1.LegacyTx":     func() TxDataV2 { return &LegacyTx{} },
@@ -49,7 +50,7 @@ func GetSigners(msg protov2.Message) ([][]byte, error) {
 	}
 	txData := txDataFn()
 
-	// msgEthTx.Data is a message (DynamicFeeTx, LegacyTx or AccessListTx)
+	// msgEthTx.Data is a message (SetCodeTx, DynamicFeeTx, LegacyTx or AccessListTx)
 	if err := msgEthTx.Data.UnmarshalTo(txData); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,93 @@
+package vmv1
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	ethutils "github.com/cosmos/evm/utils/eth"
+)
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	return stringToBigInt(tx.GetChainId())
+}
+
+// AsEthereumData returns an SetCodeTx transaction tx from the proto-formatted
+// TxData defined on the Cosmos EVM.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+
+	var to common.Address
+	if addr := stringToAddress(tx.GetTo()); addr != nil {
+		to = *addr
+	}
+
+	return &ethtypes.SetCodeTx{
+		ChainID:    bigToUint256(tx.GetChainID()),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  bigToUint256(stringToBigInt(tx.GetGasTipCap())),
+		GasFeeCap:  bigToUint256(stringToBigInt(tx.GetGasFeeCap())),
+		Gas:        tx.GetGas(),
+		To:         to,
+		Value:      bigToUint256(stringToBigInt(tx.GetValue())),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		AuthList:   tx.GetAuthList(),
+		V:          bigToUint256(v),
+		R:          bigToUint256(r),
+		S:          bigToUint256(s),
+	}
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	var ethAccessList ethtypes.AccessList
+
+	for _, tuple := range tx.Accesses {
+		storageKeys := make([]common.Hash, len(tuple.StorageKeys))
+
+		for i := range tuple.StorageKeys {
+			storageKeys[i] = common.HexToHash(tuple.StorageKeys[i])
+		}
+
+		ethAccessList = append(ethAccessList, ethtypes.AccessTuple{
+			Address:     common.HexToAddress(tuple.Address),
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return ethAccessList
+}
+
+// GetAuthList returns the AuthorizationList field.
+func (tx *SetCodeTx) GetAuthList() []ethtypes.SetCodeAuthorization {
+	if tx.AuthorizationList == nil {
+		return nil
+	}
+	ethAuthList := make([]ethtypes.SetCodeAuthorization, 0, len(tx.AuthorizationList))
+
+	for _, auth := range tx.AuthorizationList {
+		ethAuthList = append(ethAuthList, ethtypes.SetCodeAuthorization{
+			ChainID: *bigToUint256(stringToBigInt(auth.ChainId)),
+			Address: common.HexToAddress(auth.Address),
+			Nonce:   auth.Nonce,
+			V:       uint8(auth.V), //nolint:gosec // G115 // V is validated to fit in an uint8
+			R:       *new(uint256.Int).SetBytes(auth.R),
+			S:       *new(uint256.Int).SetBytes(auth.S),
+		})
+	}
+
+	return ethAuthList
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return ethutils.RawSignatureValues(tx.V, tx.R, tx.S)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	sdkmath "cosmossdk.io/math"
@@ -14,6 +15,7 @@ var (
 	_ TxDataV2 = &LegacyTx{}
 	_ TxDataV2 = &AccessListTx{}
 	_ TxDataV2 = &DynamicFeeTx{}
+	_ TxDataV2 = &SetCodeTx{}
 )
 
 // TxDataV2 implements the Ethereum transaction tx structure. It is used
@@ -37,6 +39,16 @@ func stringToBigInt(str string) *big.Int {
 	return res.BigInt()
 }
 
+// helper function to convert a bigInt into an uint256, nil values are converted
+// to zero
+func bigToUint256(i *big.Int) *uint256.Int {
+	if i == nil {
+		return new(uint256.Int)
+	}
+	res, _ := uint256.FromBig(i)
+	return res
+}
+
 func stringToAddress(toStr string) *common.Address {
 	if toStr == "" {
 		return nil
@@ -22,6 +22,10 @@ const (
 	Msg_EthereumTx_FullMethodName          = "/cosmos.evm.vm.v1.Msg/EthereumTx"
 	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.vm.v1.Msg/UpdateParams"
 	Msg_RegisterPreinstalls_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_UpdateChainConfig_FullMethodName   = "/cosmos.evm.vm.v1.Msg/UpdateChainConfig"
+	Msg_DevSetState_FullMethodName         = "/cosmos.evm.vm.v1.Msg/DevSetState"
+	Msg_DevIncreaseTime_FullMethodName     = "/cosmos.evm.vm.v1.Msg/DevIncreaseTime"
+	Msg_DevSendTransaction_FullMethodName  = "/cosmos.evm.vm.v1.Msg/DevSendTransaction"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +42,20 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// UpdateChainConfig defines a governance operation for updating the chain
+	// config stored in the x/vm module, e.g. to schedule a hard fork at a future
+	// block height or time. The authority is the same as is used for Params
+	// updates.
+	UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error)
+	// DevSetState defines a dev mode operation for overriding the balance,
+	// nonce, code and storage of EVM accounts.
+	DevSetState(ctx context.Context, in *MsgDevSetState, opts ...grpc.CallOption) (*MsgDevSetStateResponse, error)
+	// DevIncreaseTime defines a dev mode operation for moving the block time of
+	// the EVM forward.
+	DevIncreaseTime(ctx context.Context, in *MsgDevIncreaseTime, opts ...grpc.CallOption) (*MsgDevIncreaseTimeResponse, error)
+	// DevSendTransaction defines a dev mode operation for executing an Ethereum
+	// transaction on behalf of any account, without its signature.
+	DevSendTransaction(ctx context.Context, in *MsgDevSendTransaction, opts ...grpc.CallOption) (*MsgDevSendTransactionResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +93,42 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error) {
+	out := new(MsgUpdateChainConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateChainConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DevSetState(ctx context.Context, in *MsgDevSetState, opts ...grpc.CallOption) (*MsgDevSetStateResponse, error) {
+	out := new(MsgDevSetStateResponse)
+	err := c.cc.Invoke(ctx, Msg_DevSetState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DevIncreaseTime(ctx context.Context, in *MsgDevIncreaseTime, opts ...grpc.CallOption) (*MsgDevIncreaseTimeResponse, error) {
+	out := new(MsgDevIncreaseTimeResponse)
+	err := c.cc.Invoke(ctx, Msg_DevIncreaseTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DevSendTransaction(ctx context.Context, in *MsgDevSendTransaction, opts ...grpc.CallOption) (*MsgDevSendTransactionResponse, error) {
+	out := new(MsgDevSendTransactionResponse)
+	err := c.cc.Invoke(ctx, Msg_DevSendTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -89,6 +143,20 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// UpdateChainConfig defines a governance operation for updating the chain
+	// config stored in the x/vm module, e.g. to schedule a hard fork at a future
+	// block height or time. The authority is the same as is used for Params
+	// updates.
+	UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error)
+	// DevSetState defines a dev mode operation for overriding the balance,
+	// nonce, code and storage of EVM accounts.
+	DevSetState(context.Context, *MsgDevSetState) (*MsgDevSetStateResponse, error)
+	// DevIncreaseTime defines a dev mode operation for moving the block time of
+	// the EVM forward.
+	DevIncreaseTime(context.Context, *MsgDevIncreaseTime) (*MsgDevIncreaseTimeResponse, error)
+	// DevSendTransaction defines a dev mode operation for executing an Ethereum
+	// transaction on behalf of any account, without its signature.
+	DevSendTransaction(context.Context, *MsgDevSendTransaction) (*MsgDevSendTransactionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -105,6 +173,18 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (UnimplementedMsgServer) UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainConfig not implemented")
+}
+func (UnimplementedMsgServer) DevSetState(context.Context, *MsgDevSetState) (*MsgDevSetStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevSetState not implemented")
+}
+func (UnimplementedMsgServer) DevIncreaseTime(context.Context, *MsgDevIncreaseTime) (*MsgDevIncreaseTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevIncreaseTime not implemented")
+}
+func (UnimplementedMsgServer) DevSendTransaction(context.Context, *MsgDevSendTransaction) (*MsgDevSendTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevSendTransaction not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +252,78 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateChainConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainConfig(ctx, req.(*MsgUpdateChainConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DevSetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDevSetState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DevSetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DevSetState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DevSetState(ctx, req.(*MsgDevSetState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DevIncreaseTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDevIncreaseTime)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DevIncreaseTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DevIncreaseTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DevIncreaseTime(ctx, req.(*MsgDevIncreaseTime))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DevSendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDevSendTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DevSendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DevSendTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DevSendTransaction(ctx, req.(*MsgDevSendTransaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +343,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "UpdateChainConfig",
+			Handler:    _Msg_UpdateChainConfig_Handler,
+		},
+		{
+			MethodName: "DevSetState",
+			Handler:    _Msg_DevSetState_Handler,
+		},
+		{
+			MethodName: "DevIncreaseTime",
+			Handler:    _Msg_DevIncreaseTime_Handler,
+		},
+		{
+			MethodName: "DevSendTransaction",
+			Handler:    _Msg_DevSendTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
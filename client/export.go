@@ -50,27 +50,11 @@ func UnsafeExportEthKeyCommand() *cobra.Command {
 				return err
 			}
 
-			// Exports private key from keybase using password
-			armor, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], decryptPassword)
+			ethPrivKey, err := exportEthPrivKey(clientCtx, args[0], decryptPassword)
 			if err != nil {
 				return err
 			}
 
-			privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
-			if err != nil {
-				return err
-			}
-
-			if algo != ethsecp256k1.KeyType {
-				return fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
-			}
-
-			// Converts key to Cosmos EVM secp256k1 implementation
-			ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
-			if !ok {
-				return fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
-			}
-
 			key, err := ethPrivKey.ToECDSA()
 			if err != nil {
 				return err
@@ -86,3 +70,29 @@ func UnsafeExportEthKeyCommand() *cobra.Command {
 		},
 	}
 }
+
+// exportEthPrivKey exports the eth_secp256k1 private key with the given name
+// from the keyring, decrypting it with the given password.
+func exportEthPrivKey(clientCtx client.Context, name, decryptPassword string) (*ethsecp256k1.PrivKey, error) {
+	// Exports private key from keybase using password
+	armor, err := clientCtx.Keyring.ExportPrivKeyArmor(name, decryptPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo != ethsecp256k1.KeyType {
+		return nil, fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+	}
+
+	// Converts key to Cosmos EVM secp256k1 implementation
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+	}
+	return ethPrivKey, nil
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/hd"
+	"github.com/cosmos/evm/crypto/keystore"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	// flagKDF is the key derivation function of the exported keystore file.
+	flagKDF = "kdf"
+	// flagOutputFile is the path of the exported keystore file.
+	flagOutputFile = "output-file"
+)
+
+// ImportKeystoreCommand imports a private key from a Web3 Secret Storage
+// (keystore v3) file, as written by geth and the other Ethereum clients.
+func ImportKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-keystore <name> <file>",
+		Short: "Import an Ethereum keystore file into the local keybase",
+		Long: `Import the private key of an encrypted Web3 Secret Storage (keystore v3) file into the local keybase
+as an eth_secp256k1 key. Both the scrypt and the pbkdf2 key derivation functions are supported.`,
+		Args: cobra.ExactArgs(2),
+		RunE: runImportKeystoreCmd,
+	}
+}
+
+func runImportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	keyJSON, err := os.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	// the passphrases of the keystore files written by the Ethereum clients can
+	// be shorter than the minimum length of the keyring ones
+	passphrase, err := input.GetPassword("Enter passphrase to decrypt the keystore file:", inBuf)
+	if err != nil && passphrase == "" {
+		return err
+	}
+
+	privKey, err := keystore.Decrypt(keyJSON, passphrase)
+	if err != nil {
+		return err
+	}
+
+	armor := crypto.EncryptArmorPrivKey(privKey, passphrase, ethsecp256k1.KeyType)
+
+	return clientCtx.Keyring.ImportPrivKey(args[0], armor, passphrase)
+}
+
+// ExportKeystoreCommand exports a key with the given name as a Web3 Secret
+// Storage (keystore v3) file.
+func ExportKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-keystore <name>",
+		Short: "Export an Ethereum private key as a keystore file",
+		Long: `Export an eth_secp256k1 private key of the local keybase as a Web3 Secret Storage (keystore v3) file
+encrypted with a new passphrase, which can be imported by geth and the other Ethereum clients. The file is
+written to stdout unless --output-file is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExportKeystoreCmd,
+	}
+
+	cmd.Flags().String(flagKDF, keystore.KDFScrypt, fmt.Sprintf("Key derivation function of the keystore file (%s|%s)", keystore.KDFScrypt, keystore.KDFPBKDF2))
+	cmd.Flags().String(flagOutputFile, "", "Write the keystore file to the given path instead of stdout")
+	return cmd
+}
+
+func runExportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	kdf, err := cmd.Flags().GetString(flagKDF)
+	if err != nil {
+		return err
+	}
+	outputFile, err := cmd.Flags().GetString(flagOutputFile)
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	decryptPassword := ""
+	if clientCtx.Keyring.Backend() == keyring.BackendFile {
+		decryptPassword, err = input.GetPassword("Enter key password:", inBuf)
+		if err != nil {
+			return err
+		}
+	}
+
+	privKey, err := exportEthPrivKey(clientCtx, args[0], decryptPassword)
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to encrypt the keystore file:", inBuf)
+	if err != nil {
+		return err
+	}
+	repeated, err := input.GetPassword("Repeat the passphrase:", inBuf)
+	if err != nil {
+		return err
+	}
+	if passphrase != repeated {
+		return errors.New("passphrases don't match")
+	}
+
+	keyJSON, err := keystore.Encrypt(privKey, passphrase, kdf)
+	if err != nil {
+		return err
+	}
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, keyJSON, 0o600)
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(keyJSON))
+	return err
+}
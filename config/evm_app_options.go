@@ -42,33 +42,42 @@ func EvmAppOptionsWithConfigWithReset(
 	cosmosEVMActivators map[int]func(*vm.JumpTable),
 	withReset bool,
 ) error {
-	coinInfo, found := chainsCoinInfo[chainID]
-	if !found {
-		return fmt.Errorf("unknown chain id: %d", chainID)
+	appConfig, err := EVMAppConfigWithConfig(chainID, chainsCoinInfo)
+	if err != nil {
+		return err
 	}
 
 	// set the denom info for the chain
-	if err := setBaseDenom(coinInfo); err != nil {
+	if err := setBaseDenom(appConfig.CoinInfo); err != nil {
 		return err
 	}
 
-	ethCfg := evmtypes.DefaultChainConfig(chainID)
 	configurator := evmtypes.NewEVMConfigurator()
 	if withReset {
 		// reset configuration to set the new one
 		configurator.ResetTestConfig()
 	}
-	err := configurator.
+	return configurator.
 		WithExtendedEips(cosmosEVMActivators).
-		WithChainConfig(ethCfg).
+		WithChainConfig(appConfig.ChainConfig).
 		// NOTE: we're using the 18 decimals default for the example chain
-		WithEVMCoinInfo(coinInfo).
+		WithEVMCoinInfo(appConfig.CoinInfo).
 		Configure()
-	if err != nil {
-		return err
+}
+
+// EVMAppConfigWithConfig returns the EVM coin and chain configuration of the
+// app of the given chain ID, the same one EvmAppOptionsWithConfig sets on the
+// EVMConfigurator, to be passed to the app and owned by its keepers.
+func EVMAppConfigWithConfig(
+	chainID uint64,
+	chainsCoinInfo map[uint64]evmtypes.EvmCoinInfo,
+) (*evmtypes.EVMAppConfig, error) {
+	coinInfo, found := chainsCoinInfo[chainID]
+	if !found {
+		return nil, fmt.Errorf("unknown chain id: %d", chainID)
 	}
 
-	return nil
+	return evmtypes.NewEVMAppConfig(evmtypes.DefaultChainConfig(chainID), coinInfo)
 }
 
 // setBaseDenom registers the display denom and base denom and sets the
@@ -33,4 +33,16 @@ interface ICallbacks {
         uint64 sequence,
         bytes memory data
     ) external;
-}
\ No newline at end of file
+
+    /// @dev Callback function to be called in the end block in which an
+    /// unbonding delegation of the contract is completed, if the contract has
+    /// registered for it through the staking precompile. The call is sent by
+    /// the EVM module account, is limited in gas and its failure doesn't revert
+    /// the unbonding.
+    /// @param validatorAddress the bech32 address of the validator
+    /// @param amount the amount of the bond denomination returned to the contract
+    function onUnbondingCompleted(
+        string memory validatorAddress,
+        uint256 amount
+    ) external;
+}
@@ -0,0 +1,223 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+)
+
+const (
+	// KDFScrypt is the scrypt key derivation function of the keystore files.
+	KDFScrypt = "scrypt"
+	// KDFPBKDF2 is the PBKDF2 key derivation function of the keystore files.
+	KDFPBKDF2 = "pbkdf2"
+
+	// version is the version of the Web3 Secret Storage definition.
+	version = 3
+	// pbkdf2Iterations is the PBKDF2 iteration count used by the Ethereum
+	// clients.
+	pbkdf2Iterations = 262144
+	// dkLen is the length of the derived key.
+	dkLen = 32
+
+	// The maximum key derivation parameters accepted when decrypting, which
+	// bound the memory and time spent on an untrusted keystore file. They
+	// allow the standard and light parameters of the Ethereum clients.
+	maxScryptN          = keystore.StandardScryptN
+	maxScryptR          = 8
+	maxScryptP          = 8
+	maxPBKDF2Iterations = 4 * pbkdf2Iterations
+	maxDKLen            = 64
+)
+
+// encryptedKeyJSONV3 is the JSON encoding of a keystore v3 file.
+type encryptedKeyJSONV3 struct {
+	Address string              `json:"address"`
+	Crypto  keystore.CryptoJSON `json:"crypto"`
+	ID      string              `json:"id"`
+	Version int                 `json:"version"`
+}
+
+// Encrypt encodes the given private key as a Web3 Secret Storage (keystore v3)
+// file encrypted with the passphrase, using the scrypt or PBKDF2 key derivation
+// function.
+func Encrypt(privKey *ethsecp256k1.PrivKey, passphrase, kdf string) ([]byte, error) {
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return nil, err
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		return keystore.EncryptKey(&keystore.Key{
+			Id:         uuid.New(),
+			Address:    crypto.PubkeyToAddress(key.PublicKey),
+			PrivateKey: key,
+		}, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+	case KDFPBKDF2:
+		cryptoJSON, err := encryptPBKDF2(crypto.FromECDSA(key), passphrase)
+		if err != nil {
+			return nil, err
+		}
+		address := crypto.PubkeyToAddress(key.PublicKey)
+		return json.Marshal(encryptedKeyJSONV3{
+			Address: hex.EncodeToString(address.Bytes()),
+			Crypto:  cryptoJSON,
+			ID:      uuid.New().String(),
+			Version: version,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function %q, expected %s or %s", kdf, KDFScrypt, KDFPBKDF2)
+	}
+}
+
+// Decrypt decodes the private key of the given Web3 Secret Storage file, which
+// is encrypted with the passphrase using either the scrypt or the PBKDF2 key
+// derivation function.
+func Decrypt(keyJSON []byte, passphrase string) (*ethsecp256k1.PrivKey, error) {
+	if err := validateKeyFile(keyJSON); err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	var header struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(keyJSON, &header); err != nil {
+		return nil, err
+	}
+	if header.Address != "" {
+		address := common.HexToAddress(strings.TrimPrefix(header.Address, "0x"))
+		if address != key.Address {
+			return nil, fmt.Errorf("keystore address %s doesn't match the key address %s", address, key.Address)
+		}
+	}
+
+	return &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(key.PrivateKey)}, nil
+}
+
+// validateKeyFile checks the given keystore v3 file before any key is derived
+// from it, as the keystore package assumes it is well formed and panics
+// otherwise: the key derivation parameters must be within the bounds, and the
+// IV, cipher text and MAC must have the lengths of an encrypted private key.
+func validateKeyFile(keyJSON []byte) error {
+	var keyFile struct {
+		Crypto  keystore.CryptoJSON `json:"crypto"`
+		Version json.RawMessage     `json:"version"`
+	}
+	if err := json.Unmarshal(keyJSON, &keyFile); err != nil {
+		return err
+	}
+	if string(keyFile.Version) != fmt.Sprint(version) {
+		return fmt.Errorf("invalid keystore file: version not supported: %s", keyFile.Version)
+	}
+
+	cryptoJSON := keyFile.Crypto
+	type bound struct {
+		name     string
+		min, max int
+	}
+	var bounds []bound
+	switch cryptoJSON.KDF {
+	case KDFScrypt:
+		bounds = []bound{{"n", 1, maxScryptN}, {"r", 1, maxScryptR}, {"p", 1, maxScryptP}, {"dklen", dkLen, maxDKLen}}
+	case KDFPBKDF2:
+		if prf, ok := cryptoJSON.KDFParams["prf"].(string); !ok || prf != "hmac-sha256" {
+			return fmt.Errorf("invalid keystore file: unsupported pbkdf2 pseudo-random function %v", cryptoJSON.KDFParams["prf"])
+		}
+		bounds = []bound{{"c", 1, maxPBKDF2Iterations}, {"dklen", dkLen, maxDKLen}}
+	default:
+		// the keystore package rejects the other key derivation functions
+		return nil
+	}
+
+	for _, b := range bounds {
+		value, ok := cryptoJSON.KDFParams[b.name].(float64)
+		if !ok || value != math.Trunc(value) || value < float64(b.min) {
+			return fmt.Errorf("invalid keystore file: invalid %s key derivation parameter %s", cryptoJSON.KDF, b.name)
+		}
+		if value > float64(b.max) {
+			return fmt.Errorf("invalid keystore file: %s key derivation parameter %s %d exceeds the maximum %d", cryptoJSON.KDF, b.name, int64(value), b.max)
+		}
+	}
+	if salt, ok := cryptoJSON.KDFParams["salt"].(string); !ok {
+		return fmt.Errorf("invalid keystore file: invalid %s key derivation parameter salt", cryptoJSON.KDF)
+	} else if _, err := hex.DecodeString(salt); err != nil {
+		return fmt.Errorf("invalid keystore file: invalid %s key derivation parameter salt: %w", cryptoJSON.KDF, err)
+	}
+
+	fields := []struct {
+		name   string
+		value  string
+		length int
+	}{
+		{"iv", cryptoJSON.CipherParams.IV, aes.BlockSize},
+		{"ciphertext", cryptoJSON.CipherText, ethsecp256k1.PrivKeySize},
+		{"mac", cryptoJSON.MAC, common.HashLength},
+	}
+	for _, field := range fields {
+		bz, err := hex.DecodeString(field.value)
+		if err != nil {
+			return fmt.Errorf("invalid keystore file: invalid %s: %w", field.name, err)
+		}
+		if len(bz) != field.length {
+			return fmt.Errorf("invalid keystore file: invalid %s length %d, expected %d", field.name, len(bz), field.length)
+		}
+	}
+	return nil
+}
+
+// encryptPBKDF2 encrypts the given data with a key derived from the passphrase
+// with PBKDF2 (HMAC-SHA256), as the keystore package only supports scrypt.
+func encryptPBKDF2(data []byte, passphrase string) (keystore.CryptoJSON, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return keystore.CryptoJSON{}, err
+	}
+	derivedKey := pbkdf2.Key([]byte(passphrase), salt, pbkdf2Iterations, dkLen, sha256.New)
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return keystore.CryptoJSON{}, err
+	}
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return keystore.CryptoJSON{}, err
+	}
+	cipherText := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, data)
+	mac := crypto.Keccak256(derivedKey[16:32], cipherText)
+
+	cryptoJSON := keystore.CryptoJSON{
+		Cipher:     "aes-128-ctr",
+		CipherText: hex.EncodeToString(cipherText),
+		KDF:        KDFPBKDF2,
+		KDFParams: map[string]interface{}{
+			"c":     pbkdf2Iterations,
+			"dklen": dkLen,
+			"prf":   "hmac-sha256",
+			"salt":  hex.EncodeToString(salt),
+		},
+		MAC: hex.EncodeToString(mac),
+	}
+	cryptoJSON.CipherParams.IV = hex.EncodeToString(iv)
+	return cryptoJSON, nil
+}
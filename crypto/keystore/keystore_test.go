@@ -0,0 +1,184 @@
+package keystore
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+)
+
+// pbkdf2KeyJSON is the PBKDF2 test vector of the Web3 Secret Storage definition.
+const pbkdf2KeyJSON = `{
+	"crypto": {
+		"cipher": "aes-128-ctr",
+		"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+		"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+		"kdf": "pbkdf2",
+		"kdfparams": {
+			"c": 262144,
+			"dklen": 32,
+			"prf": "hmac-sha256",
+			"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+		},
+		"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+	},
+	"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+	"version": 3
+}`
+
+func TestDecrypt(t *testing.T) {
+	testCases := []struct {
+		name       string
+		keyJSON    string
+		passphrase string
+		expKey     string
+		expErr     string
+	}{
+		{
+			name:       "pass - pbkdf2 test vector",
+			keyJSON:    pbkdf2KeyJSON,
+			passphrase: "testpassword",
+			expKey:     "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d",
+		},
+		{
+			name:       "fail - wrong passphrase",
+			keyJSON:    pbkdf2KeyJSON,
+			passphrase: "wrongpassword",
+			expErr:     "could not decrypt key with given password",
+		},
+		{
+			name:       "fail - missing kdf params",
+			keyJSON:    `{"crypto": {"cipher": "aes-128-ctr", "kdf": "pbkdf2", "kdfparams": {}}, "id": "3198bc9c-6672-5ab3-d995-4942343ae5b6", "version": 3}`,
+			passphrase: "testpassword",
+			expErr:     "invalid keystore file",
+		},
+		{
+			name:       "fail - pbkdf2 iteration count above the maximum",
+			keyJSON:    strings.Replace(pbkdf2KeyJSON, `"c": 262144`, `"c": 4294967295`, 1),
+			passphrase: "testpassword",
+			expErr:     "pbkdf2 key derivation parameter c 4294967295 exceeds the maximum",
+		},
+		{
+			name:       "fail - scrypt n above the maximum",
+			keyJSON:    `{"crypto": {"cipher": "aes-128-ctr", "kdf": "scrypt", "kdfparams": {"n": 1073741824, "r": 8, "p": 1, "dklen": 32, "salt": "00"}}, "version": 3}`,
+			passphrase: "testpassword",
+			expErr:     "scrypt key derivation parameter n 1073741824 exceeds the maximum",
+		},
+		{
+			name:       "fail - scrypt p above the maximum",
+			keyJSON:    `{"crypto": {"cipher": "aes-128-ctr", "kdf": "scrypt", "kdfparams": {"n": 262144, "r": 8, "p": 1024, "dklen": 32, "salt": "00"}}, "version": 3}`,
+			passphrase: "testpassword",
+			expErr:     "scrypt key derivation parameter p 1024 exceeds the maximum",
+		},
+		{
+			name:       "fail - pbkdf2 derived key shorter than the cipher and MAC keys",
+			keyJSON:    strings.Replace(pbkdf2KeyJSON, `"dklen": 32`, `"dklen": 16`, 1),
+			passphrase: "testpassword",
+			expErr:     "invalid pbkdf2 key derivation parameter dklen",
+		},
+		{
+			name:       "fail - missing pbkdf2 pseudo-random function",
+			keyJSON:    strings.Replace(pbkdf2KeyJSON, `"prf": "hmac-sha256",`, ``, 1),
+			passphrase: "testpassword",
+			expErr:     "unsupported pbkdf2 pseudo-random function",
+		},
+		{
+			name:       "fail - missing salt",
+			keyJSON:    strings.Replace(pbkdf2KeyJSON, `"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"`, `"salt": 1`, 1),
+			passphrase: "testpassword",
+			expErr:     "invalid pbkdf2 key derivation parameter salt",
+		},
+		{
+			name:       "fail - invalid IV length",
+			keyJSON:    strings.Replace(pbkdf2KeyJSON, `"iv": "6087dab2f9fdbbfaddc31a909735c1e6"`, `"iv": "6087dab2"`, 1),
+			passphrase: "testpassword",
+			expErr:     "invalid iv length 4, expected 16",
+		},
+		{
+			name:       "fail - invalid cipher text length",
+			keyJSON:    strings.Replace(pbkdf2KeyJSON, `"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46"`, `"ciphertext": "5318b4d5"`, 1),
+			passphrase: "testpassword",
+			expErr:     "invalid ciphertext length 4, expected 32",
+		},
+		{
+			name:       "fail - invalid MAC",
+			keyJSON:    strings.Replace(pbkdf2KeyJSON, `"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"`, `"mac": "zz"`, 1),
+			passphrase: "testpassword",
+			expErr:     "invalid mac",
+		},
+		{
+			name:       "fail - unsupported version",
+			keyJSON:    `{"crypto": {}, "version": 4}`,
+			passphrase: "testpassword",
+			expErr:     "version not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			privKey, err := Decrypt([]byte(tc.keyJSON), tc.passphrase)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, common.FromHex(tc.expKey), privKey.Bytes())
+		})
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	address := common.BytesToAddress(privKey.PubKey().Address())
+
+	for _, kdf := range []string{KDFScrypt, KDFPBKDF2} {
+		t.Run(kdf, func(t *testing.T) {
+			keyJSON, err := Encrypt(privKey, "passphrase", kdf)
+			require.NoError(t, err)
+
+			var file struct {
+				Address string `json:"address"`
+				Crypto  struct {
+					KDF string `json:"kdf"`
+				} `json:"crypto"`
+				Version int `json:"version"`
+			}
+			require.NoError(t, json.Unmarshal(keyJSON, &file))
+			require.Equal(t, kdf, file.Crypto.KDF)
+			require.Equal(t, 3, file.Version)
+			require.Equal(t, address, common.HexToAddress(file.Address))
+
+			decrypted, err := Decrypt(keyJSON, "passphrase")
+			require.NoError(t, err)
+			require.True(t, privKey.Equals(decrypted))
+
+			_, err = Decrypt(keyJSON, "wrong")
+			require.Error(t, err)
+		})
+	}
+
+	_, err = Encrypt(privKey, "passphrase", "argon2")
+	require.ErrorContains(t, err, "unsupported key derivation function")
+}
+
+func TestDecryptAddressMismatch(t *testing.T) {
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+
+	keyJSON, err := Encrypt(privKey, "passphrase", KDFPBKDF2)
+	require.NoError(t, err)
+
+	var file map[string]interface{}
+	require.NoError(t, json.Unmarshal(keyJSON, &file))
+	file["address"] = "0000000000000000000000000000000000000001"
+	keyJSON, err = json.Marshal(file)
+	require.NoError(t, err)
+
+	_, err = Decrypt(keyJSON, "passphrase")
+	require.ErrorContains(t, err, "doesn't match the key address")
+}
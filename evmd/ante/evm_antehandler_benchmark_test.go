@@ -156,7 +156,7 @@ func (s *benchmarkSuite) generateHandlerOptions() evmdante.HandlerOptions {
 		SignModeHandler:        encCfg.TxConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         1_000_000_000,
-		TxFeeChecker:           ethante.NewDynamicFeeChecker(s.network.App.GetFeeMarketKeeper()),
+		TxFeeChecker:           ethante.NewDynamicFeeChecker(s.network.App.GetEVMKeeper(), s.network.App.GetFeeMarketKeeper()),
 	}
 }
 
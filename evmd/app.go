@@ -2,6 +2,7 @@ package evmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -20,6 +21,8 @@ import (
 	evmconfig "github.com/cosmos/evm/config"
 	evmosencoding "github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/evmd/ante"
+	evmmempool "github.com/cosmos/evm/mempool"
+	cosmosevmserver "github.com/cosmos/evm/server"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20"
@@ -86,6 +89,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -199,9 +203,21 @@ type EVMD struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// node-local database of the SHA3 preimages seen by the EVM, only opened
+	// when preimage recording is enabled
+	preimageDB dbm.DB
+
+	// randao commit pseudo transaction of the block being finalized, stripped
+	// from the delivered transactions and passed to the PreBlocker
+	randaoCommitTx []byte
 }
 
 // NewExampleApp returns a reference to an initialized EVMD.
+//
+// The EVM coin and chain configuration of the app is derived from the EVM chain
+// ID and kept on its keepers, independently of the process globals set up by
+// the EVM app options.
 func NewExampleApp(
 	logger log.Logger,
 	db dbm.DB,
@@ -219,32 +235,6 @@ func NewExampleApp(
 	interfaceRegistry := encodingConfig.InterfaceRegistry
 	txConfig := encodingConfig.TxConfig
 
-	// Below we could construct and set an application specific mempool and
-	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
-	// already set in the SDK's BaseApp, this shows an example of how to override
-	// them.
-	//
-	// Example:
-	//
-	// bApp := baseapp.NewBaseApp(...)
-	// nonceMempool := mempool.NewSenderNonceMempool()
-	// abciPropHandler := NewDefaultProposalHandler(nonceMempool, bApp)
-	//
-	// bApp.SetMempool(nonceMempool)
-	// bApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// bApp.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
-	//
-	// Alternatively, you can construct BaseApp options, append those to
-	// baseAppOptions and pass them to NewBaseApp.
-	//
-	// Example:
-	//
-	// prepareOpt = func(app *baseapp.BaseApp) {
-	// 	abciPropHandler := baseapp.NewDefaultProposalHandler(nonceMempool, app)
-	// 	app.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// }
-	// baseAppOptions = append(baseAppOptions, prepareOpt)
-
 	bApp := baseapp.NewBaseApp(
 		appName,
 		logger,
@@ -262,6 +252,10 @@ func NewExampleApp(
 	if err := evmAppOptions(evmChainID); err != nil {
 		panic(err)
 	}
+	evmAppConfig, err := evmdconfig.NewEVMAppConfig(evmChainID)
+	if err != nil {
+		panic(err)
+	}
 
 	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
@@ -335,7 +329,7 @@ func NewExampleApp(
 		EnabledSignModes:           enabledSignModes,
 		TextualCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
 	}
-	txConfig, err := authtx.NewTxConfigWithOptions(
+	txConfig, err = authtx.NewTxConfigWithOptions(
 		appCodec,
 		txConfigOpts,
 	)
@@ -382,7 +376,9 @@ func NewExampleApp(
 		authAddr,
 	)
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	// the bank keeper is set for the feegrant precompile, which grants allowances outside of the module msg server
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper).
+		SetBankKeeper(app.BankKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -465,7 +461,7 @@ func NewExampleApp(
 		keys[precisebanktypes.StoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
-	)
+	).WithEVMCoinInfo(evmAppConfig.CoinInfo)
 
 	// Set up EVM keeper
 	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
@@ -481,7 +477,17 @@ func NewExampleApp(
 		app.FeeMarketKeeper,
 		&app.Erc20Keeper,
 		tracer,
-	)
+	).WithFeegrantKeeper(app.FeeGrantKeeper)
+	app.EVMKeeper.WithEVMAppConfig(evmAppConfig)
+
+	if cast.ToBool(appOpts.Get(srvflags.EVMEnablePreimageRecording)) {
+		preimageDB, err := cosmosevmserver.OpenPreimageDB(homePath, server.GetAppDBBackend(appOpts))
+		if err != nil {
+			panic(fmt.Errorf("failed to open preimage db: %w", err))
+		}
+		app.EVMKeeper.WithPreimageDB(preimageDB)
+		app.preimageDB = preimageDB
+	}
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
@@ -570,6 +576,8 @@ func NewExampleApp(
 			app.EVMKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
+			app.AuthzKeeper,
+			app.FeeGrantKeeper,
 			app.AppCodec(),
 		),
 	)
@@ -750,6 +758,7 @@ func NewExampleApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	app.setAnteHandler(app.txConfig, maxGasWanted)
+	app.setMempool(appOpts)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -807,7 +816,7 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         evmante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
-		TxFeeChecker:           cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		TxFeeChecker:           cosmosevmante.NewDynamicFeeChecker(app.EVMKeeper, app.FeeMarketKeeper),
 	}
 	if err := options.Validate(); err != nil {
 		panic(err)
@@ -816,6 +825,50 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 }
 
+// setMempool sets the EVM mempool along with its CheckTx, PrepareProposal and
+// ProcessProposal handlers. A negative mempool.max-txs disables the app-side
+// mempool, in which case proposals keep CometBFT's FIFO order.
+//
+// The proposal handlers are wrapped to carry the vote extension signatures the
+// PREVRANDAO value is mixed from, once vote extensions are enabled.
+func (app *EVMD) setMempool(appOpts servertypes.AppOptions) {
+	app.SetExtendVoteHandler(baseapp.NoOpExtendVote())
+	app.SetVerifyVoteExtensionHandler(baseapp.NoOpVerifyVoteExtensionHandler())
+
+	maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs))
+	if maxTxs < 0 {
+		app.SetMempool(sdkmempool.NoOpMempool{})
+		handler := baseapp.NewDefaultProposalHandler(sdkmempool.NoOpMempool{}, app)
+		app.setProposalHandlers(handler.PrepareProposalHandler(), handler.ProcessProposalHandler())
+		return
+	}
+
+	mempoolConfig := evmmempool.DefaultConfig()
+	mempoolConfig.MaxTxs = maxTxs
+	if priceBump := appOpts.Get(srvflags.EVMMempoolPriceBump); priceBump != nil {
+		mempoolConfig.PriceBump = cast.ToUint64(priceBump)
+	}
+	if accountQueue := appOpts.Get(srvflags.EVMMempoolAccountQueue); accountQueue != nil {
+		mempoolConfig.AccountQueue = cast.ToUint64(accountQueue)
+	}
+	if err := mempoolConfig.Validate(); err != nil {
+		panic(err)
+	}
+
+	mp := evmmempool.NewMempool(app.EVMKeeper, mempoolConfig)
+	app.SetMempool(mp)
+	app.SetCheckTxHandler(evmmempool.NewCheckTxHandler(mp, app.txConfig.TxDecoder(), app.GetContextForCheckTx))
+
+	handler := evmmempool.NewProposalHandler(mp, app)
+	app.setProposalHandlers(handler.PrepareProposalHandler(), handler.ProcessProposalHandler())
+}
+
+func (app *EVMD) setProposalHandlers(prepare sdk.PrepareProposalHandler, process sdk.ProcessProposalHandler) {
+	handler := evmkeeper.NewRandaoProposalHandler(app.StakingKeeper, prepare, process)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(handler.ProcessProposalHandler())
+}
+
 func (app *EVMD) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
 		posthandler.HandlerOptions{},
@@ -827,6 +880,15 @@ func (app *EVMD) setPostHandler() {
 	app.SetPostHandler(postHandler)
 }
 
+// Close closes the node-local databases of the app along with the BaseApp.
+func (app *EVMD) Close() error {
+	err := app.BaseApp.Close()
+	if app.preimageDB != nil {
+		err = errors.Join(err, app.preimageDB.Close())
+	}
+	return err
+}
+
 // Name returns the name of the App
 func (app *EVMD) Name() string { return app.BaseApp.Name() }
 
@@ -840,8 +902,27 @@ func (app *EVMD) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	return app.ModuleManager.EndBlock(ctx)
 }
 
+// FinalizeBlock delivers the block without the randao commit pseudo
+// transaction injected by the RandaoProposalHandler, which isn't a valid
+// transaction. It is kept for the PreBlocker, and a successful result is
+// prepended for it as CometBFT expects one result per transaction.
 func (app *EVMD) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
-	return app.BaseApp.FinalizeBlock(req)
+	commitTx, txs := evmtypes.SplitRandaoCommitTx(req.Txs)
+	if commitTx == nil {
+		return app.BaseApp.FinalizeBlock(req)
+	}
+
+	app.randaoCommitTx = commitTx
+	defer func() { app.randaoCommitTx = nil }()
+
+	inner := *req
+	inner.Txs = txs
+	res, err = app.BaseApp.FinalizeBlock(&inner)
+	if err != nil {
+		return nil, err
+	}
+	res.TxResults = append([]*abci.ExecTxResult{{Code: abci.CodeTypeOK}}, res.TxResults...)
+	return res, nil
 }
 
 func (app *EVMD) Configurator() module.Configurator {
@@ -862,7 +943,12 @@ func (app *EVMD) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci
 	return app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
-func (app *EVMD) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *EVMD) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	txs := req.Txs
+	if app.randaoCommitTx != nil {
+		txs = append([][]byte{app.randaoCommitTx}, txs...)
+	}
+	app.EVMKeeper.UpdatePrevRandao(ctx, txs)
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -6,6 +6,7 @@ package config
 import (
 	evmconfig "github.com/cosmos/evm/config"
 	testconfig "github.com/cosmos/evm/testutil/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 // EvmAppOptions allows to setup the global configuration
@@ -13,3 +14,9 @@ import (
 func EvmAppOptions(chainID uint64) error {
 	return evmconfig.EvmAppOptionsWithConfigWithReset(chainID, testconfig.TestChainsCoinInfo, cosmosEVMActivators, true)
 }
+
+// NewEVMAppConfig returns the EVM coin and chain configuration of the app of the
+// Cosmos EVM chain, used by NewExampleApp.
+func NewEVMAppConfig(chainID uint64) (*evmtypes.EVMAppConfig, error) {
+	return evmconfig.EVMAppConfigWithConfig(chainID, testconfig.TestChainsCoinInfo)
+}
@@ -5,6 +5,7 @@ package config
 
 import (
 	evmconfig "github.com/cosmos/evm/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 // EvmAppOptions allows to setup the global configuration
@@ -12,3 +13,9 @@ import (
 func EvmAppOptions(chainID uint64) error {
 	return evmconfig.EvmAppOptionsWithConfig(chainID, ChainsCoinInfo, cosmosEVMActivators)
 }
+
+// NewEVMAppConfig returns the EVM coin and chain configuration of the app of the
+// Cosmos EVM chain, used by NewExampleApp.
+func NewEVMAppConfig(chainID uint64) (*evmtypes.EVMAppConfig, error) {
+	return evmconfig.EVMAppConfigWithConfig(chainID, ChainsCoinInfo)
+}
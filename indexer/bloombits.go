@@ -0,0 +1,335 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/rpc/backend"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// KeyPrefixBlockBloom holds the bloom of an indexed block until the
+	// section of the block is complete.
+	KeyPrefixBlockBloom = 3
+	// KeyPrefixBloomSection holds the number of indexed blocks of a section.
+	KeyPrefixBloomSection = 4
+	// KeyPrefixBloomBits holds the compressed bloom bit vectors of the
+	// processed sections.
+	KeyPrefixBloomBits = 5
+	// KeyPrefixBloomBitsSection marks the sections whose bloom bits are
+	// written.
+	KeyPrefixBloomBitsSection = 6
+
+	// bloomBitLength is the number of bits of a bloom filter
+	bloomBitLength = ethtypes.BloomByteLength * 8
+	// bloomBitVectorLength is the length in bytes of a section bit vector
+	bloomBitVectorLength = int(cosmosevmtypes.BloomBitsBlocks / 8)
+)
+
+// BloomBits returns the bit vector of the given bloom bit within a section, one
+// bit per block of the section. It returns nil if the section is not processed.
+//
+// The bloom bit n matches the mask 1<<(n%8) of the bloom byte n/8.
+func (kv *KVIndexer) BloomBits(bit uint, section uint64) ([]byte, error) {
+	if bit >= bloomBitLength {
+		return nil, fmt.Errorf("bloom bit out of range: %d", bit)
+	}
+	processed, err := kv.db.Has(BloomBitsSectionKey(section))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "BloomBits %d %d", section, bit)
+	}
+	if !processed {
+		return nil, nil
+	}
+	bz, err := kv.db.Get(BloomBitsKey(section, bit))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "BloomBits %d %d", section, bit)
+	}
+	vector, err := bitutil.DecompressBytes(bz, bloomBitVectorLength)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "BloomBits %d %d", section, bit)
+	}
+	if len(bz) == bloomBitVectorLength {
+		// not compressed, don't hand out the db buffer
+		vector = append([]byte{}, vector...)
+	}
+	return vector, nil
+}
+
+// BloomSections returns the number of contiguous sections from section 0 whose
+// bloom bits are indexed. The sections processed after a missing one are not
+// counted, as the bloom bits are only looked up below the returned section.
+func (kv *KVIndexer) BloomSections() (uint64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBloomBitsSection}, []byte{KeyPrefixBloomBitsSection + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "BloomSections")
+	}
+	defer it.Close()
+
+	var sections uint64
+	for ; it.Valid(); it.Next() {
+		if sdk.BigEndianToUint64(it.Key()[1:]) != sections {
+			break
+		}
+		sections++
+	}
+	return sections, it.Error()
+}
+
+// ProcessBloomSections converts the block blooms of the complete sections that
+// are not processed yet into bloom bits, including the sections completed by a
+// backfill of the indexer db, and returns the number of processed sections.
+//
+// IndexBlock only records the block blooms, as converting a section rewrites
+// all its bloom bits, so it is meant to be called in the background.
+func (kv *KVIndexer) ProcessBloomSections() (uint64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBloomSection}, []byte{KeyPrefixBloomSection + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "ProcessBloomSections")
+	}
+	var complete []uint64
+	for ; it.Valid(); it.Next() {
+		section := sdk.BigEndianToUint64(it.Key()[1:])
+		if sdk.BigEndianToUint64(it.Value()) == sectionSize(section) {
+			complete = append(complete, section)
+		}
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "ProcessBloomSections")
+	}
+
+	var processed uint64
+	for _, section := range complete {
+		has, err := kv.db.Has(BloomBitsSectionKey(section))
+		if err != nil {
+			return processed, errorsmod.Wrapf(err, "ProcessBloomSections %d", section)
+		}
+		if has {
+			continue
+		}
+
+		batch := kv.db.NewBatch()
+		if err := kv.indexBloomSection(batch, section); err != nil {
+			batch.Close()
+			return processed, errorsmod.Wrapf(err, "index bloom section %d", section)
+		}
+		if err := batch.Write(); err != nil {
+			batch.Close()
+			return processed, errorsmod.Wrapf(err, "ProcessBloomSections %d, write batch", section)
+		}
+		batch.Close()
+		processed++
+	}
+	return processed, nil
+}
+
+// FirstBloomIndexedBlock returns the first block number covered by the bloom
+// bits index, returns -1 if it is empty
+func (kv *KVIndexer) FirstBloomIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBloomSection}, []byte{KeyPrefixBloomSection + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstBloomIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	section := sdk.BigEndianToUint64(it.Key()[1:])
+	if sdk.BigEndianToUint64(it.Value()) == sectionSize(section) {
+		return int64(sectionStart(section)), nil //#nosec G115 -- int overflow is not a concern here
+	}
+	return kv.loadBlockBloomHeight(section, false)
+}
+
+// LastBloomIndexedBlock returns the last block number covered by the bloom
+// bits index, returns -1 if it is empty
+func (kv *KVIndexer) LastBloomIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixBloomSection}, []byte{KeyPrefixBloomSection + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastBloomIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	section := sdk.BigEndianToUint64(it.Key()[1:])
+	if sdk.BigEndianToUint64(it.Value()) == sectionSize(section) {
+		return int64((section+1)*cosmosevmtypes.BloomBitsBlocks - 1), nil //#nosec G115 -- int overflow is not a concern here
+	}
+	return kv.loadBlockBloomHeight(section, true)
+}
+
+// loadBlockBloomHeight returns the first or last block of an incomplete
+// section that has its bloom indexed.
+func (kv *KVIndexer) loadBlockBloomHeight(section uint64, reverse bool) (int64, error) {
+	start, end := sectionBlockBloomRange(section)
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "load block bloom of section %d", section)
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(it.Key()[1:])), nil //#nosec G115 -- int overflow is not a concern here
+}
+
+// indexBloom records the bloom of a block into the batch, until all the blocks
+// of its section are recorded. The complete sections are converted to bloom
+// bits by ProcessBloomSections.
+func (kv *KVIndexer) indexBloom(batch dbm.Batch, height int64, bloom ethtypes.Bloom) error {
+	if height <= 0 {
+		return nil
+	}
+	number := uint64(height)
+	section := number / cosmosevmtypes.BloomBitsBlocks
+
+	bz, err := kv.db.Get(BloomSectionKey(section))
+	if err != nil {
+		return errorsmod.Wrap(err, "get bloom section")
+	}
+	count := sdk.BigEndianToUint64(bz)
+	if count == sectionSize(section) {
+		// already complete, the block blooms may be pruned
+		return nil
+	}
+
+	has, err := kv.db.Has(BlockBloomKey(number))
+	if err != nil {
+		return errorsmod.Wrap(err, "get block bloom")
+	}
+	if !has {
+		count++
+	}
+
+	if err := batch.Set(BlockBloomKey(number), bloom.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set block bloom")
+	}
+	if err := batch.Set(BloomSectionKey(section), sdk.Uint64ToBigEndian(count)); err != nil {
+		return errorsmod.Wrap(err, "set bloom section")
+	}
+	return nil
+}
+
+// indexBloomSection rotates the block blooms of a complete section into one
+// bit vector per bloom bit, deletes the block blooms and marks the section as
+// processed.
+func (kv *KVIndexer) indexBloomSection(batch dbm.Batch, section uint64) error {
+	vectors := make([][]byte, bloomBitLength)
+	for i := range vectors {
+		vectors[i] = make([]byte, bloomBitVectorLength)
+	}
+
+	start, end := sectionBlockBloomRange(section)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		offset := sdk.BigEndianToUint64(it.Key()[1:]) % cosmosevmtypes.BloomBitsBlocks
+		for i, b := range it.Value() {
+			for j := 0; b != 0; j, b = j+1, b>>1 {
+				if b&1 != 0 {
+					vectors[i*8+j][offset/8] |= 1 << (7 - offset%8)
+				}
+			}
+		}
+		if err := batch.Delete(append([]byte{}, it.Key()...)); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	for bit, vector := range vectors {
+		// an empty vector compresses to nil which the db rejects
+		bz := append([]byte{}, bitutil.CompressBytes(vector)...)
+		if err := batch.Set(BloomBitsKey(section, uint(bit)), bz); err != nil { //#nosec G115 -- bit is below bloomBitLength
+			return err
+		}
+	}
+	return batch.Set(BloomBitsSectionKey(section), []byte{1})
+}
+
+// blockBloom returns the bloom of the logs emitted by the transactions of a
+// block.
+func blockBloom(txResults []*abci.ExecTxResult) (ethtypes.Bloom, error) {
+	var bloom ethtypes.Bloom
+	for _, result := range txResults {
+		txLogs, err := backend.AllTxLogsFromEvents(result.Events)
+		if err != nil {
+			return ethtypes.Bloom{}, err
+		}
+		for _, logs := range txLogs {
+			for _, log := range logs {
+				bloom.Add(log.Address.Bytes())
+				for _, topic := range log.Topics {
+					bloom.Add(topic.Bytes())
+				}
+			}
+		}
+	}
+	return bloom, nil
+}
+
+// sectionStart returns the first block number of a section.
+func sectionStart(section uint64) uint64 {
+	if section == 0 {
+		return 1
+	}
+	return section * cosmosevmtypes.BloomBitsBlocks
+}
+
+// sectionSize returns the number of blocks of a section, the genesis block
+// being excluded from the first one.
+func sectionSize(section uint64) uint64 {
+	return (section+1)*cosmosevmtypes.BloomBitsBlocks - sectionStart(section)
+}
+
+// sectionBlockBloomRange returns the key range of the block blooms of a section.
+func sectionBlockBloomRange(section uint64) ([]byte, []byte) {
+	return BlockBloomKey(sectionStart(section)), BlockBloomKey((section + 1) * cosmosevmtypes.BloomBitsBlocks)
+}
+
+// BlockBloomKey returns the key for db entry: `block number -> block bloom`
+func BlockBloomKey(blockNumber uint64) []byte {
+	return append([]byte{KeyPrefixBlockBloom}, sdk.Uint64ToBigEndian(blockNumber)...)
+}
+
+// BloomSectionKey returns the key for db entry: `section -> number of indexed blocks`
+func BloomSectionKey(section uint64) []byte {
+	return append([]byte{KeyPrefixBloomSection}, sdk.Uint64ToBigEndian(section)...)
+}
+
+// BloomBitsSectionKey returns the key for db entry: `section -> processed marker`
+func BloomBitsSectionKey(section uint64) []byte {
+	return append([]byte{KeyPrefixBloomBitsSection}, sdk.Uint64ToBigEndian(section)...)
+}
+
+// BloomBitsKey returns the key for db entry: `(section, bloom bit) -> compressed bit vector`
+func BloomBitsKey(section uint64, bit uint) []byte {
+	bz := append([]byte{KeyPrefixBloomBits}, sdk.Uint64ToBigEndian(section)...)
+	return append(bz, byte(bit>>8), byte(bit))
+}
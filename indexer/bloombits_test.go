@@ -0,0 +1,194 @@
+package indexer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func logResult(t *testing.T, address common.Address, topic common.Hash) []*abci.ExecTxResult {
+	t.Helper()
+	bz, err := json.Marshal(&evmtypes.Log{Address: address.Hex(), Topics: []string{topic.Hex()}})
+	require.NoError(t, err)
+	return []*abci.ExecTxResult{{
+		Events: []abci.Event{{
+			Type:       evmtypes.EventTypeTxLog,
+			Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}},
+		}},
+	}}
+}
+
+// bloomBitsSet returns the section offsets set in the bit vectors of all the
+// bloom bits of the given data.
+func bloomBitsSet(t *testing.T, idxer *KVIndexer, section uint64, data []byte) []uint64 {
+	t.Helper()
+	var bloom ethtypes.Bloom
+	bloom.Add(data)
+
+	matches := make([]byte, bloomBitVectorLength)
+	for i := range matches {
+		matches[i] = 0xff
+	}
+	for i, b := range bloom.Bytes() {
+		for j := 0; j < 8; j++ {
+			if b&(1<<j) == 0 {
+				continue
+			}
+			vector, err := idxer.BloomBits(uint(i*8+j), section) //#nosec G115 -- bit is below bloomBitLength
+			require.NoError(t, err)
+			require.Len(t, vector, bloomBitVectorLength)
+			for k := range matches {
+				matches[k] &= vector[k]
+			}
+		}
+	}
+
+	var offsets []uint64
+	for offset := uint64(0); offset < cosmosevmtypes.BloomBitsBlocks; offset++ {
+		if matches[offset/8]&(1<<(7-offset%8)) != 0 {
+			offsets = append(offsets, offset)
+		}
+	}
+	return offsets
+}
+
+func TestBloomBits(t *testing.T) {
+	idxer := NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	topic := common.HexToHash("0x2000000000000000000000000000000000000000000000000000000000000002")
+	other := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	sectionEnd := int64(cosmosevmtypes.BloomBitsBlocks) //#nosec G115 -- constant fits
+	indexBlock := func(height int64) {
+		var results []*abci.ExecTxResult
+		switch height {
+		case 10, sectionEnd + 20:
+			results = logResult(t, address, topic)
+		case 11:
+			results = logResult(t, other, topic)
+		}
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, results))
+	}
+
+	first, err := idxer.FirstBloomIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	last, err := idxer.LastBloomIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	// index the second section forward and the first one backward, leaving
+	// a block out of each
+	for height := sectionEnd; height < 2*sectionEnd-1; height++ {
+		indexBlock(height)
+	}
+	for height := sectionEnd - 1; height > 1; height-- {
+		indexBlock(height)
+	}
+	// reindexing a block doesn't complete the section
+	indexBlock(2)
+
+	processed, err := idxer.ProcessBloomSections()
+	require.NoError(t, err)
+	require.Zero(t, processed)
+	sections, err := idxer.BloomSections()
+	require.NoError(t, err)
+	require.Zero(t, sections)
+	vector, err := idxer.BloomBits(0, 0)
+	require.NoError(t, err)
+	require.Nil(t, vector)
+
+	first, err = idxer.FirstBloomIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), first)
+	last, err = idxer.LastBloomIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, 2*sectionEnd-2, last)
+
+	indexBlock(1)
+	indexBlock(2*sectionEnd - 1)
+
+	// the complete sections are only converted to bloom bits once processed
+	sections, err = idxer.BloomSections()
+	require.NoError(t, err)
+	require.Zero(t, sections)
+	vector, err = idxer.BloomBits(0, 0)
+	require.NoError(t, err)
+	require.Nil(t, vector)
+
+	processed, err = idxer.ProcessBloomSections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), processed)
+	processed, err = idxer.ProcessBloomSections()
+	require.NoError(t, err)
+	require.Zero(t, processed)
+
+	sections, err = idxer.BloomSections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sections)
+
+	first, err = idxer.FirstBloomIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err = idxer.LastBloomIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, 2*sectionEnd-1, last)
+
+	require.Equal(t, []uint64{10}, bloomBitsSet(t, idxer, 0, address.Bytes()))
+	require.Equal(t, []uint64{10, 11}, bloomBitsSet(t, idxer, 0, topic.Bytes()))
+	require.Equal(t, []uint64{11}, bloomBitsSet(t, idxer, 0, other.Bytes()))
+	require.Equal(t, []uint64{20}, bloomBitsSet(t, idxer, 1, address.Bytes()))
+	require.Empty(t, bloomBitsSet(t, idxer, 1, other.Bytes()))
+
+	// the block blooms are pruned once their section is processed
+	it, err := idxer.db.Iterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	require.NoError(t, err)
+	require.False(t, it.Valid())
+	require.NoError(t, it.Close())
+
+	_, err = idxer.BloomBits(bloomBitLength, 0)
+	require.Error(t, err)
+}
+
+func TestBloomSectionsPrefix(t *testing.T) {
+	idxer := NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+	sectionEnd := int64(cosmosevmtypes.BloomBitsBlocks) //#nosec G115 -- constant fits
+	indexBlocks := func(from, to int64) {
+		for height := from; height <= to; height++ {
+			block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+			require.NoError(t, idxer.IndexBlock(block, nil))
+		}
+	}
+
+	// the second section is processed before the first one
+	indexBlocks(sectionEnd, 2*sectionEnd-1)
+	processed, err := idxer.ProcessBloomSections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), processed)
+	sections, err := idxer.BloomSections()
+	require.NoError(t, err)
+	require.Zero(t, sections)
+
+	indexBlocks(1, sectionEnd-1)
+	processed, err = idxer.ProcessBloomSections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), processed)
+	sections, err = idxer.BloomSections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sections)
+}
@@ -49,6 +49,8 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Records the bloom of the block logs, see ProcessBloomSections for their
+// conversion to bloom bits
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Height
 
@@ -117,6 +119,15 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			}
 		}
 	}
+
+	bloom, err := blockBloom(txResults)
+	if err != nil {
+		// leave the section incomplete so queries fall back to scanning its blocks
+		kv.logger.Error("Fail to compute block bloom", "err", err, "block", height)
+	} else if err := kv.indexBloom(batch, height, bloom); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
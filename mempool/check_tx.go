@@ -0,0 +1,160 @@
+package mempool
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	evmante "github.com/cosmos/evm/ante/evm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewCheckTxHandler returns a CheckTx handler that admits the Ethereum
+// transactions the ante handler rejects only because of their nonce:
+//   - a transaction ahead of the account nonce is queued in the mempool until
+//     the gap is filled, up to the configured account queue;
+//   - a transaction reusing the nonce of a pooled one replaces it if it pays
+//     the configured price bump.
+//
+// Such transactions only go through the signature, fee and balance checks
+// here. They are fully validated against the state by the ante handler once
+// they are proposed. All the other transactions are checked by the regular
+// runTx flow.
+//
+// getCtx returns the CheckTx state context, e.g. BaseApp.GetContextForCheckTx.
+func NewCheckTxHandler(mp *Mempool, txDecoder sdk.TxDecoder, getCtx func(txBytes []byte) sdk.Context) sdk.CheckTxHandler {
+	return func(runTx sdk.RunTx, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+		tx, err := txDecoder(req.Tx)
+		if err != nil {
+			return errortypes.ResponseCheckTxWithEvents(errortypes.ErrTxDecode.Wrap(err.Error()), 0, 0, nil, false), nil
+		}
+
+		if msg, ok := ethereumMsg(tx); ok {
+			if res, handled := mp.checkNonce(getCtx(req.Tx), tx, msg); handled {
+				return res, nil
+			}
+		}
+
+		gInfo, result, anteEvents, err := runTx(req.Tx, tx)
+		if err != nil {
+			return errortypes.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
+		}
+
+		return &abci.ResponseCheckTx{
+			GasWanted: int64(gInfo.GasWanted), //#nosec G115 -- gas is bounded by the block gas limit
+			GasUsed:   int64(gInfo.GasUsed),   //#nosec G115 -- gas is bounded by the block gas limit
+			Log:       result.Log,
+			Data:      result.Data,
+			Events:    result.Events,
+		}, nil
+	}
+}
+
+// checkNonce queues or replaces an Ethereum transaction whose nonce does not
+// match the account nonce of the CheckTx state. It returns false if the
+// transaction must go through the regular runTx flow instead.
+//
+// The checks against the pooled transactions and the insertion are done under
+// one lock, so that concurrent calls can't both pass the account queue limit or
+// the balance check.
+func (mp *Mempool) checkNonce(ctx sdk.Context, tx sdk.Tx, msg *evmtypes.MsgEthereumTx) (*abci.ResponseCheckTx, bool) {
+	ethTx := msg.AsTransaction()
+	if ethTx == nil {
+		return nil, false
+	}
+
+	sender := msg.GetSender()
+	nonce := ethTx.Nonce()
+	accountNonce := mp.vmKeeper.GetNonce(ctx, sender)
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	switch {
+	case nonce > accountNonce:
+		if !mp.contains(sender, nonce) && mp.queued(sender, accountNonce) >= mp.config.AccountQueue {
+			err := errorsmod.Wrapf(
+				errortypes.ErrMempoolIsFull,
+				"%s; %d transactions queued for %s", ErrAccountQueueFull, mp.config.AccountQueue, sender,
+			)
+			return errortypes.ResponseCheckTxWithEvents(err, 0, 0, nil, false), true
+		}
+	case nonce < accountNonce && mp.contains(sender, nonce):
+	default:
+		return nil, false
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return errortypes.ResponseCheckTxWithEvents(err, 0, 0, nil, false), true
+	}
+	ethCfg := mp.vmKeeper.GetEthChainConfig(ctx)
+	signer := ethtypes.LatestSignerForChainID(ethCfg.ChainID)
+	if err := msg.VerifySender(signer); err != nil {
+		err = errorsmod.Wrap(errortypes.ErrorInvalidSigner, err.Error())
+		return errortypes.ResponseCheckTxWithEvents(err, 0, 0, nil, false), true
+	}
+	if err := mp.checkFees(ctx, ethCfg, ethTx); err != nil {
+		return errortypes.ResponseCheckTxWithEvents(err, 0, 0, nil, false), true
+	}
+	if err := mp.checkBalance(ctx, sender, accountNonce, ethTx); err != nil {
+		return errortypes.ResponseCheckTxWithEvents(err, 0, 0, nil, false), true
+	}
+	if err := mp.insertEVMTx(tx, ethTx, sender); err != nil {
+		if errors.Is(err, ErrReplacementUnderpriced) {
+			err = errorsmod.Wrap(errortypes.ErrInsufficientFee, err.Error())
+		} else {
+			err = errorsmod.Wrap(errortypes.ErrMempoolIsFull, err.Error())
+		}
+		return errortypes.ResponseCheckTxWithEvents(err, 0, 0, nil, false), true
+	}
+
+	return &abci.ResponseCheckTx{GasWanted: int64(ethTx.Gas())}, true //#nosec G115 -- gas is bounded by ValidateBasic
+}
+
+// checkFees checks that the transaction pays the local and global minimum gas
+// prices, as the ante handler does. The fee cap is used as the gas price, since
+// the base fee the transaction will be executed with is not known yet.
+func (mp *Mempool) checkFees(ctx sdk.Context, ethCfg *params.ChainConfig, ethTx *ethtypes.Transaction) error {
+	coinInfo := mp.vmKeeper.GetEVMCoinInfo()
+	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+
+	gasLimit := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(ethTx.Gas()))
+	fee := sdkmath.LegacyNewDecFromBigInt(new(big.Int).Mul(ethTx.GasFeeCap(), new(big.Int).SetUint64(ethTx.Gas())))
+
+	mempoolMinGasPrice := coinInfo.ConvertAmountTo18DecimalsLegacy(ctx.MinGasPrices().AmountOf(coinInfo.Denom))
+	if err := evmante.CheckMempoolFee(fee, mempoolMinGasPrice, gasLimit, rules.IsLondon); err != nil {
+		return err
+	}
+	return evmante.CheckGlobalFee(fee, mp.vmKeeper.GetMinGasPrice(ctx), gasLimit)
+}
+
+// checkBalance checks that the sender can pay for the transaction on top of
+// the queued ones that precede it. The caller must hold mp.mtx.
+func (mp *Mempool) checkBalance(ctx sdk.Context, sender common.Address, accountNonce uint64, ethTx *ethtypes.Transaction) error {
+	cost := new(big.Int).Set(ethTx.Cost())
+	for nonce, tx := range mp.evmTxs[sender] {
+		if nonce >= accountNonce && nonce < ethTx.Nonce() {
+			cost.Add(cost, tx.ethTx.Cost())
+		}
+	}
+
+	balance := mp.vmKeeper.GetBalance(ctx, sender).ToBig()
+	if balance.Cmp(cost) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < cost of the queued transactions (%s < %s)", balance, cost,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package mempool
+
+import (
+	"errors"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+// Config defines the configuration of the EVM mempool.
+type Config struct {
+	// PriceBump is the minimum tip and fee cap increase, in percent, for an
+	// Ethereum transaction to replace a pooled one with the same sender and
+	// nonce.
+	PriceBump uint64
+	// AccountQueue is the maximum number of Ethereum transactions of an
+	// account queued ahead of its nonce until the nonce gap is filled.
+	AccountQueue uint64
+	// MaxTxs is the maximum number of transactions held by the mempool. Zero
+	// means no limit.
+	MaxTxs int
+}
+
+// DefaultConfig returns the default mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		PriceBump:    config.DefaultMempoolPriceBump,
+		AccountQueue: config.DefaultMempoolAccountQueue,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.MaxTxs < 0 {
+		return errors.New("max txs cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package mempool
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VMKeeper defines the EVM keeper methods the mempool reads the account
+// nonces and balances, the block base fee and the chain configuration from.
+type VMKeeper interface {
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+	GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int
+	GetBaseFee(ctx sdk.Context) *big.Int
+	GetMinGasPrice(ctx sdk.Context) sdkmath.LegacyDec
+	GetEthChainConfig(ctx sdk.Context) *params.ChainConfig
+	GetEVMCoinInfo() evmtypes.EvmCoinInfo
+}
@@ -0,0 +1,453 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var (
+	// ErrReplacementUnderpriced is returned when a transaction reuses the nonce
+	// of a pooled one without paying the configured price bump.
+	ErrReplacementUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrAccountQueueFull is returned when a transaction ahead of the account
+	// nonce cannot be queued, because the account already has the configured
+	// number of queued transactions.
+	ErrAccountQueueFull = errors.New("account queue is full")
+)
+
+var _ sdkmempool.ExtMempool = (*Mempool)(nil)
+
+// Mempool is an EVM-aware app-side mempool.
+//
+// Ethereum transactions are kept in per-account nonce queues. A transaction
+// whose nonce is ahead of the account nonce stays queued until the gap is
+// filled, and a transaction reusing the nonce of a pooled one replaces it if
+// both its tip and fee cap are higher by at least the configured price bump.
+// Cosmos transactions, including the ones made of several Ethereum messages,
+// are kept in a priority nonce mempool.
+//
+// Select orders the executable Ethereum transactions by effective tip against
+// the current base fee, keeping the nonce order of each account, and
+// interleaves them with the Cosmos transactions by priority.
+type Mempool struct {
+	mtx      sync.Mutex
+	vmKeeper VMKeeper
+	config   Config
+
+	// evmTxs holds the Ethereum transactions by sender and nonce
+	evmTxs   map[common.Address]map[uint64]*evmTx
+	evmCount int
+	// seq is the insertion counter used to break priority ties
+	seq uint64
+
+	cosmosTxs        *sdkmempool.PriorityNonceMempool[int64]
+	cosmosPriorities map[string]int64
+	signerExtractor  sdkmempool.SignerExtractionAdapter
+}
+
+// evmTx is a pooled Ethereum transaction.
+type evmTx struct {
+	tx     sdk.Tx
+	ethTx  *ethtypes.Transaction
+	sender common.Address
+	seq    uint64
+}
+
+// NewMempool creates a new EVM mempool.
+func NewMempool(vmKeeper VMKeeper, config Config) *Mempool {
+	signerExtractor := signerExtractionAdapter{fallback: sdkmempool.NewDefaultSignerExtractionAdapter()}
+	return &Mempool{
+		vmKeeper: vmKeeper,
+		config:   config,
+		evmTxs:   make(map[common.Address]map[uint64]*evmTx),
+		cosmosTxs: sdkmempool.NewPriorityMempool(sdkmempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      sdkmempool.NewDefaultTxPriority(),
+			SignerExtractor: signerExtractor,
+			MaxTx:           config.MaxTxs,
+		}),
+		cosmosPriorities: make(map[string]int64),
+		signerExtractor:  signerExtractor,
+	}
+}
+
+// Insert adds a transaction to the mempool. An Ethereum transaction with the
+// nonce of a pooled one from the same sender replaces it if it pays the
+// configured price bump.
+func (mp *Mempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	msg, ok := ethereumMsg(tx)
+	if !ok {
+		return mp.insertCosmosTx(goCtx, tx)
+	}
+
+	ethTx := msg.AsTransaction()
+	if ethTx == nil {
+		return fmt.Errorf("failed to unpack ethereum tx %s", msg.Hash)
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.insertEVMTx(tx, ethTx, msg.GetSender())
+}
+
+// insertEVMTx adds an Ethereum transaction to the mempool, replacing the pooled
+// one with the same nonce if it pays the configured price bump. The caller must
+// hold mp.mtx.
+func (mp *Mempool) insertEVMTx(tx sdk.Tx, ethTx *ethtypes.Transaction, sender common.Address) error {
+	nonce := ethTx.Nonce()
+	txs := mp.evmTxs[sender]
+	if old, found := txs[nonce]; found {
+		if old.ethTx.Hash() == ethTx.Hash() {
+			return nil
+		}
+		if !mp.canReplace(old.ethTx, ethTx) {
+			return ErrReplacementUnderpriced
+		}
+		txs[nonce] = mp.newEVMTx(tx, ethTx, sender)
+		return nil
+	}
+
+	if mp.config.MaxTxs > 0 && mp.evmCount+mp.cosmosTxs.CountTx() >= mp.config.MaxTxs {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	if txs == nil {
+		txs = make(map[uint64]*evmTx)
+		mp.evmTxs[sender] = txs
+	}
+	txs[nonce] = mp.newEVMTx(tx, ethTx, sender)
+	mp.evmCount++
+	return nil
+}
+
+// Select returns an iterator over the transactions to propose, ordered as
+// described on Mempool. Ethereum transactions below the account nonce are
+// pruned, and those behind a nonce gap or with a fee cap below the base fee
+// are skipped.
+func (mp *Mempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	txs := mp.selectTxs(sdk.UnwrapSDKContext(goCtx))
+	if len(txs) == 0 {
+		return nil
+	}
+	return &iterator{txs: txs}
+}
+
+// SelectBy calls callback on each transaction to propose, in the Select
+// order, until it returns false.
+func (mp *Mempool) SelectBy(goCtx context.Context, _ [][]byte, callback func(sdk.Tx) bool) {
+	for _, tx := range mp.selectTxs(sdk.UnwrapSDKContext(goCtx)) {
+		if !callback(tx) {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of transactions in the mempool, including the
+// queued Ethereum transactions.
+func (mp *Mempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.evmCount + mp.cosmosTxs.CountTx()
+}
+
+// Remove removes a transaction from the mempool. An Ethereum transaction is
+// only removed if it is the pooled one for its sender and nonce, so that a
+// replaced transaction does not evict its replacement.
+func (mp *Mempool) Remove(tx sdk.Tx) error {
+	msg, ok := ethereumMsg(tx)
+	if !ok {
+		return mp.removeCosmosTx(tx)
+	}
+
+	ethTx := msg.AsTransaction()
+	if ethTx == nil {
+		return sdkmempool.ErrTxNotFound
+	}
+	sender := msg.GetSender()
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	old, found := mp.evmTxs[sender][ethTx.Nonce()]
+	if !found || old.ethTx.Hash() != ethTx.Hash() {
+		return sdkmempool.ErrTxNotFound
+	}
+	mp.removeEVMTx(sender, ethTx.Nonce())
+	return nil
+}
+
+// queued returns the number of Ethereum transactions from sender queued ahead
+// of the given account nonce. The caller must hold mp.mtx.
+func (mp *Mempool) queued(sender common.Address, accountNonce uint64) uint64 {
+	var count uint64
+	for nonce := range mp.evmTxs[sender] {
+		if nonce > accountNonce {
+			count++
+		}
+	}
+	return count
+}
+
+// Contains returns true if the mempool holds an Ethereum transaction from
+// sender with the given nonce.
+func (mp *Mempool) Contains(sender common.Address, nonce uint64) bool {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.contains(sender, nonce)
+}
+
+// contains is Contains for a caller holding mp.mtx.
+func (mp *Mempool) contains(sender common.Address, nonce uint64) bool {
+	_, found := mp.evmTxs[sender][nonce]
+	return found
+}
+
+// canReplace returns true if newTx pays enough to replace oldTx, following
+// the go-ethereum transaction pool rules.
+func (mp *Mempool) canReplace(oldTx, newTx *ethtypes.Transaction) bool {
+	if newTx.GasFeeCapCmp(oldTx) <= 0 || newTx.GasTipCapCmp(oldTx) <= 0 {
+		return false
+	}
+	bump := new(big.Int).SetUint64(100 + mp.config.PriceBump)
+	hundred := big.NewInt(100)
+	minFeeCap := new(big.Int).Div(new(big.Int).Mul(oldTx.GasFeeCap(), bump), hundred)
+	minTipCap := new(big.Int).Div(new(big.Int).Mul(oldTx.GasTipCap(), bump), hundred)
+	return newTx.GasFeeCapIntCmp(minFeeCap) >= 0 && newTx.GasTipCapIntCmp(minTipCap) >= 0
+}
+
+func (mp *Mempool) newEVMTx(tx sdk.Tx, ethTx *ethtypes.Transaction, sender common.Address) *evmTx {
+	mp.seq++
+	return &evmTx{tx: tx, ethTx: ethTx, sender: sender, seq: mp.seq}
+}
+
+func (mp *Mempool) removeEVMTx(sender common.Address, nonce uint64) {
+	txs := mp.evmTxs[sender]
+	delete(txs, nonce)
+	if len(txs) == 0 {
+		delete(mp.evmTxs, sender)
+	}
+	mp.evmCount--
+}
+
+func (mp *Mempool) insertCosmosTx(goCtx context.Context, tx sdk.Tx) error {
+	key, err := mp.cosmosTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if _, found := mp.cosmosPriorities[key]; !found &&
+		mp.config.MaxTxs > 0 && mp.evmCount+mp.cosmosTxs.CountTx() >= mp.config.MaxTxs {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+	if err := mp.cosmosTxs.Insert(goCtx, tx); err != nil {
+		return err
+	}
+	mp.cosmosPriorities[key] = sdk.UnwrapSDKContext(goCtx).Priority()
+	return nil
+}
+
+func (mp *Mempool) removeCosmosTx(tx sdk.Tx) error {
+	key, err := mp.cosmosTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if err := mp.cosmosTxs.Remove(tx); err != nil {
+		return err
+	}
+	delete(mp.cosmosPriorities, key)
+	return nil
+}
+
+// cosmosTxKey returns the key of a Cosmos transaction, made of its first
+// signer and sequence like in the priority nonce mempool.
+func (mp *Mempool) cosmosTxKey(tx sdk.Tx) (string, error) {
+	signers, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return "", err
+	}
+	if len(signers) == 0 {
+		return "", fmt.Errorf("tx must have at least one signer")
+	}
+	return fmt.Sprintf("%s/%d", signers[0].Signer, signers[0].Sequence), nil
+}
+
+// signerExtractionAdapter extracts the signer of a transaction made of
+// Ethereum messages, which carries no Cosmos signature, from its first
+// message. It falls back to the signatures of the transaction otherwise.
+type signerExtractionAdapter struct {
+	fallback sdkmempool.SignerExtractionAdapter
+}
+
+// GetSigners implements sdkmempool.SignerExtractionAdapter.
+func (a signerExtractionAdapter) GetSigners(tx sdk.Tx) ([]sdkmempool.SignerData, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) > 0 {
+		if msg, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+			ethTx := msg.AsTransaction()
+			if ethTx == nil {
+				return nil, fmt.Errorf("failed to unpack ethereum tx %s", msg.Hash)
+			}
+			return []sdkmempool.SignerData{sdkmempool.NewSignerData(msg.GetFrom(), ethTx.Nonce())}, nil
+		}
+	}
+	return a.fallback.GetSigners(tx)
+}
+
+// selectTxs returns the transactions to propose, in order.
+func (mp *Mempool) selectTxs(ctx sdk.Context) []sdk.Tx {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	baseFee := mp.vmKeeper.GetBaseFee(ctx)
+
+	var heads pendingHeap
+	for sender, txs := range mp.evmTxs {
+		nonce := mp.vmKeeper.GetNonce(ctx, sender)
+		for stale := range txs {
+			if stale < nonce {
+				mp.removeEVMTx(sender, stale)
+			}
+		}
+
+		var pending []*pendingTx
+		for tx, ok := txs[nonce]; ok; tx, ok = txs[nonce] {
+			tip, err := tx.ethTx.EffectiveGasTip(baseFee)
+			if err != nil {
+				// the fee cap is below the base fee, so neither this transaction
+				// nor the next ones from the sender are executable
+				break
+			}
+			pending = append(pending, &pendingTx{tx: tx, tip: tip, priority: tipPriority(tip)})
+			nonce++
+		}
+		if len(pending) > 0 {
+			for i := 0; i < len(pending)-1; i++ {
+				pending[i].next = pending[i+1]
+			}
+			heads = append(heads, pending[0])
+		}
+	}
+	heap.Init(&heads)
+
+	selected := make([]sdk.Tx, 0, mp.evmCount+mp.cosmosTxs.CountTx())
+	cosmosIter := mp.cosmosTxs.Select(ctx, nil)
+	for heads.Len() > 0 || cosmosIter != nil {
+		if cosmosIter != nil && (heads.Len() == 0 || mp.cosmosPriority(cosmosIter.Tx()) > heads[0].priority) {
+			selected = append(selected, cosmosIter.Tx())
+			cosmosIter = cosmosIter.Next()
+			continue
+		}
+
+		head := heap.Pop(&heads).(*pendingTx)
+		selected = append(selected, head.tx.tx)
+		if head.next != nil {
+			heap.Push(&heads, head.next)
+		}
+	}
+
+	return selected
+}
+
+func (mp *Mempool) cosmosPriority(tx sdk.Tx) int64 {
+	key, err := mp.cosmosTxKey(tx)
+	if err != nil {
+		return 0
+	}
+	return mp.cosmosPriorities[key]
+}
+
+// tipPriority converts an effective tip to a priority in the same unit as the
+// one the ante handler sets for Cosmos transactions.
+func tipPriority(tip *big.Int) int64 {
+	priority := new(big.Int).Quo(tip, evmtypes.DefaultPriorityReduction.BigInt())
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+	return priority.Int64()
+}
+
+// ethereumMsg returns the MsgEthereumTx of a transaction made of a single
+// Ethereum message.
+func ethereumMsg(tx sdk.Tx) (*evmtypes.MsgEthereumTx, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, false
+	}
+	msg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	return msg, ok
+}
+
+// pendingTx is an executable Ethereum transaction, linked to the next one of
+// the same sender.
+type pendingTx struct {
+	tx       *evmTx
+	tip      *big.Int
+	priority int64
+	next     *pendingTx
+}
+
+// pendingHeap is a max-heap of pending transactions by effective tip, with
+// ties broken by insertion order.
+type pendingHeap []*pendingTx
+
+func (h pendingHeap) Len() int { return len(h) }
+
+func (h pendingHeap) Less(i, j int) bool {
+	if cmp := h[i].tip.Cmp(h[j].tip); cmp != 0 {
+		return cmp > 0
+	}
+	return h[i].tx.seq < h[j].tx.seq
+}
+
+func (h pendingHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *pendingHeap) Push(x any) { *h = append(*h, x.(*pendingTx)) }
+
+func (h *pendingHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return x
+}
+
+// iterator iterates over a snapshot of the selected transactions.
+type iterator struct {
+	txs []sdk.Tx
+	idx int
+}
+
+var _ sdkmempool.Iterator = (*iterator)(nil)
+
+// Next implements sdkmempool.Iterator.
+func (i *iterator) Next() sdkmempool.Iterator {
+	if i.idx+1 >= len(i.txs) {
+		return nil
+	}
+	return &iterator{txs: i.txs, idx: i.idx + 1}
+}
+
+// Tx implements sdkmempool.Iterator.
+func (i *iterator) Tx() sdk.Tx {
+	return i.txs[i.idx]
+}
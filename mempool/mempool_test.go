@@ -0,0 +1,400 @@
+package mempool_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	gwei    = 1_000_000_000
+	chainID = 9001
+)
+
+var _ mempool.VMKeeper = &mockVMKeeper{}
+
+type mockVMKeeper struct {
+	nonces      map[common.Address]uint64
+	balances    map[common.Address]*uint256.Int
+	baseFee     *big.Int
+	minGasPrice sdkmath.LegacyDec
+}
+
+func newMockVMKeeper(baseFee int64) *mockVMKeeper {
+	return &mockVMKeeper{
+		nonces:      make(map[common.Address]uint64),
+		balances:    make(map[common.Address]*uint256.Int),
+		baseFee:     big.NewInt(baseFee),
+		minGasPrice: sdkmath.LegacyZeroDec(),
+	}
+}
+
+func (k *mockVMKeeper) GetNonce(_ sdk.Context, addr common.Address) uint64 { return k.nonces[addr] }
+func (k *mockVMKeeper) GetBaseFee(_ sdk.Context) *big.Int                  { return k.baseFee }
+func (k *mockVMKeeper) GetMinGasPrice(_ sdk.Context) sdkmath.LegacyDec     { return k.minGasPrice }
+func (k *mockVMKeeper) GetBalance(_ sdk.Context, addr common.Address) *uint256.Int {
+	if balance, ok := k.balances[addr]; ok {
+		return balance
+	}
+	return uint256.NewInt(0)
+}
+
+func (k *mockVMKeeper) GetEthChainConfig(_ sdk.Context) *params.ChainConfig {
+	return evmtypes.DefaultChainConfig(chainID).EthereumConfig(nil)
+}
+
+func (k *mockVMKeeper) GetEVMCoinInfo() evmtypes.EvmCoinInfo {
+	return constants.ExampleChainCoinInfo[constants.ExampleChainID]
+}
+
+// mockCosmosTx is a Cosmos transaction with a single signature.
+type mockCosmosTx struct {
+	pubKey   cryptotypes.PubKey
+	sequence uint64
+}
+
+func (tx mockCosmosTx) GetMsgs() []sdk.Msg                    { return nil }
+func (tx mockCosmosTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockCosmosTx) GetSigners() ([][]byte, error) {
+	return [][]byte{tx.pubKey.Address()}, nil
+}
+
+func (tx mockCosmosTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return []cryptotypes.PubKey{tx.pubKey}, nil
+}
+
+func (tx mockCosmosTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return []signing.SignatureV2{{PubKey: tx.pubKey, Sequence: tx.sequence}}, nil
+}
+
+// mockBatchTx is a transaction made of several Ethereum messages.
+type mockBatchTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockBatchTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockBatchTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+type account struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+}
+
+func newAccount(t *testing.T) account {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return account{key: key, addr: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func signEthTx(t *testing.T, from account, nonce uint64, tipCap, feeCap int64) *evmtypes.MsgEthereumTx {
+	t.Helper()
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(chainID))
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	tx := ethtypes.MustSignNewTx(from.key, signer, &ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(chainID),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tipCap),
+		GasFeeCap: big.NewInt(feeCap),
+		Gas:       21000,
+		To:        &to,
+	})
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromSignedEthereumTx(tx, signer))
+	return msg
+}
+
+func selectTxs(ctx sdk.Context, mp *mempool.Mempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		txs = append(txs, iter.Tx())
+	}
+	return txs
+}
+
+func TestMempoolNonceQueue(t *testing.T) {
+	ctx := sdk.Context{}
+	keeper := newMockVMKeeper(0)
+	mp := mempool.NewMempool(keeper, mempool.DefaultConfig())
+	alice := newAccount(t)
+
+	tx0 := signEthTx(t, alice, 0, gwei, 2*gwei)
+	tx1 := signEthTx(t, alice, 1, gwei, 2*gwei)
+	tx2 := signEthTx(t, alice, 2, gwei, 2*gwei)
+	tx3 := signEthTx(t, alice, 3, gwei, 2*gwei)
+
+	// insert out of order, with a gap at nonce 2
+	require.NoError(t, mp.Insert(ctx, tx1))
+	require.NoError(t, mp.Insert(ctx, tx3))
+	require.NoError(t, mp.Insert(ctx, tx0))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []sdk.Tx{tx0, tx1}, selectTxs(ctx, mp))
+
+	// filling the gap promotes the queued transaction
+	require.NoError(t, mp.Insert(ctx, tx2))
+	require.Equal(t, []sdk.Tx{tx0, tx1, tx2, tx3}, selectTxs(ctx, mp))
+
+	// transactions below the account nonce are pruned
+	keeper.nonces[alice.addr] = 2
+	require.Equal(t, []sdk.Tx{tx2, tx3}, selectTxs(ctx, mp))
+	require.Equal(t, 2, mp.CountTx())
+	require.True(t, mp.Contains(alice.addr, 3))
+	require.False(t, mp.Contains(alice.addr, 0))
+}
+
+func TestMempoolEffectiveTipOrdering(t *testing.T) {
+	ctx := sdk.Context{}
+	keeper := newMockVMKeeper(10 * gwei)
+	mp := mempool.NewMempool(keeper, mempool.DefaultConfig())
+	alice, bob, carol, dave := newAccount(t), newAccount(t), newAccount(t), newAccount(t)
+
+	aliceTx0 := signEthTx(t, alice, 0, 1*gwei, 20*gwei)
+	aliceTx1 := signEthTx(t, alice, 1, 5*gwei, 20*gwei)
+	bobTx := signEthTx(t, bob, 0, 3*gwei, 20*gwei)
+	// the fee cap leaves an effective tip of 2 gwei only
+	carolTx := signEthTx(t, carol, 0, 8*gwei, 12*gwei)
+	// the fee cap is below the base fee
+	daveTx := signEthTx(t, dave, 0, 8*gwei, 9*gwei)
+
+	for _, tx := range []sdk.Tx{aliceTx0, aliceTx1, bobTx, carolTx, daveTx} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	// alice's second transaction pays the most but must follow her first one
+	require.Equal(t, []sdk.Tx{bobTx, carolTx, aliceTx0, aliceTx1}, selectTxs(ctx, mp))
+
+	// once the base fee drops, dave's transaction becomes executable
+	keeper.baseFee = big.NewInt(1 * gwei)
+	require.Equal(t, []sdk.Tx{carolTx, daveTx, bobTx, aliceTx0, aliceTx1}, selectTxs(ctx, mp))
+}
+
+func TestMempoolReplacement(t *testing.T) {
+	ctx := sdk.Context{}
+	mp := mempool.NewMempool(newMockVMKeeper(0), mempool.DefaultConfig())
+	alice := newAccount(t)
+
+	original := signEthTx(t, alice, 0, 10*gwei, 100*gwei)
+	require.NoError(t, mp.Insert(ctx, original))
+	// re-inserting the same transaction is a no-op
+	require.NoError(t, mp.Insert(ctx, original))
+
+	testCases := []struct {
+		name   string
+		tipCap int64
+		feeCap int64
+		expErr error
+	}{
+		{"fail - tip bump too low", 10*gwei + gwei/2, 110 * gwei, mempool.ErrReplacementUnderpriced},
+		{"fail - fee cap bump too low", 11 * gwei, 105 * gwei, mempool.ErrReplacementUnderpriced},
+		{"fail - same fee cap", 20 * gwei, 100 * gwei, mempool.ErrReplacementUnderpriced},
+		{"pass - both bumped by the price bump", 11 * gwei, 110 * gwei, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			replacement := signEthTx(t, alice, 0, tc.tipCap, tc.feeCap)
+			err := mp.Insert(ctx, replacement)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Equal(t, []sdk.Tx{original}, selectTxs(ctx, mp))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, mp.CountTx())
+			require.Equal(t, []sdk.Tx{replacement}, selectTxs(ctx, mp))
+
+			// the replaced transaction cannot evict its replacement
+			require.ErrorIs(t, mp.Remove(original), sdkmempool.ErrTxNotFound)
+			require.NoError(t, mp.Remove(replacement))
+			require.Zero(t, mp.CountTx())
+		})
+	}
+}
+
+func TestMempoolCosmosTxs(t *testing.T) {
+	ctx := sdk.Context{}
+	mp := mempool.NewMempool(newMockVMKeeper(0), mempool.DefaultConfig())
+	alice := newAccount(t)
+	pubKey := secp256k1.GenPrivKey().PubKey()
+
+	// priorities are the effective tip divided by the priority reduction
+	evmTx := signEthTx(t, alice, 0, 3_000_000, 3_000_000)
+	lowTx := mockCosmosTx{pubKey: pubKey, sequence: 0}
+	highTx := mockCosmosTx{pubKey: secp256k1.GenPrivKey().PubKey(), sequence: 0}
+
+	require.NoError(t, mp.Insert(ctx, evmTx))
+	require.NoError(t, mp.Insert(ctx.WithPriority(2), lowTx))
+	require.NoError(t, mp.Insert(ctx.WithPriority(5), highTx))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []sdk.Tx{highTx, evmTx, lowTx}, selectTxs(ctx, mp))
+
+	require.NoError(t, mp.Remove(highTx))
+	require.ErrorIs(t, mp.Remove(highTx), sdkmempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{evmTx, lowTx}, selectTxs(ctx, mp))
+
+	// the transactions of a signer are keyed by sequence
+	nextTx := mockCosmosTx{pubKey: pubKey, sequence: 1}
+	require.NoError(t, mp.Insert(ctx.WithPriority(2), nextTx))
+	require.Equal(t, 3, mp.CountTx())
+	require.NoError(t, mp.Remove(nextTx))
+	require.Equal(t, []sdk.Tx{evmTx, lowTx}, selectTxs(ctx, mp))
+}
+
+func TestMempoolBatchTxs(t *testing.T) {
+	ctx := sdk.Context{}
+	mp := mempool.NewMempool(newMockVMKeeper(0), mempool.DefaultConfig())
+	alice := newAccount(t)
+
+	// a batch is pooled as a Cosmos transaction keyed by its first message
+	batchTx := mockBatchTx{msgs: []sdk.Msg{
+		signEthTx(t, alice, 0, gwei, gwei),
+		signEthTx(t, alice, 1, gwei, gwei),
+	}}
+	require.NoError(t, mp.Insert(ctx, batchTx))
+	require.Equal(t, 1, mp.CountTx())
+	require.False(t, mp.Contains(alice.addr, 0))
+	require.Equal(t, []sdk.Tx{batchTx}, selectTxs(ctx, mp))
+
+	require.NoError(t, mp.Remove(batchTx))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestMempoolMaxTxs(t *testing.T) {
+	ctx := sdk.Context{}
+	config := mempool.DefaultConfig()
+	config.MaxTxs = 2
+	mp := mempool.NewMempool(newMockVMKeeper(0), config)
+	alice := newAccount(t)
+
+	require.NoError(t, mp.Insert(ctx, signEthTx(t, alice, 0, gwei, gwei)))
+	require.NoError(t, mp.Insert(ctx, mockCosmosTx{pubKey: secp256k1.GenPrivKey().PubKey()}))
+	require.ErrorIs(t, mp.Insert(ctx, signEthTx(t, alice, 1, gwei, gwei)), sdkmempool.ErrMempoolTxMaxCapacity)
+	// a replacement does not grow the mempool
+	require.NoError(t, mp.Insert(ctx, signEthTx(t, alice, 0, 2*gwei, 2*gwei)))
+}
+
+func TestCheckTxHandler(t *testing.T) {
+	keeper := newMockVMKeeper(0)
+	keeper.minGasPrice = sdkmath.LegacyNewDec(gwei)
+	config := mempool.DefaultConfig()
+	config.AccountQueue = 2
+	mp := mempool.NewMempool(keeper, config)
+	alice := newAccount(t)
+	keeper.nonces[alice.addr] = 1
+	// enough to pay for three transactions at 1 gwei
+	keeper.balances[alice.addr] = uint256.NewInt(3 * 21000 * gwei)
+
+	pooled := signEthTx(t, alice, 1, gwei, gwei)
+	require.NoError(t, mp.Insert(sdk.Context{}, pooled))
+
+	unsigned := signEthTx(t, alice, 2, gwei, gwei)
+	unsigned.From = common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes()
+
+	replacement := signEthTx(t, alice, 1, 2*gwei, 2*gwei)
+
+	testCases := []struct {
+		name     string
+		tx       *evmtypes.MsgEthereumTx
+		expRunTx bool
+		expCode  uint32
+		expPool  bool
+	}{
+		{"pass - matching nonce goes through runTx", signEthTx(t, alice, 2, gwei, gwei), true, 0, false},
+		{"pass - stale nonce goes through runTx", signEthTx(t, alice, 0, gwei, gwei), true, 0, false},
+		{"fail - fee below the global minimum gas price", signEthTx(t, alice, 3, gwei-1, gwei-1), false, errortypes.ErrInsufficientFee.ABCICode(), false},
+		{"pass - nonce gap is queued", signEthTx(t, alice, 3, gwei, gwei), false, 0, true},
+		{"pass - second queued transaction", signEthTx(t, alice, 4, gwei, gwei), false, 0, true},
+		{"fail - account queue is full", signEthTx(t, alice, 5, gwei, gwei), false, errortypes.ErrMempoolIsFull.ABCICode(), false},
+		{"fail - insufficient balance for the queued transactions", signEthTx(t, alice, 4, 10*gwei, 10*gwei), false, errortypes.ErrInsufficientFunds.ABCICode(), false},
+		{"fail - invalid signature", unsigned, false, errortypes.ErrorInvalidSigner.ABCICode(), false},
+		{"fail - underpriced replacement", signEthTx(t, alice, 1, gwei, gwei+1), false, errortypes.ErrInsufficientFee.ABCICode(), false},
+		{"pass - replacement", replacement, false, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the check state nonce accounts for the pooled transaction
+			keeper.nonces[alice.addr] = 2
+
+			ranTx := false
+			runTx := func(_ []byte, _ sdk.Tx) (sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+				ranTx = true
+				return sdk.GasInfo{}, &sdk.Result{}, nil, nil
+			}
+			decoder := func([]byte) (sdk.Tx, error) { return tc.tx, nil }
+			handler := mempool.NewCheckTxHandler(mp, decoder, func([]byte) sdk.Context { return sdk.Context{} })
+
+			res, err := handler(runTx, &abci.RequestCheckTx{Tx: []byte{1}})
+			require.NoError(t, err)
+			require.Equal(t, tc.expRunTx, ranTx)
+			require.Equal(t, tc.expCode, res.Code, res.Log)
+
+			if tc.expPool {
+				require.True(t, mp.Contains(alice.addr, tc.tx.AsTransaction().Nonce()))
+			}
+		})
+	}
+
+	keeper.nonces[alice.addr] = 1
+	require.Equal(t, []sdk.Tx{replacement}, selectTxs(sdk.Context{}, mp))
+}
+
+func TestCheckTxHandlerConcurrent(t *testing.T) {
+	keeper := newMockVMKeeper(0)
+	config := mempool.DefaultConfig()
+	config.AccountQueue = 1
+	mp := mempool.NewMempool(keeper, config)
+	alice := newAccount(t)
+	keeper.balances[alice.addr] = uint256.NewInt(100 * 21000 * gwei)
+
+	runTx := func(_ []byte, _ sdk.Tx) (sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+		return sdk.GasInfo{}, &sdk.Result{}, nil, nil
+	}
+
+	// the queued transactions race for the single slot of the account queue
+	var (
+		wg    sync.WaitGroup
+		start = make(chan struct{})
+	)
+	for nonce := uint64(1); nonce <= 10; nonce++ {
+		tx := signEthTx(t, alice, nonce, gwei, gwei)
+		decoder := func([]byte) (sdk.Tx, error) { return tx, nil }
+		handler := mempool.NewCheckTxHandler(mp, decoder, func([]byte) sdk.Context { return sdk.Context{} })
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := handler(runTx, &abci.RequestCheckTx{Tx: []byte{1}})
+			require.NoError(t, err)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	require.Equal(t, 1, mp.CountTx())
+}
@@ -0,0 +1,16 @@
+package mempool
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// NewProposalHandler returns the PrepareProposal and ProcessProposal handlers
+// matching the mempool. Proposals are built from Select, so the Ethereum
+// transactions of each sender come in nonce order and senders are ordered by
+// effective tip against the base fee. Both handlers run the ante handler on
+// every transaction, which drops or rejects the ones whose nonce, balance or
+// fee do not match the proposal state, such as queued transactions that went
+// through stateless checks only.
+func NewProposalHandler(mp *Mempool, txVerifier baseapp.ProposalTxVerifier) *baseapp.DefaultProposalHandler {
+	return baseapp.NewDefaultProposalHandler(mp, txVerifier)
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev GrantData defines an authorization given by a granter to a grantee.
+struct GrantData {
+    /// @dev Address of the account giving the authorization
+    address granter;
+    /// @dev Address of the account receiving the authorization
+    address grantee;
+    /// @dev Type URL of the Cosmos message the authorization is given for
+    string msgTypeUrl;
+    /// @dev Maximum amount the grantee can send, only set on send authorizations
+    Coin[] spendLimit;
+    /// @dev Addresses the grantee can send to, only set on send authorizations
+    address[] allowList;
+    /// @dev Unix timestamp at which the authorization expires, 0 if it never expires
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with authz.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IAuthz {
+    /// @dev Emitted when an authorization is granted
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message the authorization is granted for
+    event Grant(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Emitted when an authorization is revoked
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message the authorization was granted for
+    event Revoke(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Emitted when messages are executed on behalf of their signers
+    /// @param grantee The address of the grantee executing the messages
+    /// @param msgTypeUrls The type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// @dev Grant gives the grantee a generic authorization to execute any message of
+    /// the given type on behalf of the granter.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message to authorize, e.g. "/cosmos.staking.v1beta1.MsgDelegate"
+    /// @param expiration The unix timestamp at which the authorization expires, 0 for none
+    /// @return success true if the authorization was granted
+    function grant(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev GrantSend gives the grantee a send authorization to send up to the spend limit
+    /// from the granter's balance.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount the grantee can send
+    /// @param allowList The addresses the grantee can send to, empty for any
+    /// @param expiration The unix timestamp at which the authorization expires, 0 for none
+    /// @return success true if the authorization was granted
+    function grantSend(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revoke removes the authorization given to the grantee for the message type.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message
+    /// @return success true if the authorization was revoked
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Exec executes Cosmos messages on behalf of their signers, using the
+    /// authorizations they granted to the grantee. Messages signed by the grantee
+    /// itself don't need an authorization.
+    /// @param grantee The address of the grantee, which must be the caller
+    /// @param msgs The JSON encoded messages, e.g.
+    /// {"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"...","to_address":"...","amount":[...]}
+    /// @return results The results of the executed messages
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev GetGranterGrants returns the authorizations given by a granter.
+    /// @param granter The address of the granter
+    /// @param pagination Pagination configuration for the query
+    /// @return grants The list of authorizations
+    /// @return pageResponse Pagination information for the response
+    function getGranterGrants(
+        address granter,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev GetGranteeGrants returns the authorizations given to a grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination Pagination configuration for the query
+    /// @return grants The list of authorizations
+    /// @return pageResponse Pagination information for the response
+    function getGranteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
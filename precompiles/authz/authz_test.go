@@ -0,0 +1,32 @@
+package authz
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+)
+
+func TestNewPrecompileAllowedMsgTypes(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	_, err := NewPrecompile(authzkeeper.Keeper{}, nil, addrCodec, DefaultAllowedMsgTypes...)
+	require.NoError(t, err)
+
+	for _, msgTypeURL := range []string{
+		sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+		sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}),
+		sdk.MsgTypeURL(&erc20types.MsgConvertCoin{}),
+	} {
+		allowed := append([]string{}, DefaultAllowedMsgTypes...)
+		_, err := NewPrecompile(authzkeeper.Keeper{}, nil, addrCodec, append(allowed, msgTypeURL)...)
+		require.EqualError(t, err, fmt.Sprintf(ErrReentrantMsgType, msgTypeURL))
+	}
+}
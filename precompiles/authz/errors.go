@@ -0,0 +1,23 @@
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the msg type url is not valid.
+	ErrInvalidMsgTypeURL = "invalid msg type url: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidAllowList is raised when the allow list is not valid.
+	ErrInvalidAllowList = "invalid allow list: %v"
+	// ErrInvalidMsgs is raised when the msgs to execute are not valid.
+	ErrInvalidMsgs = "invalid msgs: %v"
+	// ErrMsgTypeNotAllowed is raised when the msg type cannot be granted nor
+	// executed through the precompile.
+	ErrMsgTypeNotAllowed = "msg type not allowed: %s"
+	// ErrReentrantMsgType is raised when an allowed msg type re-enters the EVM.
+	ErrReentrantMsgType = "msg type re-enters the EVM: %s"
+)
@@ -0,0 +1,171 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// GrantSendMethod defines the ABI method name for the authz GrantSend transaction.
+	GrantSendMethod = "grantSend"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant gives the grantee a generic authorization to execute the msgs of a
+// type on behalf of the granter.
+func (p *Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrant(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr, granteeHexAddr)
+}
+
+// GrantSend gives the grantee a send authorization to send coins from the
+// balance of the granter.
+func (p *Precompile) GrantSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrantSend(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr, granteeHexAddr)
+}
+
+// grant checks the granter is the msg.sender and the authorization is of an
+// allowed msg type, then saves the authorization.
+func (p *Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *authz.MsgGrant,
+	granterHexAddr, granteeHexAddr common.Address,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.validateMsgType(authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.authzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantEvent(ctx, stateDB, granterHexAddr, granteeHexAddr, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the authorization given by the granter to the grantee for
+// the msgs of a type.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	if _, err := p.authzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRevokeEvent(ctx, stateDB, granterHexAddr, granteeHexAddr, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes msgs on behalf of their signers with the authorizations they
+// gave to the grantee. The msgs signed by the grantee don't need one.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, msgTypeURLs, err := NewMsgExec(args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granteeHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granteeHexAddr.String())
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if err := p.validateMsgType(msgTypeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := p.authzKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitExecEvent(ctx, stateDB, granteeHexAddr, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// validateMsgType checks the msg type can be granted and executed through the
+// precompile. Since MsgGrant and MsgExec are never allowed, nested msgs are
+// rejected as well.
+func (p *Precompile) validateMsgType(msgTypeURL string) error {
+	if _, ok := p.allowedMsgTypes[msgTypeURL]; !ok {
+		return fmt.Errorf(ErrMsgTypeNotAllowed, msgTypeURL)
+	}
+	return nil
+}
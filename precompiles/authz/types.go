@@ -0,0 +1,348 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// EventGrant defines the event data for the Grant and GrantSend transactions.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventRevoke defines the event data for the Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Grantee     common.Address
+	MsgTypeUrls []string //nolint:revive
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// GrantsOutput defines the output for the GranterGrants and GranteeGrants queries.
+type GrantsOutput struct {
+	Grants       []GrantData        `abi:"grants"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// GrantData represents an authorization given by a granter to a grantee.
+type GrantData struct {
+	Granter    common.Address   `abi:"granter"`
+	Grantee    common.Address   `abi:"grantee"`
+	MsgTypeUrl string           `abi:"msgTypeUrl"` //nolint:revive
+	SpendLimit []cmn.Coin       `abi:"spendLimit"`
+	AllowList  []common.Address `abi:"allowList"`
+	Expiration int64            `abi:"expiration"`
+}
+
+// NewMsgGrant constructs a MsgGrant with a generic authorization.
+// args: [granter, grantee, msgTypeUrl, expiration]
+func NewMsgGrant(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	return newMsgGrant(args[0], args[1], args[3], authz.NewGenericAuthorization(msgTypeURL), addrCdc)
+}
+
+// NewMsgGrantSend constructs a MsgGrant with a send authorization.
+// args: [granter, grantee, spendLimit, allowList, expiration]
+func NewMsgGrantSend(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	coins, err := cmn.ToCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	allowList, ok := args[3].([]common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAllowList, args[3])
+	}
+
+	allowed := make([]sdk.AccAddress, len(allowList))
+	for i, addr := range allowList {
+		allowed[i] = addr.Bytes()
+	}
+
+	return newMsgGrant(args[0], args[1], args[4], banktypes.NewSendAuthorization(spendLimit.Sort(), allowed), addrCdc)
+}
+
+// newMsgGrant constructs a MsgGrant of the authorization from the granter,
+// grantee and expiration arguments.
+func newMsgGrant(
+	granterArg, granteeArg, expirationArg interface{},
+	authorization authz.Authorization,
+	addrCdc address.Codec,
+) (*authz.MsgGrant, common.Address, common.Address, error) {
+	granter, grantee, err := parseGranterGrantee(granterArg, granteeArg)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	expiration, ok := expirationArg.(int64)
+	if !ok || expiration < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, expirationArg)
+	}
+
+	authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &authz.MsgGrant{
+		Grant: authz.Grant{Authorization: authorizationAny},
+	}
+
+	// a zero expiration is a grant that never expires
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		msg.Grant.Expiration = &expirationTime
+	}
+
+	if msg.Granter, err = addrCdc.BytesToString(granter.Bytes()); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+	if msg.Grantee, err = addrCdc.BytesToString(grantee.Bytes()); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevoke constructs a MsgRevoke.
+// args: [granter, grantee, msgTypeUrl]
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authz.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	msg := &authz.MsgRevoke{MsgTypeUrl: msgTypeURL}
+	if msg.Granter, err = addrCdc.BytesToString(granter.Bytes()); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+	if msg.Grantee, err = addrCdc.BytesToString(grantee.Bytes()); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgExec constructs a MsgExec of the JSON encoded msgs.
+// args: [grantee, msgs]
+func NewMsgExec(args []interface{}, cdc codec.Codec, addrCdc address.Codec) (*authz.MsgExec, common.Address, []string, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	jsonMsgs, ok := args[1].([][]byte)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, "msgs arg")
+	}
+
+	anys := make([]*codectypes.Any, len(jsonMsgs))
+	msgTypeURLs := make([]string, len(jsonMsgs))
+	for i, bz := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+			return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, fmt.Sprintf("message %d: %s", i, err))
+		}
+
+		anyVal, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, common.Address{}, nil, err
+		}
+		anys[i] = anyVal
+		msgTypeURLs[i] = anyVal.TypeUrl
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	msg := &authz.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    anys,
+	}
+
+	return msg, grantee, msgTypeURLs, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments for the GranterGrants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    granter,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments for the GranteeGrants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the GrantsOutput from the grants and pagination of a query response.
+func (o *GrantsOutput) FromResponse(grants []*authz.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		granter, err := utils.HexAddressFromBech32String(grant.Granter)
+		if err != nil {
+			return nil, err
+		}
+		grantee, err := utils.HexAddressFromBech32String(grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+
+		authorization, ok := grant.Authorization.GetCachedValue().(authz.Authorization)
+		if !ok {
+			return nil, fmt.Errorf("invalid authorization type %s", grant.Authorization.TypeUrl)
+		}
+
+		data := GrantData{
+			Granter:    granter,
+			Grantee:    grantee,
+			MsgTypeUrl: authorization.MsgTypeURL(),
+			SpendLimit: []cmn.Coin{},
+			AllowList:  []common.Address{},
+		}
+
+		if sendAuthz, ok := authorization.(*banktypes.SendAuthorization); ok {
+			data.SpendLimit = cmn.NewCoinsResponse(sendAuthz.SpendLimit)
+			for _, allowed := range sendAuthz.AllowList {
+				addr, err := utils.HexAddressFromBech32String(allowed)
+				if err != nil {
+					return nil, err
+				}
+				data.AllowList = append(data.AllowList, addr)
+			}
+		}
+
+		if grant.Expiration != nil {
+			data.Expiration = grant.Expiration.Unix()
+		}
+
+		o.Grants[i] = data
+	}
+
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return o, nil
+}
+
+// parseGranterGrantee parses the granter and grantee address arguments.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
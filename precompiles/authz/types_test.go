@@ -0,0 +1,148 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestNewMsgGrant(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granterAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgTypeURL := "/cosmos.staking.v1beta1.MsgDelegate"
+	expiration := int64(1_700_000_000)
+
+	expectedGranter, err := addrCodec.BytesToString(granterAddr.Bytes())
+	require.NoError(t, err)
+	expectedGrantee, err := addrCodec.BytesToString(granteeAddr.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantExpiraton *time.Time
+	}{
+		{
+			name: "valid without expiration",
+			args: []interface{}{granterAddr, granteeAddr, msgTypeURL, int64(0)},
+		},
+		{
+			name:          "valid with expiration",
+			args:          []interface{}{granterAddr, granteeAddr, msgTypeURL, expiration},
+			wantExpiraton: func() *time.Time { t := time.Unix(expiration, 0).UTC(); return &t }(),
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "invalid granter type",
+			args:    []interface{}{"not-an-address", granteeAddr, msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, "not-an-address"),
+		},
+		{
+			name:    "empty grantee address",
+			args:    []interface{}{granterAddr, common.Address{}, msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			name:    "empty msg type url",
+			args:    []interface{}{granterAddr, granteeAddr, "", int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgTypeURL, ""),
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granterAddr, granteeAddr, msgTypeURL, int64(-1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, int64(-1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granter, grantee, err := NewMsgGrant(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tt.errMsg, err.Error())
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, expectedGranter, msg.Granter)
+				require.Equal(t, expectedGrantee, msg.Grantee)
+				require.Equal(t, granterAddr, granter)
+				require.Equal(t, granteeAddr, grantee)
+				require.Equal(t, tt.wantExpiraton, msg.Grant.Expiration)
+
+				authorization, err := msg.GetAuthorization()
+				require.NoError(t, err)
+				require.Equal(t, authz.NewGenericAuthorization(msgTypeURL), authorization)
+			}
+		})
+	}
+}
+
+func TestNewMsgGrantSend(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granterAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	allowedAddr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	spendLimit := []cmn.Coin{{Denom: "stake", Amount: big.NewInt(1000)}}
+
+	msg, _, _, err := NewMsgGrantSend([]interface{}{granterAddr, granteeAddr, spendLimit, []common.Address{allowedAddr}, int64(0)}, addrCodec)
+	require.NoError(t, err)
+
+	authorization, err := msg.GetAuthorization()
+	require.NoError(t, err)
+	sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+	require.True(t, ok)
+	require.Equal(t, "1000stake", sendAuthz.SpendLimit.String())
+	require.Equal(t, []string{sdk.AccAddress(allowedAddr.Bytes()).String()}, sendAuthz.AllowList)
+
+	_, _, _, err = NewMsgGrantSend([]interface{}{granterAddr, granteeAddr, "not-coins", []common.Address{}, int64(0)}, addrCodec)
+	require.ErrorContains(t, err, "invalid spend limit")
+
+	_, _, _, err = NewMsgGrantSend([]interface{}{granterAddr, granteeAddr, spendLimit, "not-addresses", int64(0)}, addrCodec)
+	require.ErrorContains(t, err, "invalid allow list")
+}
+
+func TestNewMsgRevoke(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granterAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgTypeURL := "/cosmos.staking.v1beta1.MsgDelegate"
+
+	msg, granter, grantee, err := NewMsgRevoke([]interface{}{granterAddr, granteeAddr, msgTypeURL}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, msgTypeURL, msg.MsgTypeUrl)
+	require.Equal(t, granterAddr, granter)
+	require.Equal(t, granteeAddr, grantee)
+
+	_, _, _, err = NewMsgRevoke([]interface{}{granterAddr, granteeAddr}, addrCodec)
+	require.EqualError(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2))
+
+	_, _, _, err = NewMsgRevoke([]interface{}{granterAddr, granteeAddr, ""}, addrCodec)
+	require.EqualError(t, err, fmt.Sprintf(ErrInvalidMsgTypeURL, ""))
+}
@@ -31,7 +31,7 @@ var (
 
 // PrecompileMetaData contains all meta data concerning the Precompile contract.
 var PrecompileMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"acknowledgement\",\"type\":\"bytes\"}],\"name\":\"onPacketAcknowledgement\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onPacketTimeout\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"acknowledgement\",\"type\":\"bytes\"}],\"name\":\"onPacketAcknowledgement\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onPacketTimeout\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"validatorAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"onUnbondingCompleted\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // PrecompileABI is the input ABI used to generate the binding from.
@@ -221,3 +221,24 @@ func (_Precompile *PrecompileSession) OnPacketTimeout(channelId string, portId s
 func (_Precompile *PrecompileTransactorSession) OnPacketTimeout(channelId string, portId string, sequence uint64, data []byte) (*types.Transaction, error) {
 	return _Precompile.Contract.OnPacketTimeout(&_Precompile.TransactOpts, channelId, portId, sequence, data)
 }
+
+// OnUnbondingCompleted is a paid mutator transaction binding the contract method 0x7aa7cd61.
+//
+// Solidity: function onUnbondingCompleted(string validatorAddress, uint256 amount) returns()
+func (_Precompile *PrecompileTransactor) OnUnbondingCompleted(opts *bind.TransactOpts, validatorAddress string, amount *big.Int) (*types.Transaction, error) {
+	return _Precompile.contract.Transact(opts, "onUnbondingCompleted", validatorAddress, amount)
+}
+
+// OnUnbondingCompleted is a paid mutator transaction binding the contract method 0x7aa7cd61.
+//
+// Solidity: function onUnbondingCompleted(string validatorAddress, uint256 amount) returns()
+func (_Precompile *PrecompileSession) OnUnbondingCompleted(validatorAddress string, amount *big.Int) (*types.Transaction, error) {
+	return _Precompile.Contract.OnUnbondingCompleted(&_Precompile.TransactOpts, validatorAddress, amount)
+}
+
+// OnUnbondingCompleted is a paid mutator transaction binding the contract method 0x7aa7cd61.
+//
+// Solidity: function onUnbondingCompleted(string validatorAddress, uint256 amount) returns()
+func (_Precompile *PrecompileTransactorSession) OnUnbondingCompleted(validatorAddress string, amount *big.Int) (*types.Transaction, error) {
+	return _Precompile.Contract.OnUnbondingCompleted(&_Precompile.TransactOpts, validatorAddress, amount)
+}
@@ -39,6 +39,7 @@ func (bh *BalanceHandler) BeforeBalanceChange(ctx sdk.Context) {
 // of the spender and receiver addresses respectively.
 func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.StateDB) error {
 	events := ctx.EventManager().Events()
+	coinInfo := stateDB.EVMAppConfig().CoinInfo
 
 	for _, event := range events[bh.prevEventsLen:] {
 		switch event.Type {
@@ -48,7 +49,7 @@ func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.S
 				return fmt.Errorf("failed to parse spender address from event %q: %w", banktypes.EventTypeCoinSpent, err)
 			}
 
-			amount, err := parseAmount(event)
+			amount, err := parseAmount(event, coinInfo)
 			if err != nil {
 				return fmt.Errorf("failed to parse amount from event %q: %w", banktypes.EventTypeCoinSpent, err)
 			}
@@ -61,7 +62,7 @@ func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.S
 				return fmt.Errorf("failed to parse receiver address from event %q: %w", banktypes.EventTypeCoinReceived, err)
 			}
 
-			amount, err := parseAmount(event)
+			amount, err := parseAmount(event, coinInfo)
 			if err != nil {
 				return fmt.Errorf("failed to parse amount from event %q: %w", banktypes.EventTypeCoinReceived, err)
 			}
@@ -87,7 +88,7 @@ func parseHexAddress(event sdk.Event, key string) (common.Address, error) {
 	return common.BytesToAddress(accAddr), nil
 }
 
-func parseAmount(event sdk.Event) (*uint256.Int, error) {
+func parseAmount(event sdk.Event, coinInfo evmtypes.EvmCoinInfo) (*uint256.Int, error) {
 	amountAttr, ok := event.GetAttribute(sdk.AttributeKeyAmount)
 	if !ok {
 		return nil, fmt.Errorf("event %q missing attribute %q", banktypes.EventTypeCoinSpent, sdk.AttributeKeyAmount)
@@ -98,8 +99,8 @@ func parseAmount(event sdk.Event) (*uint256.Int, error) {
 		return nil, fmt.Errorf("failed to parse coins from %q: %w", amountAttr.Value, err)
 	}
 
-	amountBigInt := amountCoins.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt()
-	amount, err := utils.Uint256FromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(amountBigInt))
+	amountBigInt := amountCoins.AmountOf(coinInfo.Denom).BigInt()
+	amount, err := utils.Uint256FromBigInt(coinInfo.ConvertAmountTo18DecimalsBigInt(amountBigInt))
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert coin amount to Uint256: %w", err)
 	}
@@ -135,7 +135,7 @@ func TestParseAmount(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			setupBalanceHandlerTest(t)
 
-			amt, err := parseAmount(tc.maleate())
+			amt, err := parseAmount(tc.maleate(), evmtypes.GetEVMCoinInfo())
 			if tc.expError {
 				require.Error(t, err)
 				return
@@ -10,6 +10,7 @@ import (
 	"github.com/holiman/uint256"
 
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -231,3 +232,13 @@ func (p *Precompile) GetBalanceHandler() *BalanceHandler {
 	}
 	return p.balanceHandler
 }
+
+// GetEVMAppConfig returns the EVM coin and chain configuration of the app the
+// given state belongs to. It defaults to the one set on startup through the
+// EVMConfigurator for other StateDB implementations.
+func GetEVMAppConfig(stateDB vm.StateDB) *evmtypes.EVMAppConfig {
+	if s, ok := stateDB.(*statedb.StateDB); ok {
+		return s.EVMAppConfig()
+	}
+	return evmtypes.GlobalEVMAppConfig()
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Transfer With Authorization Interface
+ * @dev Interface for the transfers authorized via signatures, as defined in
+ * https://eips.ethereum.org/EIPS/eip-3009[EIP-3009].
+ */
+interface IERC3009 {
+    /// @dev Emitted when an authorization is used.
+    /// @param authorizer The address of the account that signed the authorization.
+    /// @param nonce The unique nonce of the authorization.
+    event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce);
+
+    /// @dev Executes a transfer with a signed authorization of the sender. The
+    /// signature is checked against the EIP712 domain of the token.
+    /// @param from The address of the payer, which signed the authorization.
+    /// @param to The address of the payee.
+    /// @param value The amount of tokens to transfer.
+    /// @param validAfter The time after which the authorization is valid (unix time).
+    /// @param validBefore The time before which the authorization is valid (unix time).
+    /// @param nonce The unique nonce of the authorization.
+    /// @param v The recovery byte of the signature.
+    /// @param r The first half of the ECDSA signature.
+    /// @param s The second half of the ECDSA signature.
+    function transferWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /// @dev Returns the state of an authorization.
+    /// @param authorizer The address of the account that signed the authorization.
+    /// @param nonce The unique nonce of the authorization.
+    /// @return used True if the authorization has been used.
+    function authorizationState(
+        address authorizer,
+        bytes32 nonce
+    ) external view returns (bool used);
+}
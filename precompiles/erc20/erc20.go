@@ -40,6 +40,15 @@ const (
 	GasTotalSupply       = 2_480
 	GasBalanceOf         = 2_870
 	GasAllowance         = 3_225
+
+	// NOTE: The gas values of the EIP-2612 and EIP-3009 transactions add the cost of
+	// the ecrecover precompile to the ones of the approve and transfer transactions.
+
+	GasPermit                    = 11_100
+	GasTransferWithAuthorization = 12_000
+	GasNonces                    = 2_610
+	GasDomainSeparator           = 3_421
+	GasAuthorizationState        = 2_610
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -116,6 +125,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasIncreaseAllowance
 	case DecreaseAllowanceMethod:
 		return GasDecreaseAllowance
+	case PermitMethod:
+		return GasPermit
+	case TransferWithAuthorizationMethod:
+		return GasTransferWithAuthorization
 	// ERC-20 queries
 	case NameMethod:
 		return GasName
@@ -129,6 +142,12 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case AllowanceMethod:
 		return GasAllowance
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
+	case AuthorizationStateMethod:
+		return GasAuthorizationState
 	default:
 		return 0
 	}
@@ -192,7 +211,9 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		TransferFromMethod,
 		ApproveMethod,
 		IncreaseAllowanceMethod,
-		DecreaseAllowanceMethod:
+		DecreaseAllowanceMethod,
+		PermitMethod,
+		TransferWithAuthorizationMethod:
 		return true
 	default:
 		return false
@@ -219,6 +240,10 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
 	case DecreaseAllowanceMethod:
 		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case TransferWithAuthorizationMethod:
+		bz, err = p.TransferWithAuthorization(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
@@ -232,6 +257,12 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
+	case AuthorizationStateMethod:
+		bz, err = p.AuthorizationState(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
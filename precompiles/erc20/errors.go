@@ -26,11 +26,22 @@ var (
 	ErrIncreaseNonPositiveValue = errors.New("cannot increase allowance with non-positive values")
 	ErrNegativeAmount           = errors.New("cannot approve negative values")
 	ErrSpenderIsOwner           = errors.New("spender cannot be the owner")
+	ErrInvalidSignatureValues   = errors.New("invalid signature values")
 
 	// ERC20 errors
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+
+	// EIP-2612 errors
+	ErrPermitExpired          = errors.New("ERC20Permit: expired deadline")
+	ErrPermitInvalidSignature = errors.New("ERC20Permit: invalid signature")
+
+	// EIP-3009 errors
+	ErrAuthorizationNotYetValid      = errors.New("EIP3009: authorization is not yet valid")
+	ErrAuthorizationExpired          = errors.New("EIP3009: authorization is expired")
+	ErrAuthorizationUsed             = errors.New("EIP3009: authorization is used")
+	ErrAuthorizationInvalidSignature = errors.New("EIP3009: invalid signature")
 )
 
 // ConvertErrToERC20Error is a helper function which maps errors raised by the Cosmos SDK stack
@@ -19,6 +19,9 @@ const (
 
 	// EventTypeApproval defines the event type for the ERC-20 Approval event.
 	EventTypeApproval = "Approval"
+
+	// EventTypeAuthorizationUsed defines the event type for the EIP-3009 AuthorizationUsed event.
+	EventTypeAuthorizationUsed = "AuthorizationUsed"
 )
 
 // EmitTransferEvent creates a new Transfer event emitted on transfer and transferFrom transactions.
@@ -93,3 +96,31 @@ func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner
 
 	return nil
 }
+
+// EmitAuthorizationUsedEvent creates a new AuthorizationUsed event emitted on
+// TransferWithAuthorization transactions.
+func (p Precompile) EmitAuthorizationUsedEvent(ctx sdk.Context, stateDB vm.StateDB, authorizer common.Address, nonce common.Hash) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeAuthorizationUsed]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(authorizer)
+	if err != nil {
+		return err
+	}
+
+	topics[2] = nonce
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
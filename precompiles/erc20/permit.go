@@ -0,0 +1,268 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the EIP-2612 nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+	// TransferWithAuthorizationMethod defines the ABI method name for the
+	// EIP-3009 transferWithAuthorization transaction.
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	// AuthorizationStateMethod defines the ABI method name for the EIP-3009
+	// authorizationState query.
+	AuthorizationStateMethod = "authorizationState"
+
+	// domainVersion is the version of the EIP-712 domain of the token.
+	domainVersion = "1"
+)
+
+var (
+	// domainTypeHash is the EIP-712 type hash of the domain of the token.
+	domainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// permitTypeHash is the EIP-712 type hash of the EIP-2612 permit.
+	permitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+	// transferWithAuthorizationTypeHash is the EIP-712 type hash of the EIP-3009 transfer authorization.
+	transferWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's signed approval. The signature must be made over
+// the owner's current nonce, which is then increased. It emits the Approval
+// event on success.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParsePermitArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpired
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx, stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), input.Owner)
+	structHash := crypto.Keccak256Hash(
+		permitTypeHash.Bytes(),
+		common.LeftPadBytes(input.Owner.Bytes(), 32),
+		common.LeftPadBytes(input.Spender.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(input.Value)),
+		math.U256Bytes(new(big.Int).SetUint64(nonce)),
+		math.U256Bytes(new(big.Int).Set(input.Deadline)),
+	)
+
+	signer, err := recoverSigner(domainSeparator, structHash, input.V, input.R, input.S)
+	if err != nil || signer != input.Owner {
+		return nil, ErrPermitInvalidSignature
+	}
+
+	p.erc20Keeper.SetPermitNonce(ctx, p.Address(), input.Owner, nonce+1)
+
+	if err := p.setAllowance(ctx, input.Owner, input.Spender, input.Value); err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, input.Owner, input.Spender, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current EIP-2612 permit nonce of the owner.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator of the token, used in
+// the signatures of the permits and transfer authorizations.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx, stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(domainSeparator)
+}
+
+// TransferWithAuthorization executes a transfer from the authorizer to the
+// destination address, given the authorizer's signed authorization. Each
+// authorization can only be used once, within its validity period. It emits
+// the AuthorizationUsed and Transfer events on success.
+func (p *Precompile) TransferWithAuthorization(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseTransferWithAuthorizationArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := big.NewInt(ctx.BlockTime().Unix())
+	if blockTime.Cmp(input.ValidAfter) <= 0 {
+		return nil, ErrAuthorizationNotYetValid
+	}
+	if blockTime.Cmp(input.ValidBefore) >= 0 {
+		return nil, ErrAuthorizationExpired
+	}
+
+	nonce := common.Hash(input.Nonce)
+	if p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), input.From, nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx, stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	structHash := crypto.Keccak256Hash(
+		transferWithAuthorizationTypeHash.Bytes(),
+		common.LeftPadBytes(input.From.Bytes(), 32),
+		common.LeftPadBytes(input.To.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(input.Value)),
+		math.U256Bytes(new(big.Int).Set(input.ValidAfter)),
+		math.U256Bytes(new(big.Int).Set(input.ValidBefore)),
+		nonce.Bytes(),
+	)
+
+	signer, err := recoverSigner(domainSeparator, structHash, input.V, input.R, input.S)
+	if err != nil || signer != input.From {
+		return nil, ErrAuthorizationInvalidSignature
+	}
+
+	p.erc20Keeper.SetAuthorizationUsed(ctx, p.Address(), input.From, nonce)
+
+	if err := p.EmitAuthorizationUsedEvent(ctx, stateDB, input.From, nonce); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: sdkmath.NewIntFromBigInt(input.Value)}}
+	msg := banktypes.NewMsgSend(input.From.Bytes(), input.To.Bytes(), coins)
+	if err := msg.Amount.Validate(); err != nil {
+		return nil, err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.BankKeeper)
+	if _, err := msgSrv.Send(ctx, msg); err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	if err := p.EmitTransferEvent(ctx, stateDB, input.From, input.To, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// AuthorizationState returns true if the EIP-3009 authorization with the given
+// nonce of the authorizer has been used.
+func (p Precompile) AuthorizationState(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, err := ParseAuthorizationStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	used := p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), authorizer, nonce)
+
+	return method.Outputs.Pack(used)
+}
+
+// domainSeparator returns the EIP-712 domain separator of the token, made of
+// its name, the chain id and the precompile address.
+func (p Precompile) domainSeparator(ctx sdk.Context, stateDB vm.StateDB) (common.Hash, error) {
+	name, err := p.name(ctx)
+	if err != nil {
+		return common.Hash{}, ConvertErrToERC20Error(err)
+	}
+
+	return crypto.Keccak256Hash(
+		domainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(domainVersion)),
+		math.U256Bytes(new(big.Int).SetUint64(cmn.GetEVMAppConfig(stateDB).ChainConfig.ChainId)),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	), nil
+}
+
+// recoverSigner returns the address that signed the EIP-712 typed data with the
+// given domain separator and struct hash. Only signatures in the lower half of
+// the curve order are accepted to prevent their malleability.
+func recoverSigner(domainSeparator, structHash common.Hash, v uint8, r, s [32]byte) (common.Address, error) {
+	if v < 27 || !crypto.ValidateSignatureValues(v-27, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, ErrInvalidSignatureValues
+	}
+
+	digest := crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator.Bytes(), structHash.Bytes())
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], r[:])
+	copy(sig[32:64], s[:])
+	sig[64] = v - 27
+
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -21,6 +22,37 @@ type EventApproval struct {
 	Value   *big.Int
 }
 
+// EventAuthorizationUsed defines the event data for the EIP-3009 AuthorizationUsed events.
+type EventAuthorizationUsed struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+}
+
+// PermitInput defines the input arguments of the EIP-2612 permit method.
+type PermitInput struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Deadline *big.Int
+	V        uint8
+	R        [32]byte
+	S        [32]byte
+}
+
+// TransferWithAuthorizationInput defines the input arguments of the EIP-3009
+// transferWithAuthorization method.
+type TransferWithAuthorizationInput struct {
+	From        common.Address
+	To          common.Address
+	Value       *big.Int
+	ValidAfter  *big.Int
+	ValidBefore *big.Int
+	Nonce       [32]byte
+	V           uint8
+	R           [32]byte
+	S           [32]byte
+}
+
 // ParseTransferArgs parses the arguments from the transfer method and returns
 // the destination address (to) and amount.
 func ParseTransferArgs(args []interface{}) (
@@ -127,3 +159,68 @@ func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
 
 	return account, nil
 }
+
+// ParsePermitArgs parses the arguments from the permit method.
+func ParsePermitArgs(method *abi.Method, args []interface{}) (*PermitInput, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 7; got: %d", len(args))
+	}
+
+	var input PermitInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PermitInput: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseNoncesArgs parses the nonces arguments and returns the owner address.
+func ParseNoncesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseTransferWithAuthorizationArgs parses the arguments from the
+// transferWithAuthorization method.
+func ParseTransferWithAuthorizationArgs(method *abi.Method, args []interface{}) (*TransferWithAuthorizationInput, error) {
+	if len(args) != 9 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 9; got: %d", len(args))
+	}
+
+	var input TransferWithAuthorizationInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to TransferWithAuthorizationInput: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseAuthorizationStateArgs parses the authorizationState arguments and
+// returns the authorizer address and the nonce.
+func ParseAuthorizationStateArgs(args []interface{}) (
+	authorizer common.Address, nonce common.Hash, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	authorizer, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid authorizer address: %v", args[0])
+	}
+
+	nonceBz, ok := args[1].([32]byte)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid nonce: %v", args[1])
+	}
+
+	return authorizer, nonceBz, nil
+}
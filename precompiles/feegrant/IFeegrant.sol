@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev FeeAllowance defines an allowance given by a granter to pay the fees of a grantee.
+struct FeeAllowance {
+    /// @dev Address of the account paying the fees
+    address granter;
+    /// @dev Address of the account whose fees are paid
+    address grantee;
+    /// @dev Maximum amount of fees the grantee can use, empty for no limit
+    Coin[] spendLimit;
+    /// @dev Unix timestamp at which the allowance expires, 0 if it never expires
+    int64 expiration;
+    /// @dev Duration of a period in seconds, 0 for a basic allowance
+    int64 period;
+    /// @dev Maximum amount of fees the grantee can use in a period
+    Coin[] periodSpendLimit;
+    /// @dev Amount of fees the grantee can still use in the current period
+    Coin[] periodCanSpend;
+    /// @dev Unix timestamp at which the current period ends
+    int64 periodReset;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with feegrant.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event GrantAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Emitted when a fee allowance is revoked
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev GrantAllowance gives the grantee an allowance to pay its transaction fees from
+    /// the balance of the granter. The allowance is periodic if the period is not 0 and basic
+    /// otherwise.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of fees the grantee can use, empty for no limit
+    /// @param expiration The unix timestamp at which the allowance expires, 0 for none
+    /// @param period The duration of a period in seconds, 0 for a basic allowance
+    /// @param periodSpendLimit The maximum amount of fees the grantee can use in a period
+    /// @return success true if the allowance was granted
+    function grantAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev RevokeAllowance removes the allowance given by the granter to the grantee.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @return success true if the allowance was revoked
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev Allowance returns the allowance given by the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (FeeAllowance memory allowance);
+
+    /// @dev Allowances returns the allowances given to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination Pagination configuration for the query
+    /// @return allowances The list of fee allowances
+    /// @return pageResponse Pagination information for the response
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (FeeAllowance[] memory allowances, PageResponse memory pageResponse);
+}
@@ -0,0 +1,16 @@
+package feegrant
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidPeriod is raised when the period is not valid.
+	ErrInvalidPeriod = "invalid period: %v"
+	// ErrInvalidPeriodSpendLimit is raised when the period spend limit is not valid.
+	ErrInvalidPeriodSpendLimit = "invalid period spend limit: %v"
+)
@@ -0,0 +1,316 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// EventGrantAllowance defines the event data for the GrantAllowance transaction.
+type EventGrantAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// EventRevokeAllowance defines the event data for the RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowanceOutput defines the output for the Allowance query.
+type AllowanceOutput struct {
+	Allowance FeeAllowance
+}
+
+// AllowancesOutput defines the output for the Allowances query.
+type AllowancesOutput struct {
+	Allowances   []FeeAllowance     `abi:"allowances"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// FeeAllowance represents an allowance given by a granter to pay the fees of a grantee.
+type FeeAllowance struct {
+	Granter          common.Address `abi:"granter"`
+	Grantee          common.Address `abi:"grantee"`
+	SpendLimit       []cmn.Coin     `abi:"spendLimit"`
+	Expiration       int64          `abi:"expiration"`
+	Period           int64          `abi:"period"`
+	PeriodSpendLimit []cmn.Coin     `abi:"periodSpendLimit"`
+	PeriodCanSpend   []cmn.Coin     `abi:"periodCanSpend"`
+	PeriodReset      int64          `abi:"periodReset"`
+}
+
+// NewMsgGrantAllowance constructs a MsgGrantAllowance with a basic allowance, or
+// a periodic one if the period is not zero.
+// args: [granter, grantee, spendLimit, expiration, period, periodSpendLimit]
+func NewMsgGrantAllowance(
+	args []interface{},
+	blockTime time.Time,
+	addrCdc address.Codec,
+) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	spendLimit, err := parseCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok || expiration < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	period, ok := args[4].(int64)
+	if !ok || period < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriod, args[4])
+	}
+
+	periodSpendLimit, err := parseCoins(args[5])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriodSpendLimit, err)
+	}
+
+	basic := feegrant.BasicAllowance{SpendLimit: spendLimit}
+	// a zero expiration is an allowance that never expires
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &expirationTime
+	}
+
+	var allowance proto.Message = &basic
+	if period != 0 {
+		periodDuration := time.Duration(period) * time.Second
+		allowance = &feegrant.PeriodicAllowance{
+			Basic:            basic,
+			Period:           periodDuration,
+			PeriodSpendLimit: periodSpendLimit,
+			PeriodCanSpend:   periodSpendLimit,
+			PeriodReset:      blockTime.Add(periodDuration),
+		}
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(allowance)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &feegrant.MsgGrantAllowance{Allowance: allowanceAny}
+	if msg.Granter, err = addrCdc.BytesToString(granter.Bytes()); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+	if msg.Grantee, err = addrCdc.BytesToString(grantee.Bytes()); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevokeAllowance constructs a MsgRevokeAllowance.
+// args: [granter, grantee]
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &feegrant.MsgRevokeAllowance{}
+	if msg.Granter, err = addrCdc.BytesToString(granter.Bytes()); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+	if msg.Grantee, err = addrCdc.BytesToString(grantee.Bytes()); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// ParseAllowanceArgs parses the arguments for the Allowance query.
+func ParseAllowanceArgs(args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	req := &feegrant.QueryAllowanceRequest{}
+	if req.Granter, err = addrCdc.BytesToString(granter.Bytes()); err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+	if req.Grantee, err = addrCdc.BytesToString(grantee.Bytes()); err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return req, nil
+}
+
+// ParseAllowancesArgs parses the arguments for the Allowances query.
+func ParseAllowancesArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the AllowanceOutput from a query response.
+func (o *AllowanceOutput) FromResponse(res *feegrant.QueryAllowanceResponse) (*AllowanceOutput, error) {
+	allowance, err := NewFeeAllowance(res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	o.Allowance = allowance
+	return o, nil
+}
+
+// FromResponse populates the AllowancesOutput from a query response.
+func (o *AllowancesOutput) FromResponse(res *feegrant.QueryAllowancesResponse) (*AllowancesOutput, error) {
+	o.Allowances = make([]FeeAllowance, len(res.Allowances))
+	for i, grant := range res.Allowances {
+		allowance, err := NewFeeAllowance(grant)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = allowance
+	}
+
+	if res.Pagination != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return o, nil
+}
+
+// NewFeeAllowance converts a feegrant Grant to a FeeAllowance. The allowance
+// wrapped by an allowed msg allowance is returned without the msg restrictions.
+func NewFeeAllowance(grant *feegrant.Grant) (FeeAllowance, error) {
+	granter, err := utils.HexAddressFromBech32String(grant.Granter)
+	if err != nil {
+		return FeeAllowance{}, err
+	}
+	grantee, err := utils.HexAddressFromBech32String(grant.Grantee)
+	if err != nil {
+		return FeeAllowance{}, err
+	}
+
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return FeeAllowance{}, err
+	}
+	if allowedMsgs, ok := allowance.(*feegrant.AllowedMsgAllowance); ok {
+		if allowance, err = allowedMsgs.GetAllowance(); err != nil {
+			return FeeAllowance{}, err
+		}
+	}
+
+	out := FeeAllowance{
+		Granter:          granter,
+		Grantee:          grantee,
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+	}
+
+	var basic feegrant.BasicAllowance
+	switch allowance := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		basic = *allowance
+	case *feegrant.PeriodicAllowance:
+		basic = allowance.Basic
+		out.Period = int64(allowance.Period.Seconds())
+		out.PeriodSpendLimit = cmn.NewCoinsResponse(allowance.PeriodSpendLimit)
+		out.PeriodCanSpend = cmn.NewCoinsResponse(allowance.PeriodCanSpend)
+		out.PeriodReset = allowance.PeriodReset.Unix()
+	default:
+		return FeeAllowance{}, fmt.Errorf("unsupported allowance type %T", allowance)
+	}
+
+	out.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		out.Expiration = basic.Expiration.Unix()
+	}
+
+	return out, nil
+}
+
+// parseCoins parses a coins argument, an empty list being no coins.
+func parseCoins(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(coins) == 0 {
+		return nil, nil
+	}
+
+	sdkCoins, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, err
+	}
+	return sdkCoins.Sort(), nil
+}
+
+// parseGranterGrantee parses the granter and grantee address arguments.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
@@ -0,0 +1,162 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+)
+
+func TestNewMsgGrantAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granterAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	expiration := int64(1_800_000_000)
+	coins := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(100)}}
+	noCoins := []cmn.Coin{}
+
+	expectedGranter, err := addrCodec.BytesToString(granterAddr.Bytes())
+	require.NoError(t, err)
+	expectedGrantee, err := addrCodec.BytesToString(granteeAddr.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantAllowance feegrant.FeeAllowanceI
+	}{
+		{
+			name:          "valid basic allowance without limits",
+			args:          []interface{}{granterAddr, granteeAddr, noCoins, int64(0), int64(0), noCoins},
+			wantAllowance: &feegrant.BasicAllowance{},
+		},
+		{
+			name: "valid basic allowance with spend limit and expiration",
+			args: []interface{}{granterAddr, granteeAddr, coins, expiration, int64(0), noCoins},
+			wantAllowance: &feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(100))),
+				Expiration: func() *time.Time { t := time.Unix(expiration, 0).UTC(); return &t }(),
+			},
+		},
+		{
+			name: "valid periodic allowance",
+			args: []interface{}{granterAddr, granteeAddr, noCoins, int64(0), int64(3600), coins},
+			wantAllowance: &feegrant.PeriodicAllowance{
+				Period:           time.Hour,
+				PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(100))),
+				PeriodCanSpend:   sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(100))),
+				PeriodReset:      blockTime.Add(time.Hour),
+			},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			name:    "invalid granter type",
+			args:    []interface{}{"not-an-address", granteeAddr, noCoins, int64(0), int64(0), noCoins},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, "not-an-address"),
+		},
+		{
+			name:    "empty grantee address",
+			args:    []interface{}{granterAddr, common.Address{}, noCoins, int64(0), int64(0), noCoins},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granterAddr, granteeAddr, noCoins, int64(-1), int64(0), noCoins},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, int64(-1)),
+		},
+		{
+			name:    "negative period",
+			args:    []interface{}{granterAddr, granteeAddr, noCoins, int64(0), int64(-1), noCoins},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPeriod, int64(-1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granter, grantee, err := NewMsgGrantAllowance(tt.args, blockTime, addrCodec)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.EqualError(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granterAddr, granter)
+			require.Equal(t, granteeAddr, grantee)
+			require.Equal(t, expectedGranter, msg.Granter)
+			require.Equal(t, expectedGrantee, msg.Grantee)
+			require.Equal(t, tt.wantAllowance, msg.Allowance.GetCachedValue())
+		})
+	}
+}
+
+func TestNewMsgRevokeAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granterAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granterAddr, granteeAddr},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:    "empty granter address",
+			args:    []interface{}{common.Address{}, granteeAddr},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, common.Address{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granter, grantee, err := NewMsgRevokeAllowance(tt.args, addrCodec)
+			if tt.wantErr {
+				require.EqualError(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granterAddr, granter)
+			require.Equal(t, granteeAddr, grantee)
+		})
+	}
+}
@@ -216,6 +216,14 @@ interface StakingI {
         uint256 creationHeight
     ) external returns (bool success);
 
+    /// @dev Registers or unregisters the caller for the onUnbondingCompleted callback
+    /// of the ICallbacks interface, which is called in the end block in which an
+    /// unbonding delegation of the caller is completed. It can be called from the
+    /// constructor of a contract.
+    /// @param enabled Whether the callback is enabled or disabled
+    /// @return success Whether or not the registration was updated
+    function setUnbondingCallback(bool enabled) external returns (bool success);
+
     /// @dev Queries the given amount of the bond denomination to a validator.
     /// @param delegatorAddress The address of the delegator.
     /// @param validatorAddress The address of the validator.
@@ -295,6 +303,13 @@ interface StakingI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev Queries whether the given address is registered for the onUnbondingCompleted callback.
+    /// @param delegatorAddress The address of the delegator
+    /// @return registered Whether or not the callback is enabled for the delegator
+    function hasUnbondingCallback(
+        address delegatorAddress
+    ) external view returns (bool registered);
+
     /// @dev CreateValidator defines an Event emitted when a create a new validator.
     /// @param validatorAddress The address of the validator
     /// @param value The amount of coin being self delegated
@@ -367,4 +382,10 @@ interface StakingI {
         uint256 amount,
         uint256 creationHeight
     );
+
+    /// @dev SetUnbondingCallback defines an Event emitted when a delegator registers or
+    /// unregisters for the onUnbondingCompleted callback.
+    /// @param delegatorAddress The address of the delegator
+    /// @param enabled Whether the callback is enabled or disabled
+    event SetUnbondingCallback(address indexed delegatorAddress, bool enabled);
 }
@@ -29,6 +29,8 @@ const (
 	EventTypeRedelegate = "Redelegate"
 	// EventTypeCancelUnbondingDelegation defines the event type for the staking CancelUnbondingDelegation transaction.
 	EventTypeCancelUnbondingDelegation = "CancelUnbondingDelegation"
+	// EventTypeSetUnbondingCallback defines the event type for the staking SetUnbondingCallback transaction.
+	EventTypeSetUnbondingCallback = "SetUnbondingCallback"
 )
 
 // EmitCreateValidatorEvent creates a new create validator event emitted on a CreateValidator transaction.
@@ -226,6 +228,37 @@ func (p Precompile) EmitCancelUnbondingDelegationEvent(ctx sdk.Context, stateDB
 	return nil
 }
 
+// EmitSetUnbondingCallbackEvent creates a new event emitted on a SetUnbondingCallback transaction.
+func (p Precompile) EmitSetUnbondingCallbackEvent(ctx sdk.Context, stateDB vm.StateDB, delegatorAddr common.Address, enabled bool) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeSetUnbondingCallback]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	data, err := event.Inputs.NonIndexed().Pack(enabled)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
 // createStakingTxTopics creates the topics for staking transactions Delegate, Undelegate, Redelegate and CancelUnbondingDelegation.
 func (p Precompile) createStakingTxTopics(topicsLen uint64, event abi.Event, delegatorAddr common.Address, validatorAddr common.Address) ([]common.Hash, error) {
 	topics := make([]common.Hash, topicsLen)
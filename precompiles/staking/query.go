@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
@@ -33,6 +34,9 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// HasUnbondingCallbackMethod defines the ABI method name for the staking
+	// HasUnbondingCallback query.
+	HasUnbondingCallbackMethod = "hasUnbondingCallback"
 )
 
 // Delegation returns the delegation that a delegator has with a specific validator.
@@ -196,3 +200,22 @@ func (p Precompile) Redelegations(
 
 	return out.Pack(method.Outputs)
 }
+
+// HasUnbondingCallback returns whether the delegator is registered for the
+// onUnbondingCompleted callback.
+func (p Precompile) HasUnbondingCallback(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	return method.Outputs.Pack(p.evmKeeper.HasUnbondingCallback(ctx, delegatorAddr))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/core/address"
@@ -30,6 +31,7 @@ var f embed.FS
 type Precompile struct {
 	cmn.Precompile
 	stakingKeeper stakingkeeper.Keeper
+	evmKeeper     *evmkeeper.Keeper
 	addrCdc       address.Codec
 }
 
@@ -43,6 +45,7 @@ func LoadABI() (abi.ABI, error) {
 // PrecompiledContract interface.
 func NewPrecompile(
 	stakingKeeper stakingkeeper.Keeper,
+	evmKeeper *evmkeeper.Keeper,
 	addrCdc address.Codec,
 ) (*Precompile, error) {
 	abi, err := LoadABI()
@@ -57,6 +60,7 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 		},
 		stakingKeeper: stakingKeeper,
+		evmKeeper:     evmKeeper,
 		addrCdc:       addrCdc,
 	}
 	// SetAddress defines the address of the staking precompiled contract.
@@ -119,6 +123,8 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegate(ctx, contract, stateDB, method, args)
 	case CancelUnbondingDelegationMethod:
 		bz, err = p.CancelUnbondingDelegation(ctx, contract, stateDB, method, args)
+	case SetUnbondingCallbackMethod:
+		bz, err = p.SetUnbondingCallback(ctx, contract, stateDB, method, args)
 	// Staking queries
 	case DelegationMethod:
 		bz, err = p.Delegation(ctx, contract, method, args)
@@ -132,6 +138,8 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegation(ctx, method, contract, args)
 	case RedelegationsMethod:
 		bz, err = p.Redelegations(ctx, method, contract, args)
+	case HasUnbondingCallbackMethod:
+		bz, err = p.HasUnbondingCallback(ctx, method, contract, args)
 	}
 
 	if err != nil {
@@ -161,6 +169,7 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - Undelegate
 //   - Redelegate
 //   - CancelUnbondingDelegation
+//   - SetUnbondingCallback
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case CreateValidatorMethod,
@@ -168,7 +177,8 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		DelegateMethod,
 		UndelegateMethod,
 		RedelegateMethod,
-		CancelUnbondingDelegationMethod:
+		CancelUnbondingDelegationMethod,
+		SetUnbondingCallbackMethod:
 		return true
 	default:
 		return false
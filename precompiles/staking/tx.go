@@ -30,6 +30,9 @@ const (
 	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
 	// CancelUnbondingDelegation transaction.
 	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+	// SetUnbondingCallbackMethod defines the ABI method name for the
+	// SetUnbondingCallback transaction.
+	SetUnbondingCallbackMethod = "setUnbondingCallback"
 )
 
 // CreateValidator performs create validator.
@@ -321,3 +324,43 @@ func (p Precompile) CancelUnbondingDelegation(
 
 	return method.Outputs.Pack(true)
 }
+
+// SetUnbondingCallback registers or unregisters the caller for the
+// onUnbondingCompleted callback, called by the EVM module in the end block in
+// which an unbonding delegation of the caller is completed. It can be called
+// from the constructor of a contract, whose code isn't set yet.
+func (p Precompile) SetUnbondingCallback(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	enabled, ok := args[0].(bool)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "enabled", true, args[0])
+	}
+
+	delegatorHexAddr := contract.Caller()
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, enabled: %t }",
+			delegatorHexAddr,
+			enabled,
+		),
+	)
+
+	p.evmKeeper.SetUnbondingCallback(ctx, delegatorHexAddr, enabled)
+
+	if err := p.EmitSetUnbondingCallbackEvent(ctx, stateDB, delegatorHexAddr, enabled); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -6,7 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/vm"
 
-	evmtypes "github.com/cosmos/evm/x/vm/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
 
 	"cosmossdk.io/math"
 
@@ -41,7 +41,7 @@ func (p Precompile) Deposit(
 		precompileAccAddr,
 		callerAccAddress,
 		sdk.NewCoins(sdk.Coin{
-			Denom:  evmtypes.GetEVMCoinDenom(),
+			Denom:  cmn.GetEVMAppConfig(stateDB).CoinInfo.Denom,
 			Amount: math.NewIntFromBigInt(depositedAmount.ToBig()),
 		}),
 	); err != nil {
@@ -67,7 +67,7 @@ func (p Precompile) Withdraw(ctx sdk.Context, contract *vm.Contract, stateDB vm.
 
 	caller := contract.Caller()
 	callerAccAddress := sdk.AccAddress(caller.Bytes())
-	nativeBalance := p.BankKeeper.GetBalance(ctx, callerAccAddress, evmtypes.GetEVMCoinDenom())
+	nativeBalance := p.BankKeeper.GetBalance(ctx, callerAccAddress, cmn.GetEVMAppConfig(stateDB).CoinInfo.Denom)
 	if nativeBalance.Amount.LT(amountInt) {
 		return nil, fmt.Errorf("account balance %v is lower than withdraw balance %v", nativeBalance.Amount, amountInt)
 	}
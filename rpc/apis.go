@@ -9,14 +9,15 @@ import (
 
 	"github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/dev"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/txpool"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/web3"
-	"github.com/cosmos/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -37,17 +38,26 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	DevNamespace      = "dev"
+
+	// Namespaces of the dev API, for compatibility with the Hardhat and Anvil
+	// clients
+
+	anvilNamespace   = "anvil"
+	hardhatNamespace = "hardhat"
+	evmNamespace     = "evm"
 
 	apiVersion = "1.0"
 )
 
-// APICreator creates the JSON-RPC API implementations.
+// APICreator creates the JSON-RPC API implementations. The backend is shared by
+// all the namespaces.
 type APICreator = func(
 	ctx *server.Context,
 	clientCtx client.Context,
 	tendermintWebsocketClient *rpcclient.WSClient,
-	allowUnprotectedTxs bool,
-	indexer types.EVMTxIndexer,
+	evmBackend *backend.Backend,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -58,10 +68,8 @@ func init() {
 		EthNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			tmWSClient *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -77,7 +85,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, *backend.Backend) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -87,7 +95,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ *backend.Backend) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -100,10 +108,8 @@ func init() {
 		PersonalNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -116,10 +122,8 @@ func init() {
 		TxPoolNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
@@ -132,10 +136,8 @@ func init() {
 		DebugNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -148,10 +150,8 @@ func init() {
 		MinerNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -161,6 +161,38 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			evmBackend *backend.Backend,
+		) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		DevNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			evmBackend *backend.Backend,
+		) []rpc.API {
+			// the same service is served under each namespace to share its snapshots
+			devAPI := dev.NewAPI(ctx.Logger, evmBackend)
+			apis := make([]rpc.API, 0, 4)
+			for _, ns := range []string{DevNamespace, anvilNamespace, hardhatNamespace, evmNamespace} {
+				apis = append(apis, rpc.API{
+					Namespace: ns,
+					Version:   apiVersion,
+					Service:   devAPI,
+					Public:    false,
+				})
+			}
+			return apis
+		},
 	}
 }
 
@@ -168,15 +200,14 @@ func init() {
 func GetRPCAPIs(ctx *server.Context,
 	clientCtx client.Context,
 	tmWSClient *rpcclient.WSClient,
-	allowUnprotectedTxs bool,
-	indexer types.EVMTxIndexer,
+	evmBackend *backend.Backend,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, evmBackend)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/cometbft/cometbft/libs/bytes"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -20,6 +21,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // GetCode returns the contract code at the given address and block number.
@@ -107,19 +109,60 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, err
 	}
 
+	// query code hash proof
+	_, codeHashProof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.CodeHashKey(address))
+	if err != nil {
+		return nil, err
+	}
+
+	// query the storage hash and its proof
+	storageHashBz, storageHashProof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StorageHashKey(address))
+	if err != nil {
+		return nil, err
+	}
+
+	// query the balance proofs, the integer part of the balance is held by the
+	// bank module and the fractional one by precisebank
+	coinInfo, err := b.evmCoinInfo()
+	if err != nil {
+		return nil, err
+	}
+	balanceKey, err := rpctypes.BalanceKey(address, coinInfo.Denom)
+	if err != nil {
+		return nil, err
+	}
+	_, balanceProof, err := b.QueryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var fractionalBalanceProof []string
+	if coinInfo.Decimals != evmtypes.EighteenDecimals {
+		_, proof, err := b.QueryClient.GetProof(clientCtx, precisebanktypes.StoreKey, rpctypes.FractionalBalanceKey(address))
+		if err != nil {
+			return nil, err
+		}
+		fractionalBalanceProof = GetHexProofs(proof)
+	}
+
 	balance, ok := sdkmath.NewIntFromString(res.Balance)
 	if !ok {
 		return nil, errors.New("invalid balance")
 	}
 
 	return &rpctypes.AccountResult{
-		Address:      address,
-		AccountProof: GetHexProofs(proof),
-		Balance:      (*hexutil.Big)(balance.BigInt()),
-		CodeHash:     common.HexToHash(res.CodeHash),
-		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage hash. TODO: implement?
-		StorageProof: storageProofs,
+		Address:       address,
+		AccountProof:  GetHexProofs(proof),
+		Balance:       (*hexutil.Big)(balance.BigInt()),
+		CodeHash:      common.HexToHash(res.CodeHash),
+		Nonce:         hexutil.Uint64(res.Nonce),
+		StorageHash:   common.BytesToHash(storageHashBz),
+		StorageProof:  storageProofs,
+		CodeHashProof: GetHexProofs(codeHashProof),
+		BalanceProof:  GetHexProofs(balanceProof),
+
+		StorageHashProof:       GetHexProofs(storageHashProof),
+		FractionalBalanceProof: fractionalBalanceProof,
 	}, nil
 }
 
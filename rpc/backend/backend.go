@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -45,6 +46,7 @@ type EVMBackend interface {
 	SetEtherbase(etherbase common.Address) bool
 	SetGasPrice(gasPrice hexutil.Big) bool
 	ImportRawKey(privkey, password string) (common.Address, error)
+	ImportKeystore(keyJSON []byte, password string) (common.Address, error)
 	ListAccounts() ([]common.Address, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
@@ -52,6 +54,7 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
+	RPCBlockRangeCap() int32
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
@@ -61,6 +64,7 @@ type EVMBackend interface {
 	// Blocks Info
 	BlockNumber() (hexutil.Uint64, error)
 	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
+	PendingBlock(fullTx bool) (map[string]interface{}, error)
 	GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error)
 	GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint
 	GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint
@@ -87,6 +91,7 @@ type EVMBackend interface {
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
 	ChainConfig() *params.ChainConfig
+	ChainConfigAtHeight(height int64) *params.ChainConfig
 	GlobalMinGasPrice() (*big.Int, error)
 	BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
 	CurrentHeader() (*ethtypes.Header, error)
@@ -109,24 +114,42 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*evmtypes.MsgEthereumTxResponse, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*rpctypes.AccessListResult, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]rpctypes.SimBlockResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	PendingLogs() ([]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
+	BloomBits(bit uint, section uint64) ([]byte, error)
 
 	// TxPool API
 	Content() (map[string]map[string]map[string]*rpctypes.RPCTransaction, error)
-	ContentFrom(address common.Address) (map[string]map[string]map[string]*rpctypes.RPCTransaction, error)
+	ContentFrom(address common.Address) (map[string]map[string]*rpctypes.RPCTransaction, error)
 	Inspect() (map[string]map[string]map[string]string, error)
 	Status() (map[string]hexutil.Uint, error)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceBlockTransactions(config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.MsgEthereumTx, []*evmtypes.TxTraceResult, error)
+	IntermediateRoots(height rpctypes.BlockNumber, block *tmrpctypes.ResultBlock) ([]common.Hash, error)
+	Preimage(hash common.Hash) ([]byte, error)
+
+	// Dev
+	DevMode() (bool, error)
+	DevSetState(overrides evmtypes.StateOverride) error
+	DevIncreaseTime(seconds uint64) (uint64, error)
+	DevSendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
+	DevStateDump() (evmtypes.StateOverride, error)
+	ImpersonateAccount(address common.Address) error
+	StopImpersonatingAccount(address common.Address) error
+	IsImpersonated(address common.Address) bool
+	Mine(blocks uint64) error
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -164,6 +187,12 @@ type Backend struct {
 	AllowUnprotectedTxs bool
 	Indexer             cosmosevmtypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
+
+	// impersonatedAccounts holds the accounts impersonated through the dev rpc
+	// api. The backend is shared by all the namespaces, so that the
+	// eth_sendTransaction calls of these accounts are executed without
+	// signature.
+	impersonatedAccounts sync.Map
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -128,9 +128,12 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	baseDenom := evmtypes.GetEVMCoinDenom()
+	coinInfo, err := b.evmCoinInfo()
+	if err != nil {
+		return common.Hash{}, err
+	}
 
-	cosmosTx, err := ethereumTx.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	cosmosTx, err := ethereumTx.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), coinInfo)
 	if err != nil {
 		b.Logger.Error("failed to build cosmos tx", "error", err.Error())
 		return common.Hash{}, err
@@ -165,6 +168,15 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		return args, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
 	}
 
+	if args.AuthorizationList != nil {
+		if args.GasPrice != nil {
+			return args, errors.New("gasPrice cannot be used with authorizationList, specify maxFeePerGas instead")
+		}
+		if args.To == nil {
+			return args, errors.New("set code transactions cannot be used to create contracts")
+		}
+	}
+
 	head, _ := b.CurrentHeader() // #nosec G703 -- no need to check error cause we're already checking that head == nil
 	if head == nil {
 		return args, errors.New("latest header is nil")
@@ -271,7 +283,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -287,7 +299,11 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// The optional state overrides are applied on top of the state at the requested
+// block before running the estimation.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -298,6 +314,11 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		return 0, err
 	}
 
+	overridesBz, err := marshalStateOverrides(overrides)
+	if err != nil {
+		return 0, err
+	}
+
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -309,6 +330,7 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -325,14 +347,25 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. The optional state
+// overrides are applied on top of the state at the requested block before
+// executing the call.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+
+	overridesBz, err := marshalStateOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+	if blockNr == rpctypes.EthPendingBlockNumber {
+		return b.doPendingCall(bz, overridesBz)
+	}
+
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -344,25 +377,13 @@ func (b *Backend) DoCall(
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
-	timeout := b.RPCEVMTimeout()
-
-	// Setup context so it may be canceled the call has completed
-	// or, in case of unmetered gas, setup a context with a timeout.
-	var cancel context.CancelFunc
-	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(ctx, timeout)
-	} else {
-		ctx, cancel = context.WithCancel(ctx)
-	}
-
-	// Make sure the context is canceled when the call has completed
-	// this makes sure resources are cleaned up.
+	ctx, cancel := b.evmCallContext(blockNr.Int64())
 	defer cancel()
 
 	res, err := b.QueryClient.EthCall(ctx, &req)
@@ -377,6 +398,100 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CreateAccessList returns the access list of the given call, along with the
+// gas used and the execution error, if any, when the access list is applied.
+// The optional state overrides are applied on top of the state at the
+// requested block before executing the call.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	overridesBz, err := marshalStateOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		Overrides:       overridesBz,
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx, cancel := b.evmCallContext(blockNr.Int64())
+	defer cancel()
+
+	res, err := b.QueryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.AccessListResult{
+		AccessList: res.AccessList.ToEthAccessList(),
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// SimulateV1 simulates a series of blocks of calls on top of the state at the
+// requested block. The state changes of every call are visible to the
+// following ones, but they are never persisted.
+func (b *Backend) SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]rpctypes.SimBlockResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockNumber:     header.Block.Height,
+		BlockHash:       common.Bytes2Hex(header.BlockID.Hash),
+		BlockTime:       header.Block.Time,
+	}
+
+	// query the state of the block the simulation is executed on
+	ctx, cancel := b.evmCallContext(header.Block.Height)
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []rpctypes.SimBlockResult
+	if err := json.Unmarshal(res.Result, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -411,6 +526,18 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	return (*hexutil.Big)(result), nil
 }
 
+// marshalStateOverrides encodes the optional state overrides to be sent on the
+// EthCallRequest. It returns nil if no overrides are provided.
+func marshalStateOverrides(overrides *rpctypes.StateOverride) ([]byte, error) {
+	if overrides == nil || len(*overrides) == 0 {
+		return nil, nil
+	}
+	if err := overrides.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(overrides)
+}
+
 // handleRevertError returns revert related error.
 func handleRevertError(vmError string, ret []byte) error {
 	if len(vmError) > 0 {
@@ -424,3 +551,14 @@ func handleRevertError(vmError string, ret []byte) error {
 	}
 	return nil
 }
+
+// evmCallContext returns the context of an EVM query at the given height. It
+// is canceled when the returned cancel function is called or, unless the EVM
+// timeout is disabled, when the timeout expires.
+func (b *Backend) evmCallContext(height int64) (context.Context, context.CancelFunc) {
+	ctx := rpctypes.ContextWithHeight(height)
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
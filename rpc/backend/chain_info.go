@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	gomath "math"
 	"math/big"
@@ -44,7 +45,47 @@ func (b *Backend) ChainID() (*hexutil.Big, error) {
 
 // ChainConfig returns the latest ethereum chain configuration
 func (b *Backend) ChainConfig() *params.ChainConfig {
-	return evmtypes.GetEthChainConfig()
+	return b.chainConfig(b.Ctx)
+}
+
+// ChainConfigAtHeight returns the ethereum chain configuration of the given
+// block height, which differs from the latest one if a hard fork was scheduled
+// by governance since.
+func (b *Backend) ChainConfigAtHeight(height int64) *params.ChainConfig {
+	return b.chainConfig(rpctypes.ContextWithHeight(height))
+}
+
+// chainConfig queries the chain configuration stored in the state of the given
+// context. It falls back to the latest chain configuration of the app if the
+// query fails, e.g. for a pruned height, and to the default chain configuration
+// of the node's EVM chain ID if the app can't be queried at all.
+func (b *Backend) chainConfig(ctx context.Context) *params.ChainConfig {
+	res, err := b.QueryClient.Config(ctx, &evmtypes.QueryConfigRequest{})
+	if err != nil || res.Config == nil {
+		b.Logger.Debug("failed to query the chain config, using the latest one", "error", err)
+		res, err = b.QueryClient.Config(b.Ctx, &evmtypes.QueryConfigRequest{})
+	}
+	if err != nil || res.Config == nil {
+		b.Logger.Error("failed to query the latest chain config, using the default one", "error", err)
+		return evmtypes.DefaultChainConfig(b.EvmChainID.Uint64()).EthereumConfig(nil)
+	}
+	return res.Config.EthereumConfig(nil)
+}
+
+// evmCoinInfo queries the denoms and decimals of the EVM coin of the node's app.
+func (b *Backend) evmCoinInfo() (evmtypes.EvmCoinInfo, error) {
+	res, err := b.QueryClient.Config(b.Ctx, &evmtypes.QueryConfigRequest{})
+	if err != nil {
+		return evmtypes.EvmCoinInfo{}, errorsmod.Wrap(err, "failed to query the EVM coin")
+	}
+	if res.Config == nil {
+		return evmtypes.EvmCoinInfo{}, errors.New("failed to query the EVM coin: empty chain config")
+	}
+	return evmtypes.EvmCoinInfo{
+		Denom:         res.Config.Denom,
+		ExtendedDenom: res.ExtendedDenom,
+		Decimals:      evmtypes.Decimals(res.Config.Decimals), //#nosec G115 -- validated by the chain config
+	}, nil
 }
 
 // GlobalMinGasPrice returns MinGasPrice param from FeeMarket
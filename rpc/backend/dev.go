@@ -0,0 +1,327 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// devTxTimeout is the time to wait for a dev transaction to be committed.
+	devTxTimeout = 30 * time.Second
+	// devPollInterval is the interval between the lookups of a dev transaction
+	// or a new block.
+	devPollInterval = 100 * time.Millisecond
+	// maxDevMineBlocks is the maximum number of blocks Mine waits for at once.
+	maxDevMineBlocks = 1000
+)
+
+// DevMode returns true if the dev mode is enabled in the x/vm params.
+func (b *Backend) DevMode() (bool, error) {
+	res, err := b.QueryClient.Params(b.Ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return false, err
+	}
+	return res.Params.DevMode, nil
+}
+
+// checkDevMode returns an error if the dev mode is disabled.
+func (b *Backend) checkDevMode() error {
+	devMode, err := b.DevMode()
+	if err != nil {
+		return err
+	}
+	if !devMode {
+		return evmtypes.ErrDevModeDisabled
+	}
+	return nil
+}
+
+// DevSetState overrides the balance, nonce, code and storage of the given
+// accounts through a MsgDevSetState signed by the node's coinbase, and waits
+// for it to be committed.
+func (b *Backend) DevSetState(overrides evmtypes.StateOverride) error {
+	if err := b.checkDevMode(); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.broadcastDevMsg(func(sender sdk.AccAddress) sdk.Msg {
+		return &evmtypes.MsgDevSetState{Sender: sender.String(), Overrides: bz}
+	}, nil)
+	return err
+}
+
+// DevIncreaseTime moves the block time seen by the EVM forward by the given
+// number of seconds. It returns the total time offset.
+func (b *Backend) DevIncreaseTime(seconds uint64) (uint64, error) {
+	if err := b.checkDevMode(); err != nil {
+		return 0, err
+	}
+
+	var res evmtypes.MsgDevIncreaseTimeResponse
+	if _, err := b.broadcastDevMsg(func(sender sdk.AccAddress) sdk.Msg {
+		return &evmtypes.MsgDevIncreaseTime{Sender: sender.String(), Seconds: seconds}
+	}, &res); err != nil {
+		return 0, err
+	}
+	return res.TimeOffset, nil
+}
+
+// DevSendTransaction executes the given transaction on behalf of its sender
+// without its signature. It returns the hash of the Cosmos transaction of the
+// MsgDevSendTransaction, since it isn't indexed as an Ethereum transaction.
+func (b *Backend) DevSendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	if err := b.checkDevMode(); err != nil {
+		return common.Hash{}, err
+	}
+	if args.From == nil {
+		return common.Hash{}, errors.New("missing transaction sender")
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	var res evmtypes.MsgDevSendTransactionResponse
+	hash, err := b.broadcastDevMsg(func(sender sdk.AccAddress) sdk.Msg {
+		return &evmtypes.MsgDevSendTransaction{Sender: sender.String(), Args: bz}
+	}, &res)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if res.Result != nil && res.Result.Failed() {
+		b.Logger.Debug("impersonated transaction failed", "hash", hash.Hex(), "error", res.Result.VmError)
+	}
+	return hash, nil
+}
+
+// DevStateDump returns the state of all the EVM accounts, as state overrides
+// that restore it.
+func (b *Backend) DevStateDump() (evmtypes.StateOverride, error) {
+	res, err := b.QueryClient.DevStateDump(b.Ctx, &evmtypes.QueryDevStateDumpRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var dump evmtypes.StateOverride
+	if err := json.Unmarshal(res.State, &dump); err != nil {
+		return nil, err
+	}
+	return dump, nil
+}
+
+// ImpersonateAccount makes the eth_sendTransaction calls of the given account
+// executed without its signature.
+func (b *Backend) ImpersonateAccount(address common.Address) error {
+	if err := b.checkDevMode(); err != nil {
+		return err
+	}
+	b.impersonatedAccounts.Store(address, struct{}{})
+	return nil
+}
+
+// StopImpersonatingAccount stops impersonating the given account.
+func (b *Backend) StopImpersonatingAccount(address common.Address) error {
+	if err := b.checkDevMode(); err != nil {
+		return err
+	}
+	b.impersonatedAccounts.Delete(address)
+	return nil
+}
+
+// IsImpersonated returns true if the given account is impersonated.
+func (b *Backend) IsImpersonated(address common.Address) bool {
+	_, ok := b.impersonatedAccounts.Load(address)
+	return ok
+}
+
+// Mine waits for the given number of blocks to be committed. It never produces
+// a block itself: the blocks are produced by the consensus engine at its own
+// pace, which can't be asked for a block on demand. Mine only blocks the caller
+// until the chain has advanced by the given number of blocks, or times out.
+func (b *Backend) Mine(blocks uint64) error {
+	if blocks > maxDevMineBlocks {
+		return fmt.Errorf("cannot mine more than %d blocks at once, got %d", maxDevMineBlocks, blocks)
+	}
+	if err := b.checkDevMode(); err != nil {
+		return err
+	}
+
+	start, err := b.BlockNumber()
+	if err != nil {
+		return err
+	}
+
+	target := uint64(start) + blocks
+	timeout := time.Duration(blocks) * devTxTimeout
+	return b.pollUntil(timeout, func() (bool, error) {
+		bn, err := b.BlockNumber()
+		if err != nil {
+			return false, err
+		}
+		return uint64(bn) >= target, nil
+	})
+}
+
+// broadcastDevMsg signs the message returned by newMsg for the node's coinbase,
+// broadcasts it and waits for it to be committed. The response of the message
+// is decoded into res if not nil.
+func (b *Backend) broadcastDevMsg(newMsg func(sender sdk.AccAddress) sdk.Msg, res interface{ Unmarshal([]byte) error }) (common.Hash, error) {
+	sender, err := b.GetCoinbase()
+	if err != nil {
+		return common.Hash{}, errorsmod.Wrap(err, "failed to get the coinbase account signing the dev messages")
+	}
+	msg := newMsg(sender)
+
+	keyInfo, err := b.ClientCtx.Keyring.KeyByAddress(sender)
+	if err != nil {
+		return common.Hash{}, errorsmod.Wrap(err, "failed to find the coinbase key in the node's keyring")
+	}
+
+	nonce, err := b.GetTransactionCount(common.BytesToAddress(sender), rpctypes.EthPendingBlockNumber)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txFactory := tx.Factory{}.
+		WithChainID(b.ClientCtx.ChainID).
+		WithKeybase(b.ClientCtx.Keyring).
+		WithTxConfig(b.ClientCtx.TxConfig).
+		WithSequence(uint64(*nonce)).
+		WithGasAdjustment(1.25)
+
+	_, gas, err := tx.CalculateGas(b.ClientCtx, txFactory, msg)
+	if err != nil {
+		return common.Hash{}, errorsmod.Wrap(err, "failed to calculate gas")
+	}
+
+	fees, err := b.devTxFees(gas)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	builder := b.ClientCtx.TxConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msg); err != nil {
+		return common.Hash{}, err
+	}
+	builder.SetGasLimit(gas)
+	builder.SetFeeAmount(fees)
+
+	if err := tx.Sign(b.ClientCtx.CmdContext, txFactory, keyInfo.Name, builder, false); err != nil {
+		return common.Hash{}, errorsmod.Wrap(err, "failed to sign tx")
+	}
+
+	txBytes, err := b.ClientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	syncCtx := b.ClientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+	}
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash := cmttypes.Tx(txBytes).Hash()
+	var data []byte
+	if err := b.pollUntil(devTxTimeout, func() (bool, error) {
+		resTx, err := b.RPCClient.Tx(b.Ctx, txHash, false)
+		if err != nil {
+			// the transaction is not committed yet
+			return false, nil //nolint:nilerr
+		}
+		if resTx.TxResult.Code != 0 {
+			return false, errorsmod.ABCIError(resTx.TxResult.Codespace, resTx.TxResult.Code, resTx.TxResult.Log)
+		}
+		data = resTx.TxResult.Data
+		return true, nil
+	}); err != nil {
+		return common.Hash{}, err
+	}
+
+	if res != nil {
+		var txMsgData sdk.TxMsgData
+		if err := b.ClientCtx.Codec.Unmarshal(data, &txMsgData); err != nil {
+			return common.Hash{}, err
+		}
+		if len(txMsgData.MsgResponses) != 1 {
+			return common.Hash{}, fmt.Errorf("expected 1 message response, got %d", len(txMsgData.MsgResponses))
+		}
+		if err := res.Unmarshal(txMsgData.MsgResponses[0].Value); err != nil {
+			return common.Hash{}, err
+		}
+	}
+
+	return common.BytesToHash(txHash), nil
+}
+
+// devTxFees returns the fees of a dev transaction with the given gas limit, at
+// the greater of the node's minimum gas price and the base fee.
+func (b *Backend) devTxFees(gas uint64) (sdk.Coins, error) {
+	coinInfo, err := b.evmCoinInfo()
+	if err != nil {
+		return nil, err
+	}
+	denom := coinInfo.Denom
+	gasPrice := b.Cfg.GetMinGasPrices().AmountOf(denom)
+
+	res, err := b.QueryClient.FeeMarket.Params(b.Ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Params.NoBaseFee && res.Params.BaseFee.GT(gasPrice) {
+		gasPrice = res.Params.BaseFee
+	}
+
+	amount := gasPrice.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(denom, amount)), nil
+}
+
+// pollUntil calls done at each poll interval until it returns true or an
+// error, or the timeout elapses.
+func (b *Backend) pollUntil(timeout time.Duration, done func() (bool, error)) error {
+	ticker := time.NewTicker(devPollInterval)
+	defer ticker.Stop()
+	deadline := time.After(timeout)
+
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -32,5 +34,22 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
-	return 4096, 0
+	if b.Indexer == nil {
+		return cosmosevmtypes.BloomBitsBlocks, 0
+	}
+	sections, err := b.Indexer.BloomSections()
+	if err != nil {
+		b.Logger.Debug("failed to load bloom sections", "error", err.Error())
+		return cosmosevmtypes.BloomBitsBlocks, 0
+	}
+	return cosmosevmtypes.BloomBitsBlocks, sections
+}
+
+// BloomBits returns the bit vector of a bloom bit within a section maintained by
+// the chain indexer, nil if the section is not indexed.
+func (b *Backend) BloomBits(bit uint, section uint64) ([]byte, error) {
+	if b.Indexer == nil {
+		return nil, nil
+	}
+	return b.Indexer.BloomBits(bit, section)
 }
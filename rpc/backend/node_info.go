@@ -12,10 +12,10 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/keystore"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -197,7 +197,23 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 	}
 
 	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
+	return b.importPrivKey(privKey, password)
+}
 
+// ImportKeystore decrypts the private key of a Web3 Secret Storage (keystore
+// v3) file with the given passphrase, and stores it into the key directory
+// like ImportRawKey.
+func (b *Backend) ImportKeystore(keyJSON []byte, password string) (common.Address, error) {
+	privKey, err := keystore.Decrypt(keyJSON, password)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return b.importPrivKey(privKey, password)
+}
+
+// importPrivKey armors and encrypts the given private key with the password and
+// stores it into the key directory, unless it has already been imported.
+func (b *Backend) importPrivKey(privKey *ethsecp256k1.PrivKey, password string) (common.Address, error) {
 	addr := sdk.AccAddress(privKey.PubKey().Address().Bytes())
 	ethereumAddr := common.BytesToAddress(addr)
 
@@ -269,7 +285,11 @@ func (b *Backend) SetGasPrice(gasPrice hexutil.Big) bool {
 		b.Logger.Debug("could not get the server config", "error", err.Error())
 		return false
 	}
-	c := b.GenerateMinGasCoin(gasPrice, appConf)
+	c, err := b.GenerateMinGasCoin(gasPrice, appConf)
+	if err != nil {
+		b.Logger.Debug("could not generate the min gas price", "error", err.Error())
+		return false
+	}
 
 	appConf.SetMinGasPrices(sdk.DecCoins{c})
 	sdkconfig.WriteConfigFile(b.ClientCtx.Viper.ConfigFileUsed(), appConf)
@@ -277,28 +297,32 @@ func (b *Backend) SetGasPrice(gasPrice hexutil.Big) bool {
 	return true
 }
 
-func (b *Backend) GenerateMinGasCoin(gasPrice hexutil.Big, appConf config.Config) sdk.DecCoin {
+func (b *Backend) GenerateMinGasCoin(gasPrice hexutil.Big, appConf config.Config) (sdk.DecCoin, error) {
 	var unit string
 	minGasPrices := appConf.GetMinGasPrices()
+	coinInfo, err := b.evmCoinInfo()
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
 
 	// fetch the base denom from the sdk Config in case it's not currently defined on the node config
 	if len(minGasPrices) == 0 || minGasPrices.Empty() {
-		unit = evmtypes.GetEVMCoinDenom()
+		unit = coinInfo.Denom
 	} else {
 		unit = minGasPrices[0].Denom
 	}
 
 	// The provided gasPrice has 18 decimals.
 	// We need to update to the denom's real precision
-	scaledAmt := evmtypes.ConvertBigIntFrom18DecimalsToLegacyDec(gasPrice.ToInt())
+	scaledAmt := coinInfo.ConvertBigIntFrom18DecimalsToLegacyDec(gasPrice.ToInt())
 	c := sdk.DecCoin{Denom: unit, Amount: scaledAmt}
 
-	return c
+	return c, nil
 }
 
 // UnprotectedAllowed returns the node configuration value for allowing
 // unprotected transactions (i.e not replay-protected)
-func (b Backend) UnprotectedAllowed() bool {
+func (b *Backend) UnprotectedAllowed() bool {
 	return b.AllowUnprotectedTxs
 }
 
@@ -338,15 +362,20 @@ func (b *Backend) RPCBlockRangeCap() int32 {
 }
 
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
-// the node config. If set value is 0, it will default to 20.
+// the node config. If set value is 0, or the EVM coin can't be queried, it will
+// default to 20.
 func (b *Backend) RPCMinGasPrice() *big.Int {
-	baseDenom := evmtypes.GetEVMCoinDenom()
+	coinInfo, err := b.evmCoinInfo()
+	if err != nil {
+		b.Logger.Debug("failed to get the min gas price", "error", err.Error())
+		return big.NewInt(constants.DefaultGasPrice)
+	}
 
 	minGasPrice := b.Cfg.GetMinGasPrices()
-	amt := minGasPrice.AmountOf(baseDenom)
+	amt := minGasPrice.AmountOf(coinInfo.Denom)
 	if amt.IsNil() || amt.IsZero() {
 		return big.NewInt(constants.DefaultGasPrice)
 	}
 
-	return evmtypes.ConvertAmountTo18DecimalsLegacy(amt).TruncateInt().BigInt()
+	return coinInfo.ConvertAmountTo18DecimalsLegacy(amt).TruncateInt().BigInt()
 }
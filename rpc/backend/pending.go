@@ -0,0 +1,167 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PendingBlock returns the speculative pending block, built by executing the
+// Ethereum transactions of the mempool on top of the latest block. As it is
+// not sealed, its hash, nonce and miner are null.
+func (b *Backend) PendingBlock(fullTx bool) (map[string]interface{}, error) {
+	res, msgs, latest, err := b.queryPendingBlock(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	block := latest.Block
+	ctx := rpctypes.ContextWithHeight(block.Height)
+
+	var baseFee *big.Int
+	if baseFeeRes, err := b.QueryClient.BaseFee(ctx, &evmtypes.QueryBaseFeeRequest{}); err != nil {
+		b.Logger.Debug("failed to query base fee", "height", block.Height, "error", err.Error())
+	} else if baseFeeRes.BaseFee != nil {
+		baseFee = baseFeeRes.BaseFee.BigInt()
+	}
+
+	header := block.Header
+	header.Height++
+	header.LastBlockID = cmttypes.BlockID{Hash: block.Hash()}
+	header.DataHash = nil
+
+	height := uint64(header.Height) //#nosec G115 -- checked for int overflow already
+	txs := make([]interface{}, 0, len(res.TxResponses))
+	var logs []*ethtypes.Log
+	for txIndex, txRes := range res.TxResponses {
+		logs = append(logs, evmtypes.LogsToEthereum(txRes.Logs)...)
+		if !fullTx {
+			txs = append(txs, common.HexToHash(txRes.Hash))
+			continue
+		}
+
+		rpcTx, err := rpctypes.NewRPCTransaction(msgs[txRes.Hash], common.Hash{}, height, uint64(txIndex), baseFee, b.EvmChainID) //#nosec G115 -- checked for int overflow already
+		if err != nil {
+			b.Logger.Debug("NewTransactionFromData for pending block failed", "hash", txRes.Hash, "error", err.Error())
+			continue
+		}
+		txs = append(txs, rpcTx)
+	}
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(ctx, b.ClientCtx, block.Height)
+	if err != nil {
+		b.Logger.Error("failed to query consensus params", "error", err.Error())
+	}
+
+	bloom := ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs})
+	formattedBlock := rpctypes.FormatBlock(
+		header, 0,
+		gasLimit, new(big.Int).SetUint64(res.GasUsed),
+		txs, bloom, common.Address{}, baseFee,
+	)
+	for _, field := range []string{"hash", "nonce", "miner"} {
+		formattedBlock[field] = nil
+	}
+	return formattedBlock, nil
+}
+
+// PendingLogs returns the logs emitted by the transactions of the pending
+// block.
+func (b *Backend) PendingLogs() ([]*ethtypes.Log, error) {
+	res, _, latest, err := b.queryPendingBlock(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []*ethtypes.Log{}
+	for _, txRes := range res.TxResponses {
+		txLogs := evmtypes.LogsToEthereum(txRes.Logs)
+		for _, log := range txLogs {
+			log.BlockNumber = uint64(latest.Block.Height + 1) //#nosec G115 -- checked for int overflow already
+		}
+		logs = append(logs, txLogs...)
+	}
+	return logs, nil
+}
+
+// doPendingCall executes a call on top of the pending block.
+func (b *Backend) doPendingCall(args, overrides []byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, _, _, err := b.queryPendingBlock(args, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = handleRevertError(res.Call.VmError, res.Call.Ret); err != nil {
+		return nil, err
+	}
+
+	return res.Call, nil
+}
+
+// queryPendingBlock executes the Ethereum transactions of the mempool, and the
+// call if any, on top of the latest block. It returns the query response along
+// with the pending messages by hash and the latest block.
+func (b *Backend) queryPendingBlock(args, overrides []byte) (
+	*evmtypes.QueryPendingBlockResponse, map[string]*evmtypes.MsgEthereumTx, *tmrpctypes.ResultBlock, error,
+) {
+	latest, err := b.TendermintBlockByNumber(rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if latest == nil {
+		return nil, nil, nil, errors.New("latest block not found")
+	}
+
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	msgs := make(map[string]*evmtypes.MsgEthereumTx)
+	req := &evmtypes.QueryPendingBlockRequest{
+		Args:            args,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(latest.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		Overrides:       overrides,
+	}
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+			msgs[ethMsg.Hash] = ethMsg
+			req.Txs = append(req.Txs, ethMsg)
+		}
+	}
+
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		// the query may not be served over gRPC and see the context deadline
+		req.Timeout = timeout.String()
+	}
+
+	ctx, cancel := b.evmCallContext(latest.Block.Height)
+	defer cancel()
+
+	res, err := b.QueryClient.PendingBlock(ctx, req)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(args) > 0 && res.Call == nil {
+		return nil, nil, nil, errors.New("pending call result not found")
+	}
+
+	return res, msgs, latest, nil
+}
@@ -23,6 +23,12 @@ import (
 
 // SendTransaction sends transaction based on received args using Node's key to sign it
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	// Execute the transactions of the accounts impersonated through the dev
+	// namespace without signature
+	if args.From != nil && b.IsImpersonated(*args.From) {
+		return b.DevSendTransaction(args)
+	}
+
 	// Look up the wallet containing the requested signer
 	if !b.Cfg.JSONRPC.AllowInsecureUnlock {
 		b.Logger.Debug("account unlock with HTTP access is forbidden")
@@ -72,10 +78,13 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	baseDenom := evmtypes.GetEVMCoinDenom()
+	coinInfo, err := b.evmCoinInfo()
+	if err != nil {
+		return common.Hash{}, err
+	}
 
 	// Assemble transaction from fields
-	tx, err := msg.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	tx, err := msg.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), coinInfo)
 	if err != nil {
 		b.Logger.Error("build cosmos tx failed", "error", err.Error())
 		return common.Hash{}, err
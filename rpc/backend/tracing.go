@@ -6,6 +6,7 @@ import (
 	"math"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -149,26 +150,42 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
-	txDecoder := b.ClientCtx.TxConfig.TxDecoder()
+	return b.traceEthMsgs(height, config, block, b.blockEthereumMsgs(block), txsLength)
+}
 
-	var txsMessages []*evmtypes.MsgEthereumTx
-	for i, tx := range txs {
-		decodedTx, err := txDecoder(tx)
-		if err != nil {
-			b.Logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
-			continue
-		}
+// TraceBlockTransactions traces the Ethereum transactions of the given block, as
+// returned by EthMsgsFromTendermintBlock. It returns the traced messages along with
+// one trace result per message.
+func (b *Backend) TraceBlockTransactions(
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+) ([]*evmtypes.MsgEthereumTx, []*evmtypes.TxTraceResult, error) {
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		for _, msg := range decodedTx.GetMsgs() {
-			ethMessage, ok := msg.(*evmtypes.MsgEthereumTx)
-			if !ok {
-				// Just considers Ethereum transactions
-				continue
-			}
-			txsMessages = append(txsMessages, ethMessage)
-		}
+	txsMessages := b.EthMsgsFromTendermintBlock(block, blockRes)
+	if len(txsMessages) == 0 {
+		return txsMessages, []*evmtypes.TxTraceResult{}, nil
+	}
+
+	results, err := b.traceEthMsgs(rpctypes.BlockNumber(block.Block.Height), config, block, txsMessages, len(txsMessages))
+	if err != nil {
+		return nil, nil, err
 	}
 
+	return txsMessages, results, nil
+}
+
+// traceEthMsgs traces the given Ethereum messages on top of the state at the
+// beginning of the block.
+func (b *Backend) traceEthMsgs(height rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+	txsMessages []*evmtypes.MsgEthereumTx,
+	txsLength int,
+) ([]*evmtypes.TxTraceResult, error) {
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
@@ -210,3 +227,117 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// IntermediateRoots re-executes the Ethereum transactions of the given block and
+// returns the hash of the state touched by each of them.
+func (b *Backend) IntermediateRoots(height rpctypes.BlockNumber, block *tmrpctypes.ResultBlock) ([]common.Hash, error) {
+	txsMessages, feePayers := b.blockEthereumMsgsWithFeePayers(block)
+	if len(txsMessages) == 0 {
+		return []common.Hash{}, nil
+	}
+
+	// minus one to get the context at the beginning of the block
+	contextHeight := height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+	ctxWithHeight := rpctypes.ContextWithHeight(int64(contextHeight))
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &block.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.QueryClient.IntermediateRoots(ctxWithHeight, &evmtypes.QueryIntermediateRootsRequest{
+		Txs:             txsMessages,
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+		FeePayers:       feePayers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]common.Hash, 0, len(res.Roots))
+	for i, root := range res.Roots {
+		if i < len(res.Errors) && res.Errors[i] != "" {
+			b.Logger.Debug("transaction failed during the re-execution", "hash", txsMessages[i].Hash, "error", res.Errors[i])
+		}
+		roots = append(roots, common.BytesToHash(root))
+	}
+
+	return roots, nil
+}
+
+// blockEthereumMsgs decodes the transactions of the given block and returns the
+// Ethereum messages they contain. Transactions that fail to decode are skipped.
+func (b *Backend) blockEthereumMsgs(block *tmrpctypes.ResultBlock) []*evmtypes.MsgEthereumTx {
+	txsMessages, _ := b.blockEthereumMsgsWithFeePayers(block)
+	return txsMessages
+}
+
+// blockEthereumMsgsWithFeePayers is like blockEthereumMsgs, but also returns the
+// bech32 fee payer of each message, empty when the sender pays its own fees.
+func (b *Backend) blockEthereumMsgsWithFeePayers(block *tmrpctypes.ResultBlock) ([]*evmtypes.MsgEthereumTx, []string) {
+	txs := block.Block.Txs
+	txDecoder := b.ClientCtx.TxConfig.TxDecoder()
+
+	var (
+		txsMessages []*evmtypes.MsgEthereumTx
+		feePayers   []string
+	)
+	for i, tx := range txs {
+		decodedTx, err := txDecoder(tx)
+		if err != nil {
+			b.Logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
+			continue
+		}
+
+		feePayer, err := evmtypes.GetFeePayer(decodedTx)
+		if err != nil {
+			b.Logger.Error("failed to get the fee payer of transaction", "hash", txs[i].Hash(), "error", err.Error())
+			continue
+		}
+
+		for _, msg := range decodedTx.GetMsgs() {
+			ethMessage, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// Just considers Ethereum transactions
+				continue
+			}
+			txsMessages = append(txsMessages, ethMessage)
+			if feePayer != nil {
+				feePayers = append(feePayers, feePayer.String())
+			} else {
+				feePayers = append(feePayers, "")
+			}
+		}
+	}
+
+	return txsMessages, feePayers
+}
+
+// Preimage returns the preimage of a SHA3 hash recorded by the node, which
+// requires the `evm.cache-preimage` flag to be enabled.
+func (b *Backend) Preimage(hash common.Hash) ([]byte, error) {
+	res, err := b.QueryClient.Preimage(b.Ctx, &evmtypes.QueryPreimageRequest{Hash: hash.Hex()})
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty response is only a match for the hash of empty data
+	if len(res.Preimage) == 0 && hash != crypto.Keccak256Hash(nil) {
+		return nil, errors.New("unknown preimage")
+	}
+	return res.Preimage, nil
+}
@@ -268,19 +268,32 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+	switch txData.(type) {
+	case *evmtypes.DynamicFeeTx, *evmtypes.SetCodeTx:
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
 			// tolerate the error for pruned node.
 			b.Logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
 		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
 		}
 	}
 
+	setReceiptFeePayer(receipt, tx)
+
 	return receipt, nil
 }
 
+// setReceiptFeePayer adds to the receipt of a sponsored ethereum tx the
+// account that paid its fees instead of the sender.
+func setReceiptFeePayer(receipt map[string]interface{}, tx sdk.Tx) {
+	feePayer, err := evmtypes.GetFeePayer(tx)
+	if err != nil || feePayer == nil {
+		return
+	}
+	receipt["feePayer"] = common.BytesToAddress(feePayer)
+}
+
 // GetTransactionLogs returns the transaction logs identified by hash.
 func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error) {
 	hexTx := hash.Hex()
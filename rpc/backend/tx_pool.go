@@ -1,43 +1,245 @@
 package backend
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// txPoolPending is the key of the executable transactions in the txpool responses.
+	txPoolPending = "pending"
+	// txPoolQueued is the key of the non-executable (nonce gapped) transactions in the
+	// txpool responses.
+	txPoolQueued = "queued"
 )
 
+// txPoolContent holds the Ethereum transactions of the mempool grouped by sender.
+// Transactions of each account are sorted by nonce.
+type txPoolContent struct {
+	pending map[common.Address][]*evmtypes.MsgEthereumTx
+	queued  map[common.Address][]*evmtypes.MsgEthereumTx
+}
+
 // Content returns the transactions contained within the transaction pool
 func (b *Backend) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+		txPoolPending: make(map[string]map[string]*types.RPCTransaction),
+		txPoolQueued:  make(map[string]map[string]*types.RPCTransaction),
+	}
+
+	pool, err := b.txPoolContent(nil)
+	if err != nil {
+		return nil, err
 	}
+
+	for addr, msgs := range pool.pending {
+		dump, err := b.rpcTxsByNonce(msgs)
+		if err != nil {
+			return nil, err
+		}
+		content[txPoolPending][addr.Hex()] = dump
+	}
+	for addr, msgs := range pool.queued {
+		dump, err := b.rpcTxsByNonce(msgs)
+		if err != nil {
+			return nil, err
+		}
+		content[txPoolQueued][addr.Hex()] = dump
+	}
+
 	return content, nil
 }
 
 // ContentFrom returns the transactions contained within the transaction pool
-func (b *Backend) ContentFrom(_ common.Address) (map[string]map[string]map[string]*types.RPCTransaction, error) {
-	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+// for the given address.
+func (b *Backend) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	pool, err := b.txPoolContent(&address)
+	if err != nil {
+		return nil, err
 	}
-	return content, nil
+
+	pending, err := b.rpcTxsByNonce(pool.pending[address])
+	if err != nil {
+		return nil, err
+	}
+	queued, err := b.rpcTxsByNonce(pool.queued[address])
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		txPoolPending: pending,
+		txPoolQueued:  queued,
+	}, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an easily inspectable list.
 func (b *Backend) Inspect() (map[string]map[string]map[string]string, error) {
 	inspect := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		txPoolPending: make(map[string]map[string]string),
+		txPoolQueued:  make(map[string]map[string]string),
+	}
+
+	pool, err := b.txPoolContent(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for addr, msgs := range pool.pending {
+		inspect[txPoolPending][addr.Hex()] = formatTxsByNonce(msgs)
 	}
+	for addr, msgs := range pool.queued {
+		inspect[txPoolQueued][addr.Hex()] = formatTxsByNonce(msgs)
+	}
+
 	return inspect, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (b *Backend) Status() (map[string]hexutil.Uint, error) {
+	pool, err := b.txPoolContent(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending, queued int
+	for _, msgs := range pool.pending {
+		pending += len(msgs)
+	}
+	for _, msgs := range pool.queued {
+		queued += len(msgs)
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		txPoolPending: hexutil.Uint(pending),
+		txPoolQueued:  hexutil.Uint(queued),
 	}, nil
 }
+
+// txPoolContent reads the Ethereum transactions from the node's mempool and groups
+// them by sender. For each sender, the transactions whose nonces follow the
+// account's current nonce without gaps are considered pending, while the ones after
+// a nonce gap are considered queued. Transactions with a nonce lower than the
+// account nonce are stale and therefore skipped.
+//
+// If a sender address is provided, only the transactions from that account are
+// returned.
+func (b *Backend) txPoolContent(sender *common.Address) (*txPoolContent, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.LatestSignerForChainID(b.EvmChainID)
+	bySender := make(map[common.Address][]*evmtypes.MsgEthereumTx)
+
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			from, err := ethMsg.GetSenderLegacy(signer)
+			if err != nil {
+				b.Logger.Debug("failed to get sender of mempool tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			if sender != nil && from != *sender {
+				continue
+			}
+
+			bySender[from] = append(bySender[from], ethMsg)
+		}
+	}
+
+	content := &txPoolContent{
+		pending: make(map[common.Address][]*evmtypes.MsgEthereumTx),
+		queued:  make(map[common.Address][]*evmtypes.MsgEthereumTx),
+	}
+
+	for from, msgs := range bySender {
+		res, err := b.QueryClient.Account(b.Ctx, &evmtypes.QueryAccountRequest{Address: from.Hex()})
+		if err != nil {
+			return nil, err
+		}
+
+		pending, queued := splitByNonce(msgs, res.Nonce)
+		if len(pending) > 0 {
+			content.pending[from] = pending
+		}
+		if len(queued) > 0 {
+			content.queued[from] = queued
+		}
+	}
+
+	return content, nil
+}
+
+// splitByNonce sorts the transactions of a single account by nonce and splits them
+// into the executable ones, that start at the given account nonce and have no gaps,
+// and the ones that can only be executed once the gap is filled.
+func splitByNonce(msgs []*evmtypes.MsgEthereumTx, accountNonce uint64) (pending, queued []*evmtypes.MsgEthereumTx) {
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].AsTransaction().Nonce() < msgs[j].AsTransaction().Nonce()
+	})
+
+	next := accountNonce
+	for _, msg := range msgs {
+		nonce := msg.AsTransaction().Nonce()
+		switch {
+		case nonce < next:
+			// stale or duplicated nonce
+			continue
+		case nonce == next && len(queued) == 0:
+			pending = append(pending, msg)
+			next++
+		default:
+			queued = append(queued, msg)
+		}
+	}
+
+	return pending, queued
+}
+
+// rpcTxsByNonce returns the RPC representation of the given transactions keyed by
+// their nonce.
+func (b *Backend) rpcTxsByNonce(msgs []*evmtypes.MsgEthereumTx) (map[string]*types.RPCTransaction, error) {
+	dump := make(map[string]*types.RPCTransaction, len(msgs))
+	for _, msg := range msgs {
+		// use zero block values since it's not included in a block yet
+		rpcTx, err := types.NewTransactionFromMsg(msg, common.Hash{}, uint64(0), uint64(0), nil, b.EvmChainID)
+		if err != nil {
+			return nil, err
+		}
+		dump[fmt.Sprintf("%d", rpcTx.Nonce)] = rpcTx
+	}
+	return dump, nil
+}
+
+// formatTxsByNonce returns the geth inspect summary of the given transactions keyed
+// by their nonce.
+func formatTxsByNonce(msgs []*evmtypes.MsgEthereumTx) map[string]string {
+	dump := make(map[string]string, len(msgs))
+	for _, msg := range msgs {
+		tx := msg.AsTransaction()
+		dump[fmt.Sprintf("%d", tx.Nonce())] = formatTx(tx)
+	}
+	return dump
+}
+
+// formatTx returns the flattened summary of a transaction as used by txpool_inspect.
+func formatTx(tx *ethtypes.Transaction) string {
+	if to := tx.To(); to != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+}
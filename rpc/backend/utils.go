@@ -184,7 +184,7 @@ func (b *Backend) ProcessBlock(
 	} else {
 		targetOneFeeHistory.BaseFee = blockBaseFee
 	}
-	cfg := b.ChainConfig()
+	cfg := b.ChainConfigAtHeight(blockHeight)
 	// set gas used ratio
 	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
 	if !ok {
@@ -313,9 +313,29 @@ func (a *API) PrintBlock(number uint64) (string, error) {
 	return spew.Sdump(block), nil
 }
 
-// IntermediateRoots executes a block, and returns a list
-// of intermediate roots: the stateroot after each transaction.
+// IntermediateRoots executes a block, and returns a list of intermediate roots:
+// the hash of the accounts and storage touched by each transaction, read after
+// its execution.
 func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
-	return ([]common.Hash)(nil), nil
+	// Get Tendermint Block
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+
+	return a.backend.IntermediateRoots(rpctypes.BlockNumber(resBlock.Block.Height), resBlock)
+}
+
+// Preimage returns the preimage of a SHA3 hash, if the node recorded it with
+// the `evm.cache-preimage` flag enabled.
+func (a *API) Preimage(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_preimage", "hash", hash)
+	return a.backend.Preimage(hash)
 }
@@ -0,0 +1,257 @@
+package dev
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// API is the dev prefixed set of APIs, which provides the cheat codes of the
+// Hardhat and Anvil local networks. The state changes are applied through
+// the dev messages of the x/vm module, signed by the node's coinbase, and are
+// only allowed when the dev mode is enabled in the x/vm params.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+
+	mu           sync.Mutex
+	snapshots    map[uint64]evmtypes.StateOverride
+	nextSnapshot uint64
+}
+
+// NewAPI creates an instance of the dev API.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:       logger.With("api", "dev"),
+		backend:      backend,
+		snapshots:    make(map[uint64]evmtypes.StateOverride),
+		nextSnapshot: 1,
+	}
+}
+
+// Quantity is an unsigned integer parameter given either as a JSON number or
+// as a hex or decimal string, since the clients of Hardhat and Anvil use both.
+type Quantity uint64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (q *Quantity) UnmarshalJSON(input []byte) error {
+	if len(input) > 0 && input[0] == '"' {
+		var s string
+		if err := json.Unmarshal(input, &s); err != nil {
+			return err
+		}
+		if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+			v, err := hexutil.DecodeUint64(s)
+			*q = Quantity(v)
+			return err
+		}
+		input = []byte(s)
+	}
+
+	v, err := strconv.ParseUint(string(input), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid quantity %s: %w", input, err)
+	}
+	*q = Quantity(v)
+	return nil
+}
+
+// SetBalance sets the balance of the given account.
+func (a *API) SetBalance(address common.Address, balance hexutil.Big) error {
+	a.logger.Debug("dev_setBalance", "address", address.Hex(), "balance", balance.String())
+	b := &balance
+	return a.backend.DevSetState(evmtypes.StateOverride{
+		address: {Balance: &b},
+	})
+}
+
+// SetCode sets the code of the given account.
+func (a *API) SetCode(address common.Address, code hexutil.Bytes) error {
+	a.logger.Debug("dev_setCode", "address", address.Hex())
+	return a.backend.DevSetState(evmtypes.StateOverride{
+		address: {Code: &code},
+	})
+}
+
+// SetStorageAt sets the value of a storage slot of the given account.
+func (a *API) SetStorageAt(address common.Address, slot hexutil.Big, value hexutil.Bytes) error {
+	a.logger.Debug("dev_setStorageAt", "address", address.Hex(), "slot", slot.String())
+	if len(value) > common.HashLength {
+		return fmt.Errorf("storage value must be at most %d bytes, got %d", common.HashLength, len(value))
+	}
+
+	stateDiff := map[common.Hash]common.Hash{
+		common.BigToHash(slot.ToInt()): common.BytesToHash(value),
+	}
+	return a.backend.DevSetState(evmtypes.StateOverride{
+		address: {StateDiff: &stateDiff},
+	})
+}
+
+// SetNonce sets the nonce of the given account.
+func (a *API) SetNonce(address common.Address, nonce hexutil.Uint64) error {
+	a.logger.Debug("dev_setNonce", "address", address.Hex(), "nonce", nonce)
+	return a.backend.DevSetState(evmtypes.StateOverride{
+		address: {Nonce: &nonce},
+	})
+}
+
+// ImpersonateAccount makes the eth_sendTransaction calls of the given account
+// executed without its signature.
+func (a *API) ImpersonateAccount(address common.Address) error {
+	a.logger.Debug("dev_impersonateAccount", "address", address.Hex())
+	return a.backend.ImpersonateAccount(address)
+}
+
+// StopImpersonatingAccount stops impersonating the given account.
+func (a *API) StopImpersonatingAccount(address common.Address) error {
+	a.logger.Debug("dev_stopImpersonatingAccount", "address", address.Hex())
+	return a.backend.StopImpersonatingAccount(address)
+}
+
+// Mine waits for the given number of blocks to be committed, one by default.
+// The blocks are produced by the consensus engine at its own pace, so the
+// interval between their timestamps can't be set.
+func (a *API) Mine(blocks, interval *hexutil.Uint64) error {
+	a.logger.Debug("dev_mine", "blocks", blocks)
+	if interval != nil && *interval != 0 {
+		return errors.New("the interval between the blocks can't be set")
+	}
+
+	n := uint64(1)
+	if blocks != nil {
+		n = uint64(*blocks)
+	}
+	return a.backend.Mine(n)
+}
+
+// IncreaseTime moves the block time seen by the EVM forward by the given
+// number of seconds. It returns the total time offset in seconds.
+func (a *API) IncreaseTime(seconds Quantity) (uint64, error) {
+	a.logger.Debug("dev_increaseTime", "seconds", seconds)
+	return a.backend.DevIncreaseTime(uint64(seconds))
+}
+
+// Snapshot records the state of the EVM accounts and returns its id, to
+// restore it with Revert.
+func (a *API) Snapshot() (hexutil.Uint64, error) {
+	a.logger.Debug("dev_snapshot")
+	dump, err := a.backend.DevStateDump()
+	if err != nil {
+		return 0, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	id := a.nextSnapshot
+	a.snapshots[id] = dump
+	a.nextSnapshot++
+	return hexutil.Uint64(id), nil
+}
+
+// Revert restores the state of the EVM accounts recorded by the given
+// snapshot. The snapshot and the ones taken after it are discarded. It returns
+// false if the snapshot doesn't exist. The accounts created since the snapshot
+// are emptied, and the state of the other modules and the time offset are not
+// reverted.
+func (a *API) Revert(id hexutil.Uint64) (bool, error) {
+	a.logger.Debug("dev_revert", "id", id)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	snapshot, ok := a.snapshots[uint64(id)]
+	if !ok {
+		return false, nil
+	}
+
+	current, err := a.backend.DevStateDump()
+	if err != nil {
+		return false, err
+	}
+
+	// the coinbase signs the dev messages, so its nonce must keep increasing
+	coinbase, err := a.backend.GetCoinbase()
+	if err != nil {
+		return false, err
+	}
+
+	overrides, err := revertOverrides(snapshot, current, common.BytesToAddress(coinbase))
+	if err != nil {
+		return false, err
+	}
+	if len(overrides) > 0 {
+		if err := a.backend.DevSetState(overrides); err != nil {
+			return false, err
+		}
+	}
+
+	for snapshotID := range a.snapshots {
+		if snapshotID >= uint64(id) {
+			delete(a.snapshots, snapshotID)
+		}
+	}
+	return true, nil
+}
+
+// revertOverrides returns the state overrides that restore the snapshot from
+// the current state, skipping the nonce of the given signer.
+func revertOverrides(snapshot, current evmtypes.StateOverride, signer common.Address) (evmtypes.StateOverride, error) {
+	overrides := make(evmtypes.StateOverride)
+
+	for addr, account := range snapshot {
+		equal, err := equalAccounts(account, current[addr])
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			overrides[addr] = account
+		}
+	}
+
+	// empty the accounts created since the snapshot
+	for addr := range current {
+		if _, ok := snapshot[addr]; ok {
+			continue
+		}
+		var (
+			nonce   hexutil.Uint64
+			code    hexutil.Bytes
+			balance = new(hexutil.Big)
+			state   = make(map[common.Hash]common.Hash)
+		)
+		overrides[addr] = evmtypes.OverrideAccount{Nonce: &nonce, Code: &code, Balance: &balance, State: &state}
+	}
+
+	if account, ok := overrides[signer]; ok {
+		account.Nonce = nil
+		overrides[signer] = account
+	}
+	return overrides, nil
+}
+
+// equalAccounts returns true if the given accounts have the same state.
+func equalAccounts(a, b evmtypes.OverrideAccount) (bool, error) {
+	bzA, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	bzB, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(bzA, bzB), nil
+}
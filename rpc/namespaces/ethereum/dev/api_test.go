@@ -0,0 +1,86 @@
+package dev
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestQuantityUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		input  string
+		exp    Quantity
+		expErr bool
+	}{
+		{input: `3600`, exp: 3600},
+		{input: `"3600"`, exp: 3600},
+		{input: `"0xe10"`, exp: 3600},
+		{input: `"0x"`, expErr: true},
+		{input: `-1`, expErr: true},
+		{input: `"one hour"`, expErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			var q Quantity
+			err := json.Unmarshal([]byte(tc.input), &q)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, q)
+		})
+	}
+}
+
+func TestRevertOverrides(t *testing.T) {
+	var (
+		signer    = common.HexToAddress("0x01")
+		unchanged = common.HexToAddress("0x02")
+		changed   = common.HexToAddress("0x03")
+		created   = common.HexToAddress("0x04")
+	)
+
+	account := func(nonce uint64, balance int64) evmtypes.OverrideAccount {
+		n := hexutil.Uint64(nonce)
+		b := (*hexutil.Big)(big.NewInt(balance))
+		code := hexutil.Bytes{}
+		state := map[common.Hash]common.Hash{}
+		return evmtypes.OverrideAccount{Nonce: &n, Balance: &b, Code: &code, State: &state}
+	}
+
+	snapshot := evmtypes.StateOverride{
+		signer:    account(1, 100),
+		unchanged: account(2, 200),
+		changed:   account(3, 300),
+	}
+	current := evmtypes.StateOverride{
+		signer:    account(5, 90),
+		unchanged: account(2, 200),
+		changed:   account(4, 0),
+		created:   account(1, 50),
+	}
+
+	overrides, err := revertOverrides(snapshot, current, signer)
+	require.NoError(t, err)
+	require.Len(t, overrides, 3)
+	require.NotContains(t, overrides, unchanged)
+
+	require.Equal(t, snapshot[changed], overrides[changed])
+
+	// the balance of the signer is restored but its nonce keeps increasing
+	require.Nil(t, overrides[signer].Nonce)
+	require.Equal(t, big.NewInt(100), (*overrides[signer].Balance).ToInt())
+
+	require.Equal(t, hexutil.Uint64(0), *overrides[created].Nonce)
+	require.Equal(t, 0, (*overrides[created].Balance).ToInt().Sign())
+	require.Empty(t, *overrides[created].Code)
+	require.Empty(t, *overrides[created].State)
+}
@@ -25,8 +25,7 @@ import (
 )
 
 var (
-	errInvalidBlockRange      = errors.New("invalid block range params")
-	errPendingLogsUnsupported = errors.New("pending logs are not supported")
+	errInvalidBlockRange = errors.New("invalid block range params")
 )
 
 // FilterAPI gathers
@@ -49,9 +48,11 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	PendingLogs() ([]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	BloomBits(bit uint, section uint64) ([]byte, error)
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
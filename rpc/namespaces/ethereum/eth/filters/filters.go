@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/big"
+	"math/bits"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -119,9 +122,14 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		return f.blockLogs(blockRes, bloom)
 	}
 
-	// Disallow pending logs.
-	if f.criteria.FromBlock.Int64() == rpc.PendingBlockNumber.Int64() || f.criteria.ToBlock.Int64() == rpc.PendingBlockNumber.Int64() {
-		return nil, errPendingLogsUnsupported
+	// The pending block can only end a range, since it follows the latest one.
+	fromPending := f.criteria.FromBlock.Int64() == rpc.PendingBlockNumber.Int64()
+	toPending := f.criteria.ToBlock.Int64() == rpc.PendingBlockNumber.Int64()
+	if fromPending {
+		if !toPending {
+			return nil, errInvalidBlockRange
+		}
+		return f.pendingLogs(logs, logLimit)
 	}
 
 	// Figure out the limits of the filter range
@@ -154,9 +162,12 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	if err != nil {
 		return nil, err
 	}
-	to, err := resolveSpecial(f.criteria.ToBlock.Int64())
-	if err != nil {
-		return nil, err
+	to := head
+	if !toPending {
+		to, err = resolveSpecial(f.criteria.ToBlock.Int64())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// check bounds
@@ -172,7 +183,24 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
+	// skip the blocks the bloom bits of their section rule out
+	sectionSize, _ := f.backend.BloomStatus()
+	var (
+		matches        []byte
+		matchesSection = uint64(math.MaxUint64)
+	)
+
 	for height := from; height <= to; height++ {
+		if len(f.bloomFilters) > 0 && sectionSize > 0 {
+			if section := height / sectionSize; section != matchesSection {
+				matches = f.sectionMatches(section)
+				matchesSection = section
+			}
+			if offset := height % sectionSize; matches != nil && matches[offset/8]&(1<<(7-offset%8)) == 0 {
+				continue
+			}
+		}
+
 		h := int64(height) //#nosec G115
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&h)
 		if err != nil {
@@ -196,9 +224,28 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		}
 		logs = append(logs, filtered...)
 	}
+
+	if toPending {
+		return f.pendingLogs(logs, logLimit)
+	}
 	return logs, nil
 }
 
+// pendingLogs appends the logs of the pending block matching the filter
+// criteria to the given logs.
+func (f *Filter) pendingLogs(logs []*ethtypes.Log, logLimit int) ([]*ethtypes.Log, error) {
+	unfiltered, err := f.backend.PendingLogs()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pending logs")
+	}
+
+	filtered := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+	if len(logs)+len(filtered) > logLimit {
+		return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+	return append(logs, filtered...), nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -223,6 +270,47 @@ func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtyp
 	return logs, nil
 }
 
+// sectionMatches returns the bit vector of the blocks of a section whose bloom
+// may match the filter, nil if the bloom bits of the section are not indexed.
+func (f *Filter) sectionMatches(section uint64) []byte {
+	var matches []byte
+	for _, bloomIVs := range f.bloomFilters {
+		// a block matches a filter rule if its bloom has all the bits of any clause
+		var ruleMatches []byte
+		for _, iv := range bloomIVs {
+			var clauseMatches []byte
+			for i := range iv.I {
+				bit := iv.I[i]*8 + uint(bits.TrailingZeros8(iv.V[i]))
+				vector, err := f.backend.BloomBits(bit, section)
+				if err != nil {
+					f.logger.Debug("failed to fetch bloom bits", "section", section, "bit", bit, "error", err.Error())
+					return nil
+				}
+				if vector == nil {
+					return nil
+				}
+				if clauseMatches == nil {
+					clauseMatches = vector
+					continue
+				}
+				bitutil.ANDBytes(clauseMatches, clauseMatches, vector)
+			}
+			if ruleMatches == nil {
+				ruleMatches = clauseMatches
+				continue
+			}
+			bitutil.ORBytes(ruleMatches, ruleMatches, clauseMatches)
+		}
+		// a block matches the filter if it matches all the rules
+		if matches == nil {
+			matches = ruleMatches
+			continue
+		}
+		bitutil.ANDBytes(matches, matches, ruleMatches)
+	}
+	return matches
+}
+
 func createBloomFilters(filters [][][]byte, logger log.Logger) [][]BloomIV {
 	bloomFilters := make([][]BloomIV, 0)
 	for _, filter := range filters {
@@ -0,0 +1,174 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// bloomBitsBackend serves the block results and the bloom bits of an indexer,
+// recording the heights whose block results are fetched.
+type bloomBitsBackend struct {
+	Backend
+
+	head    int64
+	idxer   *indexer.KVIndexer
+	results map[int64][]*abci.ExecTxResult
+	pending []*ethtypes.Log
+	fetched []int64
+}
+
+func (b *bloomBitsBackend) HeaderByNumber(types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *bloomBitsBackend) TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	b.fetched = append(b.fetched, *height)
+	return &coretypes.ResultBlockResults{Height: *height, TxsResults: b.results[*height]}, nil
+}
+
+func (b *bloomBitsBackend) BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	logs, err := backend.GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return ethtypes.Bloom{}, err
+	}
+	var bloom ethtypes.Bloom
+	for _, txLogs := range logs {
+		for _, log := range txLogs {
+			bloom.Add(log.Address.Bytes())
+		}
+	}
+	return bloom, nil
+}
+
+func (b *bloomBitsBackend) BloomStatus() (uint64, uint64) {
+	sections, _ := b.idxer.BloomSections()
+	return 4096, sections
+}
+
+func (b *bloomBitsBackend) BloomBits(bit uint, section uint64) ([]byte, error) {
+	return b.idxer.BloomBits(bit, section)
+}
+
+func (b *bloomBitsBackend) PendingLogs() ([]*ethtypes.Log, error) {
+	return b.pending, nil
+}
+
+func TestLogsBloomBits(t *testing.T) {
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bz, err := json.Marshal(&evmtypes.Log{Address: address.Hex(), Topics: []string{}})
+	require.NoError(t, err)
+
+	b := &bloomBitsBackend{
+		head:  4200,
+		idxer: indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{}),
+		results: map[int64][]*abci.ExecTxResult{
+			10: {{
+				Events: []abci.Event{{
+					Type:       evmtypes.EventTypeTxLog,
+					Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}},
+				}},
+			}},
+		},
+	}
+	// only the first section is complete
+	for height := int64(1); height <= 4100; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, b.idxer.IndexBlock(block, b.results[height]))
+	}
+	_, err = b.idxer.ProcessBloomSections()
+	require.NoError(t, err)
+
+	filter := NewRangeFilter(log.NewNopLogger(), b, 1, 4200, []common.Address{address}, nil)
+	logs, err := filter.Logs(context.Background(), 100, -1)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, address, logs[0].Address)
+
+	// the blocks of the first section without matching logs are skipped
+	expFetched := []int64{10}
+	for height := int64(4096); height <= 4200; height++ {
+		expFetched = append(expFetched, height)
+	}
+	require.Equal(t, expFetched, b.fetched)
+
+	// without criteria every block is scanned
+	b.fetched = nil
+	filter = NewRangeFilter(log.NewNopLogger(), b, 1, 4200, nil, nil)
+	_, err = filter.Logs(context.Background(), 100, -1)
+	require.NoError(t, err)
+	require.Len(t, b.fetched, 4200)
+}
+
+func TestLogsPending(t *testing.T) {
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	bz, err := json.Marshal(&evmtypes.Log{Address: address.Hex(), Topics: []string{}, BlockNumber: 5})
+	require.NoError(t, err)
+
+	b := &bloomBitsBackend{
+		head:  5,
+		idxer: indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{}),
+		results: map[int64][]*abci.ExecTxResult{
+			5: {{
+				Events: []abci.Event{{
+					Type:       evmtypes.EventTypeTxLog,
+					Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}},
+				}},
+			}},
+		},
+		pending: []*ethtypes.Log{
+			{Address: address, BlockNumber: 6},
+			{Address: other, BlockNumber: 6},
+		},
+	}
+	pending := rpc.PendingBlockNumber.Int64()
+
+	testCases := []struct {
+		name     string
+		from, to int64
+		expPass  bool
+		expLogs  []uint64
+	}{
+		{"pending only", pending, pending, true, []uint64{6}},
+		{"latest to pending", rpc.LatestBlockNumber.Int64(), pending, true, []uint64{5, 6}},
+		{"pending to latest", pending, rpc.LatestBlockNumber.Int64(), false, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := NewRangeFilter(log.NewNopLogger(), b, tc.from, tc.to, []common.Address{address}, nil)
+			logs, err := filter.Logs(context.Background(), 100, -1)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			blockNumbers := make([]uint64, 0, len(logs))
+			for _, log := range logs {
+				require.Equal(t, address, log.Address)
+				blockNumbers = append(blockNumbers, log.BlockNumber)
+			}
+			require.Equal(t, tc.expLogs, blockNumbers)
+		})
+	}
+}
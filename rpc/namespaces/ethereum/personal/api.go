@@ -2,6 +2,7 @@ package personal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"time"
@@ -59,6 +60,20 @@ func (api *PrivateAccountAPI) ImportRawKey(privkey, password string) (common.Add
 	return api.backend.ImportRawKey(privkey, password)
 }
 
+// ImportKeystore decrypts the private key of a Web3 Secret Storage (keystore
+// v3) file with the given password and stores it into the key directory, like
+// ImportRawKey. The file can be given as a JSON object or as a JSON encoded
+// string.
+func (api *PrivateAccountAPI) ImportKeystore(keyJSON json.RawMessage, password string) (common.Address, error) {
+	api.logger.Debug("personal_importKeystore")
+
+	var encoded string
+	if err := json.Unmarshal(keyJSON, &encoded); err == nil {
+		keyJSON = json.RawMessage(encoded)
+	}
+	return api.backend.ImportKeystore(keyJSON, password)
+}
+
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 	api.logger.Debug("personal_listAccounts")
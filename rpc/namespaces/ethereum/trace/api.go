@@ -0,0 +1,262 @@
+package trace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// traceTypeTrace is the only trace type supported by trace_replayBlockTransactions
+const traceTypeTrace = "trace"
+
+// maxFilterBlockRange is the maximum number of blocks traced by a single
+// trace_filter query, as each of them is re-executed.
+const maxFilterBlockRange uint64 = 100
+
+// callTracerConfig is the trace configuration used to get the call frames of the
+// transactions, that are then flattened into OpenEthereum traces.
+var callTracerConfig = &evmtypes.TraceConfig{Tracer: "callTracer"}
+
+// API offers the OpenEthereum trace methods, which return the call frames of the
+// transactions as flat traces.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the trace methods.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// txCallFrame is the root call frame of a traced transaction.
+type txCallFrame struct {
+	hash  common.Hash
+	frame *callFrame
+}
+
+// Block returns the traces of all the transactions of the given block.
+func (api *API) Block(blockNr rpctypes.BlockNumber) ([]*Trace, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+
+	blockHash, height, txFrames, err := api.traceBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([]*Trace, 0, len(txFrames))
+	for i, txFrame := range txFrames {
+		traces = append(traces, txFrame.traces(blockHash, height, uint64(i))...)
+	}
+
+	return traces, nil
+}
+
+// Transaction returns the traces of the given transaction.
+func (api *API) Transaction(hash common.Hash) ([]*Trace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+
+	tx, err := api.backend.GetTransactionByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil || tx.BlockHash == nil || tx.BlockNumber == nil || tx.TransactionIndex == nil {
+		return nil, fmt.Errorf("transaction %s not found", hash)
+	}
+
+	res, err := api.backend.TraceTransaction(hash, callTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	frame, err := decodeCallFrame(res)
+	if err != nil {
+		return nil, err
+	}
+
+	txFrame := txCallFrame{hash: hash, frame: frame}
+	return txFrame.traces(*tx.BlockHash, tx.BlockNumber.ToInt().Uint64(), uint64(*tx.TransactionIndex)), nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns their traces. Only the `trace` trace type is supported.
+func (api *API) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*TraceResults, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "traceTypes", traceTypes)
+
+	withTrace := false
+	for _, traceType := range traceTypes {
+		if traceType != traceTypeTrace {
+			return nil, fmt.Errorf("trace type %q is not supported", traceType)
+		}
+		withTrace = true
+	}
+
+	_, _, txFrames, err := api.traceBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*TraceResults, 0, len(txFrames))
+	for _, txFrame := range txFrames {
+		result := &TraceResults{
+			Output:          txFrame.frame.Output,
+			Trace:           []*Trace{},
+			TransactionHash: &txFrame.hash,
+		}
+		if withTrace {
+			result.Trace = txFrame.frame.flatten([]int{}, result.Trace)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// Filter returns the traces of the transactions in the given block range that
+// match the from and to addresses of the filter.
+func (api *API) Filter(args FilterArgs) ([]*Trace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	fromBlock, toBlock := uint64(latest), uint64(latest)
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		fromBlock = uint64(args.FromBlock.Int64())
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		toBlock = uint64(args.ToBlock.Int64())
+	}
+
+	if fromBlock > toBlock {
+		return nil, errors.New("invalid block range params")
+	}
+
+	blockLimit := maxFilterBlockRange
+	if rangeCap := api.backend.RPCBlockRangeCap(); rangeCap > 0 && uint64(rangeCap) < blockLimit {
+		blockLimit = uint64(rangeCap)
+	}
+	if toBlock-fromBlock > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var (
+		traces  = []*Trace{}
+		skipped uint64
+	)
+	for height := fromBlock; height <= toBlock; height++ {
+		blockTraces, err := api.Block(rpctypes.BlockNumber(height)) //#nosec G115 -- int overflow is not a concern here
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !args.matches(trace) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// traceBlock traces the transactions of the given block with the callTracer and
+// returns the block hash and height, along with the root call frame of each
+// transaction.
+func (api *API) traceBlock(blockNr rpctypes.BlockNumber) (common.Hash, uint64, []txCallFrame, error) {
+	resBlock, err := api.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		api.logger.Debug("get block failed", "number", blockNr, "error", err.Error())
+		return common.Hash{}, 0, nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		return common.Hash{}, 0, nil, errors.New("block not found")
+	}
+
+	msgs, results, err := api.backend.TraceBlockTransactions(callTracerConfig, resBlock)
+	if err != nil {
+		return common.Hash{}, 0, nil, err
+	}
+
+	if len(msgs) != len(results) {
+		return common.Hash{}, 0, nil, fmt.Errorf("expected %d trace results, got %d", len(msgs), len(results))
+	}
+
+	// a transaction that fails to be traced is reported by the error of its
+	// root trace, without failing the others
+	txFrames := make([]txCallFrame, 0, len(msgs))
+	for i, msg := range msgs {
+		ethTx := msg.AsTransaction()
+		hash := ethTx.Hash()
+		if results[i].Error != "" {
+			api.logger.Debug("failed to trace transaction", "hash", hash, "error", results[i].Error)
+			txFrames = append(txFrames, txCallFrame{hash: hash, frame: failedCallFrame(msg, ethTx, results[i].Error)})
+			continue
+		}
+
+		frame, err := decodeCallFrame(results[i].Result)
+		if err != nil {
+			api.logger.Debug("failed to decode call frame", "hash", hash, "error", err.Error())
+			txFrames = append(txFrames, txCallFrame{hash: hash, frame: failedCallFrame(msg, ethTx, err.Error())})
+			continue
+		}
+		txFrames = append(txFrames, txCallFrame{hash: hash, frame: frame})
+	}
+
+	return common.BytesToHash(resBlock.Block.Hash()), uint64(resBlock.Block.Height), txFrames, nil //#nosec G115 -- int overflow is not a concern here
+}
+
+// failedCallFrame returns the root call frame of a transaction that failed to be
+// traced, built from the transaction itself and holding the given error.
+func failedCallFrame(msg *evmtypes.MsgEthereumTx, tx *ethtypes.Transaction, err string) *callFrame {
+	frameType := vm.CALL
+	if tx.To() == nil {
+		frameType = vm.CREATE
+	}
+	return &callFrame{
+		Type:  frameType.String(),
+		From:  common.BytesToAddress(msg.From),
+		To:    tx.To(),
+		Value: (*hexutil.Big)(tx.Value()),
+		Gas:   hexutil.Uint64(tx.Gas()),
+		Input: tx.Data(),
+		Error: err,
+	}
+}
+
+// traces returns the flat traces of the transaction, including its position in
+// the block.
+func (t txCallFrame) traces(blockHash common.Hash, height, position uint64) []*Trace {
+	traces := t.frame.flatten([]int{}, nil)
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &height
+		trace.TransactionHash = &t.hash
+		trace.TransactionPosition = &position
+	}
+	return traces
+}
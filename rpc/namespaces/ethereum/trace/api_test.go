@@ -0,0 +1,36 @@
+package trace
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestFailedCallFrame(t *testing.T) {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	msg := &evmtypes.MsgEthereumTx{From: from.Bytes()}
+
+	call := ethtypes.NewTx(&ethtypes.LegacyTx{To: &to, Value: big.NewInt(1), Gas: 21000, Data: []byte{1}})
+	traces := failedCallFrame(msg, call, "execution timeout").flatten([]int{}, nil)
+	require.Len(t, traces, 1)
+	require.Equal(t, traceTypeCall, traces[0].Type)
+	require.Equal(t, "execution timeout", traces[0].Error)
+	require.Nil(t, traces[0].Result)
+	action, ok := traces[0].Action.(*CallAction)
+	require.True(t, ok)
+	require.Equal(t, from, action.From)
+	require.Equal(t, to, action.To)
+	require.Equal(t, "call", action.CallType)
+
+	create := ethtypes.NewTx(&ethtypes.LegacyTx{Value: big.NewInt(0), Gas: 100000, Data: []byte{0x60}})
+	traces = failedCallFrame(msg, create, "execution timeout").flatten([]int{}, nil)
+	require.Len(t, traces, 1)
+	require.Equal(t, traceTypeCreate, traces[0].Type)
+	require.Nil(t, traces[0].Result)
+}
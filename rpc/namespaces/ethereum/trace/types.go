@@ -0,0 +1,237 @@
+package trace
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+const (
+	traceTypeCall    = "call"
+	traceTypeCreate  = "create"
+	traceTypeSuicide = "suicide"
+
+	errReverted = "Reverted"
+	errOutOfGas = "Out of gas"
+)
+
+// Trace is a flat trace of a single call frame, as defined by the OpenEthereum
+// trace module.
+type Trace struct {
+	Action              interface{}  `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              interface{}  `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+// CallAction is the action of a call trace.
+type CallAction struct {
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Input    hexutil.Bytes  `json:"input"`
+	To       common.Address `json:"to"`
+	Value    *hexutil.Big   `json:"value"`
+}
+
+// CallResult is the result of a successful call trace.
+type CallResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+// CreateAction is the action of a contract creation trace.
+type CreateAction struct {
+	From  common.Address `json:"from"`
+	Gas   hexutil.Uint64 `json:"gas"`
+	Init  hexutil.Bytes  `json:"init"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// CreateResult is the result of a successful contract creation trace.
+type CreateResult struct {
+	Address common.Address `json:"address"`
+	Code    hexutil.Bytes  `json:"code"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+// SuicideAction is the action of a self-destruct trace.
+type SuicideAction struct {
+	Address       common.Address `json:"address"`
+	Balance       *hexutil.Big   `json:"balance"`
+	RefundAddress common.Address `json:"refundAddress"`
+}
+
+// TraceResults is the result of replaying a transaction. Only the `trace` trace
+// type is supported, so the state diff and VM trace are always empty.
+type TraceResults struct {
+	Output          hexutil.Bytes `json:"output"`
+	StateDiff       interface{}   `json:"stateDiff"`
+	Trace           []*Trace      `json:"trace"`
+	VMTrace         interface{}   `json:"vmTrace"`
+	TransactionHash *common.Hash  `json:"transactionHash,omitempty"`
+}
+
+// FilterArgs are the arguments of the trace_filter method.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// callFrame is a call frame as returned by the callTracer.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to"`
+	Value   *hexutil.Big    `json:"value"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output"`
+	Error   string          `json:"error"`
+	Calls   []callFrame     `json:"calls"`
+}
+
+// decodeCallFrame decodes the result of the callTracer into its call frame.
+func decodeCallFrame(result interface{}) (*callFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	var frame callFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// flatten appends the flat traces of the call frame and its sub calls, in depth
+// first order, to the given traces.
+func (f *callFrame) flatten(traceAddress []int, traces []*Trace) []*Trace {
+	trace := &Trace{
+		Subtraces:    len(f.Calls),
+		TraceAddress: traceAddress,
+		Error:        formatError(f.Error),
+	}
+
+	value := f.Value
+	if value == nil {
+		value = (*hexutil.Big)(common.Big0)
+	}
+
+	var to common.Address
+	if f.To != nil {
+		to = *f.To
+	}
+
+	switch vm.StringToOp(f.Type) {
+	case vm.CREATE, vm.CREATE2:
+		trace.Type = traceTypeCreate
+		trace.Action = &CreateAction{
+			From:  f.From,
+			Gas:   f.Gas,
+			Init:  f.Input,
+			Value: value,
+		}
+		if f.Error == "" {
+			trace.Result = &CreateResult{
+				Address: to,
+				Code:    f.Output,
+				GasUsed: f.GasUsed,
+			}
+		}
+	case vm.SELFDESTRUCT:
+		trace.Type = traceTypeSuicide
+		trace.Action = &SuicideAction{
+			Address:       f.From,
+			Balance:       value,
+			RefundAddress: to,
+		}
+	default:
+		trace.Type = traceTypeCall
+		trace.Action = &CallAction{
+			CallType: strings.ToLower(f.Type),
+			From:     f.From,
+			Gas:      f.Gas,
+			Input:    f.Input,
+			To:       to,
+			Value:    value,
+		}
+		if f.Error == "" {
+			trace.Result = &CallResult{
+				GasUsed: f.GasUsed,
+				Output:  f.Output,
+			}
+		}
+	}
+
+	traces = append(traces, trace)
+	for i := range f.Calls {
+		childAddress := make([]int, len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		childAddress[len(traceAddress)] = i
+		traces = f.Calls[i].flatten(childAddress, traces)
+	}
+
+	return traces
+}
+
+// formatError converts the EVM errors reported by the callTracer into the ones
+// used by the OpenEthereum traces.
+func formatError(err string) string {
+	switch err {
+	case vm.ErrExecutionReverted.Error():
+		return errReverted
+	case vm.ErrOutOfGas.Error():
+		return errOutOfGas
+	default:
+		return err
+	}
+}
+
+// matches returns true if the trace matches the from and to addresses of the filter.
+func (args *FilterArgs) matches(trace *Trace) bool {
+	var from, to common.Address
+	switch action := trace.Action.(type) {
+	case *CallAction:
+		from, to = action.From, action.To
+	case *CreateAction:
+		from = action.From
+		if result, ok := trace.Result.(*CreateResult); ok {
+			to = result.Address
+		}
+	case *SuicideAction:
+		from, to = action.Address, action.RefundAddress
+	}
+
+	return containsAddress(args.FromAddress, from) && containsAddress(args.ToAddress, to)
+}
+
+// containsAddress returns true if the address is in the list, or if the list is empty.
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	if len(addrs) == 0 {
+		return true
+	}
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
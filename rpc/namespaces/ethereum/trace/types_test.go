@@ -0,0 +1,126 @@
+package trace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	// callTracer output of a call that creates a contract, which reverts a
+	// static call and then self-destructs
+	callTracerResult := `{
+		"type": "CALL",
+		"from": "0x0000000000000000000000000000000000000001",
+		"to": "0x0000000000000000000000000000000000000002",
+		"value": "0x1",
+		"gas": "0x5208",
+		"gasUsed": "0x5000",
+		"input": "0x01",
+		"output": "0x02",
+		"calls": [
+			{
+				"type": "CREATE2",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000003",
+				"value": "0x0",
+				"gas": "0x100",
+				"gasUsed": "0x50",
+				"input": "0x6000",
+				"output": "0x00",
+				"calls": [
+					{
+						"type": "STATICCALL",
+						"from": "0x0000000000000000000000000000000000000003",
+						"to": "0x0000000000000000000000000000000000000004",
+						"gas": "0x10",
+						"gasUsed": "0x10",
+						"input": "0x",
+						"error": "execution reverted"
+					}
+				]
+			},
+			{
+				"type": "SELFDESTRUCT",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000001",
+				"value": "0x5",
+				"gas": "0x0",
+				"gasUsed": "0x0",
+				"input": "0x"
+			}
+		]
+	}`
+
+	var result interface{}
+	require.NoError(t, json.Unmarshal([]byte(callTracerResult), &result))
+
+	frame, err := decodeCallFrame(result)
+	require.NoError(t, err)
+
+	traces := frame.flatten([]int{}, nil)
+	require.Len(t, traces, 4)
+
+	// top level call
+	require.Equal(t, traceTypeCall, traces[0].Type)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, 2, traces[0].Subtraces)
+	callAction, ok := traces[0].Action.(*CallAction)
+	require.True(t, ok)
+	require.Equal(t, "call", callAction.CallType)
+	require.Equal(t, common.HexToAddress("0x2"), callAction.To)
+	require.Equal(t, uint64(0x5000), uint64(traces[0].Result.(*CallResult).GasUsed))
+
+	// contract creation
+	require.Equal(t, traceTypeCreate, traces[1].Type)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, 1, traces[1].Subtraces)
+	createResult, ok := traces[1].Result.(*CreateResult)
+	require.True(t, ok)
+	require.Equal(t, common.HexToAddress("0x3"), createResult.Address)
+
+	// reverted static call, without value nor result
+	require.Equal(t, traceTypeCall, traces[2].Type)
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+	require.Equal(t, errReverted, traces[2].Error)
+	require.Nil(t, traces[2].Result)
+	staticAction, ok := traces[2].Action.(*CallAction)
+	require.True(t, ok)
+	require.Equal(t, "staticcall", staticAction.CallType)
+	require.Equal(t, int64(0), staticAction.Value.ToInt().Int64())
+
+	// self-destruct
+	require.Equal(t, traceTypeSuicide, traces[3].Type)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+	suicideAction, ok := traces[3].Action.(*SuicideAction)
+	require.True(t, ok)
+	require.Equal(t, common.HexToAddress("0x2"), suicideAction.Address)
+	require.Equal(t, common.HexToAddress("0x1"), suicideAction.RefundAddress)
+	require.Equal(t, int64(5), suicideAction.Balance.ToInt().Int64())
+}
+
+func TestFilterArgsMatches(t *testing.T) {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	trace := &Trace{Action: &CallAction{From: from, To: to}}
+
+	testCases := []struct {
+		name     string
+		args     FilterArgs
+		expMatch bool
+	}{
+		{"empty filter", FilterArgs{}, true},
+		{"matching from address", FilterArgs{FromAddress: []common.Address{from}}, true},
+		{"matching from and to addresses", FilterArgs{FromAddress: []common.Address{from}, ToAddress: []common.Address{to}}, true},
+		{"non matching from address", FilterArgs{FromAddress: []common.Address{to}}, false},
+		{"non matching to address", FilterArgs{FromAddress: []common.Address{from}, ToAddress: []common.Address{from}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, tc.args.matches(trace))
+		})
+	}
+}
@@ -11,7 +11,6 @@ import (
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
 type PublicAPI struct {
 	logger  log.Logger
 	backend backend.EVMBackend
@@ -31,8 +30,8 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 	return api.backend.Content()
 }
 
-// ContentFrom returns the transactions contained within the transaction pool
-func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]map[string]*types.RPCTransaction, error) {
+// ContentFrom returns the transactions contained within the transaction pool for the given address
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_contentFrom")
 	return api.backend.ContentFrom(address)
 }
@@ -0,0 +1,192 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// The proofs returned by eth_getProof are the ICS-23 commitment proofs of the
+// IAVL store of the module, followed by the proof of the store root within the
+// multistore. Only the proof data is returned, so the verification helpers below
+// rebuild the proof operations from the queried key.
+//
+// NOTE: the proofs of a query at height H are checked against the app hash of
+// the state after block H, which is included in the header of block H+1.
+
+// VerifyStorageProof checks the storage hash proof and the storage proofs of
+// the account against the app hash. A zero storage hash is verified as the
+// absence of the storage hash key, and a zero slot value as the absence of
+// the storage key.
+func VerifyStorageProof(appHash []byte, account *AccountResult) error {
+	if account == nil {
+		return errors.New("account result cannot be nil")
+	}
+
+	key := evmtypes.StorageHashKey(account.Address)
+	var err error
+	if account.StorageHash == (common.Hash{}) {
+		err = verifyStoreProof(appHash, evmtypes.StoreKey, key, nil, account.StorageHashProof)
+	} else {
+		err = verifyStoreProof(appHash, evmtypes.StoreKey, key, account.StorageHash.Bytes(), account.StorageHashProof)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid storage hash proof: %w", err)
+	}
+
+	for _, storage := range account.StorageProof {
+		if err := verifySlotProof(appHash, account.Address, storage); err != nil {
+			return fmt.Errorf("invalid storage proof of key %s: %w", storage.Key, err)
+		}
+	}
+	return nil
+}
+
+// verifySlotProof checks the proof of a storage slot of the given account.
+func verifySlotProof(appHash []byte, address common.Address, storage StorageResult) error {
+	key := evmtypes.StateKey(address, common.HexToHash(storage.Key).Bytes())
+
+	var value common.Hash
+	if storage.Value != nil {
+		value = common.BigToHash(storage.Value.ToInt())
+	}
+
+	if value == (common.Hash{}) {
+		return verifyStoreProof(appHash, evmtypes.StoreKey, key, nil, storage.Proof)
+	}
+	return verifyStoreProof(appHash, evmtypes.StoreKey, key, value.Bytes(), storage.Proof)
+}
+
+// VerifyCodeHashProof checks the code hash proof of the account against the app
+// hash. The code hash of accounts without code is not stored, so an empty code
+// hash is verified as the absence of the code hash key.
+func VerifyCodeHashProof(appHash []byte, account *AccountResult) error {
+	if account == nil {
+		return errors.New("account result cannot be nil")
+	}
+
+	key := evmtypes.CodeHashKey(account.Address)
+
+	if account.CodeHash == (common.Hash{}) || account.CodeHash == crypto.Keccak256Hash(nil) {
+		return verifyStoreProof(appHash, evmtypes.StoreKey, key, nil, account.CodeHashProof)
+	}
+	return verifyStoreProof(appHash, evmtypes.StoreKey, key, account.CodeHash.Bytes(), account.CodeHashProof)
+}
+
+// VerifyBalanceProof checks the balance proofs of the account against the app
+// hash, given the denom and decimals of the EVM coin. The integer part of the
+// balance is verified in the bank store and, if the EVM coin has less than 18
+// decimals, the fractional part in the precisebank store. Zero amounts are
+// verified as the absence of their key.
+func VerifyBalanceProof(appHash []byte, account *AccountResult, denom string, decimals evmtypes.Decimals) error {
+	if account == nil {
+		return errors.New("account result cannot be nil")
+	}
+	if account.Balance == nil {
+		return errors.New("account balance cannot be nil")
+	}
+
+	balance := sdkmath.NewIntFromBigInt(account.Balance.ToInt())
+	integer, fractional := balance, sdkmath.ZeroInt()
+	if decimals != evmtypes.EighteenDecimals {
+		conversionFactor := decimals.ConversionFactor()
+		integer, fractional = balance.Quo(conversionFactor), balance.Mod(conversionFactor)
+	}
+
+	key, err := BalanceKey(account.Address, denom)
+	if err != nil {
+		return err
+	}
+	if err := verifyIntProof(appHash, banktypes.StoreKey, key, integer, account.BalanceProof); err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+
+	if decimals == evmtypes.EighteenDecimals {
+		return nil
+	}
+	if err := verifyIntProof(appHash, precisebanktypes.StoreKey, FractionalBalanceKey(account.Address), fractional, account.FractionalBalanceProof); err != nil {
+		return fmt.Errorf("invalid fractional balance proof: %w", err)
+	}
+	return nil
+}
+
+// BalanceKey returns the key of the bank balance of the given denom.
+func BalanceKey(address common.Address, denom string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(
+		banktypes.BalancesPrefix,
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+		collections.Join(sdk.AccAddress(address.Bytes()), denom),
+	)
+}
+
+// FractionalBalanceKey returns the key of the fractional balance in the
+// precisebank store.
+func FractionalBalanceKey(address common.Address) []byte {
+	return append(precisebanktypes.FractionalBalancePrefix, precisebanktypes.FractionalBalanceKey(address.Bytes())...)
+}
+
+// verifyIntProof verifies the proof of an integer amount stored under the key.
+func verifyIntProof(appHash []byte, storeKey string, key []byte, amount sdkmath.Int, proof []string) error {
+	if amount.IsZero() {
+		return verifyStoreProof(appHash, storeKey, key, nil, proof)
+	}
+
+	value, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	return verifyStoreProof(appHash, storeKey, key, value, proof)
+}
+
+// verifyStoreProof verifies the proof of the key in the given store against the
+// app hash. The existence of the value is verified if it is not nil, and the
+// absence of the key otherwise.
+func verifyStoreProof(appHash []byte, storeKey string, key, value []byte, proof []string) error {
+	if len(proof) != 2 {
+		return fmt.Errorf("invalid proof length, expected 2, got %d", len(proof))
+	}
+
+	storeProof, err := hexutil.Decode(proof[0])
+	if err != nil {
+		return fmt.Errorf("invalid store proof: %w", err)
+	}
+	rootProof, err := hexutil.Decode(proof[1])
+	if err != nil {
+		return fmt.Errorf("invalid multistore proof: %w", err)
+	}
+
+	proofOps := &cmtcrypto.ProofOps{
+		Ops: []cmtcrypto.ProofOp{
+			{Type: storetypes.ProofOpIAVLCommitment, Key: key, Data: storeProof},
+			{Type: storetypes.ProofOpSimpleMerkleCommitment, Key: []byte(storeKey), Data: rootProof},
+		},
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if value == nil {
+		return prt.VerifyAbsence(proofOps, appHash, keyPath)
+	}
+	return prt.VerifyValue(proofOps, appHash, keyPath, value)
+}
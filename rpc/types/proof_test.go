@@ -0,0 +1,326 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// proofStore is a committed multistore with the given stores mounted, used to
+// get the proofs the verification helpers are checked against.
+type proofStore struct {
+	store   *rootmulti.Store
+	appHash []byte
+}
+
+func newProofStore(t *testing.T, stores map[string]map[string][]byte) *proofStore {
+	t.Helper()
+
+	db := dbm.NewMemDB()
+	store := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := make(map[string]*storetypes.KVStoreKey, len(stores))
+	for name := range stores {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		store.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	// mount another store so that the multistore proof is not trivial
+	store.MountStoreWithDB(storetypes.NewKVStoreKey("acc"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	for name, kvs := range stores {
+		kvStore := store.GetKVStore(keys[name])
+		for key, value := range kvs {
+			kvStore.Set([]byte(key), value)
+		}
+	}
+	commitID := store.Commit()
+
+	return &proofStore{store: store, appHash: commitID.Hash}
+}
+
+// proof returns the proof of the given key of the store, in the format returned
+// by eth_getProof.
+func (ps *proofStore) proof(t *testing.T, storeKey string, key []byte) []string {
+	t.Helper()
+
+	res, err := ps.store.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", storeKey),
+		Data:   key,
+		Height: ps.store.LastCommitID().Version,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.ProofOps)
+
+	proofs := make([]string, 0, len(res.ProofOps.Ops))
+	for _, op := range res.ProofOps.Ops {
+		proofs = append(proofs, hexutil.Encode(op.Data))
+	}
+	return proofs
+}
+
+func TestVerifyStorageProof(t *testing.T) {
+	address, emptyAddress := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	key, emptyKey := common.HexToHash("0x1"), common.HexToHash("0x2")
+	value := common.HexToHash("0x2a")
+	storageHash := evmtypes.UpdateStorageHash(common.Hash{}, key, common.Hash{}, value)
+
+	ps := newProofStore(t, map[string]map[string][]byte{
+		evmtypes.StoreKey: {
+			string(evmtypes.StateKey(address, key.Bytes())): value.Bytes(),
+			string(evmtypes.StorageHashKey(address)):        storageHash.Bytes(),
+		},
+	})
+	storageHashProof := ps.proof(t, evmtypes.StoreKey, evmtypes.StorageHashKey(address))
+	slotProof := ps.proof(t, evmtypes.StoreKey, evmtypes.StateKey(address, key.Bytes()))
+	emptySlotProof := ps.proof(t, evmtypes.StoreKey, evmtypes.StateKey(address, emptyKey.Bytes()))
+
+	testCases := []struct {
+		name    string
+		appHash []byte
+		account *AccountResult
+		expPass bool
+	}{
+		{
+			"pass - existing and empty slots",
+			ps.appHash,
+			&AccountResult{Address: address, StorageHash: storageHash, StorageHashProof: storageHashProof, StorageProof: []StorageResult{
+				{Key: key.Hex(), Value: (*hexutil.Big)(value.Big()), Proof: slotProof},
+				{Key: emptyKey.Hex(), Value: (*hexutil.Big)(big.NewInt(0)), Proof: emptySlotProof},
+			}},
+			true,
+		},
+		{
+			"pass - account without storage",
+			ps.appHash,
+			&AccountResult{Address: emptyAddress, StorageHashProof: ps.proof(t, evmtypes.StoreKey, evmtypes.StorageHashKey(emptyAddress))},
+			true,
+		},
+		{
+			"fail - wrong storage hash",
+			ps.appHash,
+			&AccountResult{Address: address, StorageHash: common.HexToHash("0x1"), StorageHashProof: storageHashProof},
+			false,
+		},
+		{
+			"fail - storage reported as empty",
+			ps.appHash,
+			&AccountResult{Address: address, StorageHashProof: storageHashProof},
+			false,
+		},
+		{
+			"fail - wrong value",
+			ps.appHash,
+			&AccountResult{Address: address, StorageHash: storageHash, StorageHashProof: storageHashProof, StorageProof: []StorageResult{
+				{Key: key.Hex(), Value: (*hexutil.Big)(big.NewInt(1)), Proof: slotProof},
+			}},
+			false,
+		},
+		{
+			"fail - existing slot reported as empty",
+			ps.appHash,
+			&AccountResult{Address: address, StorageHash: storageHash, StorageHashProof: storageHashProof, StorageProof: []StorageResult{
+				{Key: key.Hex(), Value: (*hexutil.Big)(big.NewInt(0)), Proof: slotProof},
+			}},
+			false,
+		},
+		{
+			"fail - wrong app hash",
+			crypto.Keccak256([]byte("app hash")),
+			&AccountResult{Address: address, StorageHash: storageHash, StorageHashProof: storageHashProof},
+			false,
+		},
+		{
+			"fail - invalid proof",
+			ps.appHash,
+			&AccountResult{Address: address, StorageHash: storageHash, StorageHashProof: storageHashProof, StorageProof: []StorageResult{
+				{Key: key.Hex(), Value: (*hexutil.Big)(value.Big()), Proof: []string{""}},
+			}},
+			false,
+		},
+		{
+			"fail - nil account",
+			ps.appHash,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyStorageProof(tc.appHash, tc.account)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyCodeHashProof(t *testing.T) {
+	contract, eoa := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	codeHash := crypto.Keccak256Hash([]byte("code"))
+
+	ps := newProofStore(t, map[string]map[string][]byte{
+		evmtypes.StoreKey: {string(evmtypes.CodeHashKey(contract)): codeHash.Bytes()},
+	})
+
+	testCases := []struct {
+		name    string
+		account *AccountResult
+		expPass bool
+	}{
+		{
+			"pass - contract",
+			&AccountResult{Address: contract, CodeHash: codeHash, CodeHashProof: ps.proof(t, evmtypes.StoreKey, evmtypes.CodeHashKey(contract))},
+			true,
+		},
+		{
+			"pass - account without code",
+			&AccountResult{Address: eoa, CodeHash: crypto.Keccak256Hash(nil), CodeHashProof: ps.proof(t, evmtypes.StoreKey, evmtypes.CodeHashKey(eoa))},
+			true,
+		},
+		{
+			"fail - wrong code hash",
+			&AccountResult{Address: contract, CodeHash: crypto.Keccak256Hash(nil), CodeHashProof: ps.proof(t, evmtypes.StoreKey, evmtypes.CodeHashKey(contract))},
+			false,
+		},
+		{
+			"fail - nil account",
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyCodeHashProof(ps.appHash, tc.account)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyBalanceProof(t *testing.T) {
+	address, emptyAddress := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	denom := "uatom"
+
+	balanceKey, err := BalanceKey(address, denom)
+	require.NoError(t, err)
+	emptyBalanceKey, err := BalanceKey(emptyAddress, denom)
+	require.NoError(t, err)
+
+	integer, fractional := sdkmath.NewInt(42), sdkmath.NewInt(7)
+	integerBz, err := integer.Marshal()
+	require.NoError(t, err)
+	fractionalBz, err := fractional.Marshal()
+	require.NoError(t, err)
+
+	ps := newProofStore(t, map[string]map[string][]byte{
+		banktypes.StoreKey:        {string(balanceKey): integerBz},
+		precisebanktypes.StoreKey: {string(FractionalBalanceKey(address)): fractionalBz},
+	})
+
+	// 42 integer and 7 fractional units of a 6 decimals coin
+	balance := new(big.Int).Add(
+		new(big.Int).Mul(integer.BigInt(), evmtypes.SixDecimals.ConversionFactor().BigInt()),
+		fractional.BigInt(),
+	)
+
+	testCases := []struct {
+		name     string
+		account  *AccountResult
+		decimals evmtypes.Decimals
+		expPass  bool
+	}{
+		{
+			"pass - 18 decimals",
+			&AccountResult{Address: address, Balance: (*hexutil.Big)(integer.BigInt()), BalanceProof: ps.proof(t, banktypes.StoreKey, balanceKey)},
+			evmtypes.EighteenDecimals,
+			true,
+		},
+		{
+			"pass - 6 decimals",
+			&AccountResult{
+				Address:                address,
+				Balance:                (*hexutil.Big)(balance),
+				BalanceProof:           ps.proof(t, banktypes.StoreKey, balanceKey),
+				FractionalBalanceProof: ps.proof(t, precisebanktypes.StoreKey, FractionalBalanceKey(address)),
+			},
+			evmtypes.SixDecimals,
+			true,
+		},
+		{
+			"pass - empty balance",
+			&AccountResult{
+				Address:                emptyAddress,
+				Balance:                (*hexutil.Big)(big.NewInt(0)),
+				BalanceProof:           ps.proof(t, banktypes.StoreKey, emptyBalanceKey),
+				FractionalBalanceProof: ps.proof(t, precisebanktypes.StoreKey, FractionalBalanceKey(emptyAddress)),
+			},
+			evmtypes.SixDecimals,
+			true,
+		},
+		{
+			"fail - wrong balance",
+			&AccountResult{Address: address, Balance: (*hexutil.Big)(big.NewInt(41)), BalanceProof: ps.proof(t, banktypes.StoreKey, balanceKey)},
+			evmtypes.EighteenDecimals,
+			false,
+		},
+		{
+			"fail - wrong fractional balance",
+			&AccountResult{
+				Address:                address,
+				Balance:                (*hexutil.Big)(new(big.Int).Add(balance, big.NewInt(1))),
+				BalanceProof:           ps.proof(t, banktypes.StoreKey, balanceKey),
+				FractionalBalanceProof: ps.proof(t, precisebanktypes.StoreKey, FractionalBalanceKey(address)),
+			},
+			evmtypes.SixDecimals,
+			false,
+		},
+		{
+			"fail - missing fractional balance proof",
+			&AccountResult{Address: address, Balance: (*hexutil.Big)(balance), BalanceProof: ps.proof(t, banktypes.StoreKey, balanceKey)},
+			evmtypes.SixDecimals,
+			false,
+		},
+		{
+			"fail - nil account",
+			nil,
+			evmtypes.EighteenDecimals,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyBalanceProof(ps.appHash, tc.account, denom, tc.decimals)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
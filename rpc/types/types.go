@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -20,6 +22,20 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+	// StorageHashProof is the proof of the StorageHash in the EVM store, which
+	// is not part of the Ethereum proof format. The storage hash is the
+	// commitment to the account storage maintained by x/vm, not a trie root.
+	StorageHashProof []string `json:"storageHashProof,omitempty"`
+	// CodeHashProof is the proof of the code hash in the EVM store, which is not
+	// part of the Ethereum proof format.
+	CodeHashProof []string `json:"codeHashProof,omitempty"`
+	// BalanceProof is the proof of the bank balance of the EVM coin, which is
+	// not part of the Ethereum proof format.
+	BalanceProof []string `json:"balanceProof,omitempty"`
+	// FractionalBalanceProof is the proof of the fractional balance of the EVM
+	// coin in the precisebank store, only set if the EVM coin has less than 18
+	// decimals.
+	FractionalBalanceProof []string `json:"fractionalBalanceProof,omitempty"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -50,23 +66,28 @@ type RPCTransaction struct {
 	V                *hexutil.Big         `json:"v"`
 	R                *hexutil.Big         `json:"r"`
 	S                *hexutil.Big         `json:"s"`
+
+	AuthorizationList []ethtypes.SetCodeAuthorization `json:"authorizationList,omitempty"`
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts = evmtypes.SimOpts
+
+// SimBlockResult is the result of a block simulated by eth_simulateV1.
+type SimBlockResult = evmtypes.SimBlockResult
+
+// AccessListResult is the result of eth_createAccessList.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
 }
 
 type FeeHistoryResult struct {
@@ -33,6 +33,10 @@ const ExceedBlockGasLimitError = "out of gas in location: block gas meter; gasWa
 // note: the transfer amount cannot be set to 0, otherwise this problem will not be triggered
 const StateDBCommitError = "failed to commit stateDB"
 
+// BatchTxFailedError defines the error message when an evm tx of a cosmos tx made of several ones fails,
+// reverting all of them
+const BatchTxFailedError = "ethereum tx batch failed"
+
 // RawTxToEthTx returns a evm MsgEthereum transaction from raw tx bytes.
 func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEthereumTx, error) {
 	tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
@@ -212,10 +216,13 @@ func NewRPCTransaction(
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
-	case ethtypes.DynamicFeeTxType:
+	case ethtypes.DynamicFeeTxType, ethtypes.SetCodeTxType:
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
+		if tx.Type() == ethtypes.SetCodeTxType {
+			result.AuthorizationList = tx.SetCodeAuthorizations()
+		}
 		result.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
 		result.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
 		// if the transaction has been mined, compute the effective gas price
@@ -285,8 +292,13 @@ func TxStateDBCommitError(res *abci.ExecTxResult) bool {
 	return strings.Contains(res.Log, StateDBCommitError)
 }
 
+// TxBatchFailed returns true if the evm tx batch failed.
+func TxBatchFailed(res *abci.ExecTxResult) bool {
+	return strings.Contains(res.Log, BatchTxFailedError)
+}
+
 // TxSucessOrExpectedFailure returns true if the transaction was successful
-// or if it failed with an ExceedBlockGasLimit error or TxStateDBCommitError error
+// or if it failed with an ExceedBlockGasLimit, TxStateDBCommitError or TxBatchFailed error
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
-	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
+	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res) || TxBatchFailed(res)
 }
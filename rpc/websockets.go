@@ -23,6 +23,7 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/rpc/ethereum/pubsub"
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/types"
@@ -75,14 +76,20 @@ type websocketsServer struct {
 	logger   log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	evmBackend backend.EVMBackend,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:  cfg.JSONRPC.Address,
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, evmBackend),
 		logger:   logger,
 	}
 }
@@ -341,18 +348,26 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
-	events    *rpcfilters.EventSystem
-	logger    log.Logger
-	clientCtx client.Context
+	events      *rpcfilters.EventSystem
+	logger      log.Logger
+	clientCtx   client.Context
+	pendingLogs *pendingLogsFeed
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, evmBackend backend.EVMBackend) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
+	events := rpcfilters.NewEventSystem(logger, tmWSClient)
 	return &pubSubAPI{
-		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
+		events:    events,
 		logger:    logger,
 		clientCtx: clientCtx,
+		pendingLogs: &pendingLogsFeed{
+			backend: evmBackend,
+			events:  events,
+			logger:  logger,
+			subs:    make(map[chan []*ethtypes.Log]struct{}),
+		},
 	}
 }
 
@@ -495,6 +510,16 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 			}
 		}
 
+		var err error
+		if crit.FromBlock, err = parseBlockNumberParam(params["fromBlock"]); err != nil {
+			api.logger.Debug("invalid fromBlock", "type", fmt.Sprintf("%T", params["fromBlock"]))
+			return nil, err
+		}
+		if crit.ToBlock, err = parseBlockNumberParam(params["toBlock"]); err != nil {
+			api.logger.Debug("invalid toBlock", "type", fmt.Sprintf("%T", params["toBlock"]))
+			return nil, err
+		}
+
 		if params["topics"] != nil {
 			topics, ok := params["topics"].([]interface{})
 			if !ok {
@@ -556,11 +581,37 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		}
 	}
 
-	sub, unsubFn, err := api.events.SubscribeLogs(crit)
+	// the pending logs are computed from the pending block, as the mined ones
+	// are pushed by the Tendermint events
+	toPending := crit.ToBlock != nil && crit.ToBlock.Int64() == rpc.PendingBlockNumber.Int64()
+	if crit.FromBlock != nil && crit.FromBlock.Int64() == rpc.PendingBlockNumber.Int64() {
+		if !toPending {
+			return nil, errors.New("invalid from and to block combination: pending to mined")
+		}
+		return api.subscribePendingLogs(wsConn, subID, crit)
+	}
+
+	minedCrit := crit
+	if toPending {
+		minedCrit.ToBlock = nil
+	}
+	sub, unsubFn, err := api.events.SubscribeLogs(minedCrit)
 	if err != nil {
 		api.logger.Error("failed to subscribe logs", "error", err.Error())
 		return nil, err
 	}
+	if toPending {
+		unsubPending, err := api.subscribePendingLogs(wsConn, subID, crit)
+		if err != nil {
+			unsubFn()
+			return nil, err
+		}
+		unsubMined := unsubFn
+		unsubFn = func() {
+			unsubPending()
+			unsubMined()
+		}
+	}
 
 	go func() {
 		ch := sub.Event()
@@ -620,6 +671,203 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
+// parseBlockNumberParam parses an optional block number of the log
+// subscription criteria.
+func parseBlockNumberParam(param interface{}) (*big.Int, error) {
+	if param == nil {
+		return nil, nil
+	}
+	str, ok := param.(string)
+	if !ok {
+		return nil, errors.New("invalid block number; must be a string")
+	}
+	var blockNum rpc.BlockNumber
+	if err := blockNum.UnmarshalJSON([]byte(strconv.Quote(str))); err != nil {
+		return nil, errors.Wrap(err, "invalid block number")
+	}
+	return big.NewInt(blockNum.Int64()), nil
+}
+
+// pendingLogKey identifies a log of the pending block by its transaction and
+// its position within the matching logs of the transaction, as the block log
+// indexes change when the transactions of the pending block do.
+type pendingLogKey struct {
+	txHash common.Hash
+	index  int
+}
+
+// subscribePendingLogs sends the logs of the pending block matching the
+// criteria, each time it changes, that weren't sent for its previous version.
+func (api *pubSubAPI) subscribePendingLogs(wsConn *wsConn, subID rpc.ID, crit filters.FilterCriteria) (pubsub.UnsubscribeFunc, error) {
+	logsCh, unsubFn, err := api.pendingLogs.subscribe()
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		sent := make(map[pendingLogKey]struct{})
+		for logs := range logsCh {
+			logs = rpcfilters.FilterLogs(logs, nil, nil, crit.Addresses, crit.Topics)
+			polled := make(map[pendingLogKey]struct{}, len(logs))
+			txLogs := make(map[common.Hash]int)
+			for _, ethLog := range logs {
+				key := pendingLogKey{txHash: ethLog.TxHash, index: txLogs[ethLog.TxHash]}
+				txLogs[ethLog.TxHash]++
+				polled[key] = struct{}{}
+				if _, ok := sent[key]; ok {
+					continue
+				}
+
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       ethLog,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close() // #nosec G703
+						}
+					}, api.logger, "closing websocket peer sub")
+					unsubFn()
+					return
+				}
+			}
+			sent = polled
+		}
+	}()
+
+	return unsubFn, nil
+}
+
+// pendingLogsFeed computes the logs of the pending block once per new pending
+// transaction or block, and shares them with all the pending log
+// subscriptions. It only runs while there are subscriptions.
+type pendingLogsFeed struct {
+	backend backend.EVMBackend
+	events  *rpcfilters.EventSystem
+	logger  log.Logger
+
+	mu   sync.Mutex
+	subs map[chan []*ethtypes.Log]struct{}
+	stop func()
+}
+
+// subscribe returns a channel receiving the latest logs of the pending block.
+// A subscriber that lags behind only receives the latest logs.
+func (f *pendingLogsFeed) subscribe() (<-chan []*ethtypes.Log, pubsub.UnsubscribeFunc, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.subs) == 0 {
+		stop, err := f.start()
+		if err != nil {
+			return nil, nil, err
+		}
+		f.stop = stop
+	}
+
+	ch := make(chan []*ethtypes.Log, 1)
+	f.subs[ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			delete(f.subs, ch)
+			close(ch)
+			if len(f.subs) == 0 {
+				f.stop()
+				f.stop = nil
+			}
+		})
+	}, nil
+}
+
+// start runs the loop computing the pending logs on new pending transactions
+// and blocks, and returns the function stopping it.
+func (f *pendingLogsFeed) start() (func(), error) {
+	txsSub, unsubTxs, err := f.events.SubscribePendingTxs()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to pending transactions")
+	}
+	headsSub, unsubHeads, err := f.events.SubscribeNewHeads()
+	if err != nil {
+		unsubTxs()
+		return nil, errors.Wrap(err, "failed to subscribe to new blocks")
+	}
+
+	quit := make(chan struct{})
+	go func() {
+		defer unsubHeads()
+		defer unsubTxs()
+
+		// the pending block is computed once for the events received while
+		// computing the previous one
+		update := make(chan struct{}, 1)
+		update <- struct{}{}
+		go func() {
+			for {
+				select {
+				case <-quit:
+					return
+				case _, ok := <-txsSub.Event():
+					if !ok {
+						return
+					}
+				case _, ok := <-headsSub.Event():
+					if !ok {
+						return
+					}
+				}
+				select {
+				case update <- struct{}{}:
+				default:
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-quit:
+				return
+			case <-update:
+			}
+
+			logs, err := f.backend.PendingLogs()
+			if err != nil {
+				f.logger.Debug("failed to fetch pending logs", "error", err.Error())
+				continue
+			}
+			f.broadcast(logs)
+		}
+	}()
+
+	var once sync.Once
+	return func() { once.Do(func() { close(quit) }) }, nil
+}
+
+// broadcast sends the logs to the subscribers, replacing the ones they haven't
+// received yet.
+func (f *pendingLogsFeed) broadcast(logs []*ethtypes.Log) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for ch := range f.subs {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- logs
+	}
+}
+
 func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
@@ -66,6 +66,12 @@ const (
 	// DefaultEVMChainID is the default EVM Chain ID if one is not provided
 	DefaultEVMChainID = 262144
 
+	// DefaultMempoolPriceBump is the default minimum price increase, in percent, to replace a pooled eth tx
+	DefaultMempoolPriceBump = 10
+
+	// DefaultMempoolAccountQueue is the default maximum number of queued eth txs per account
+	DefaultMempoolAccountQueue = 64
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -129,6 +135,11 @@ type EVMConfig struct {
 	EnablePreimageRecording bool `mapstructure:"cache-preimage"`
 	// EVMChainID defines the EIP-155 replay-protection chain ID.
 	EVMChainID uint64 `mapstructure:"evm-chain-id"`
+	// MempoolPriceBump defines the minimum tip and fee cap increase, in percent, for an eth tx
+	// to replace a pooled one with the same sender and nonce.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolAccountQueue defines the maximum number of eth txs of an account queued ahead of its nonce.
+	MempoolAccountQueue uint64 `mapstructure:"mempool-account-queue"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -190,6 +201,8 @@ func DefaultEVMConfig() *EVMConfig {
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
+		MempoolPriceBump:        DefaultMempoolPriceBump,
+		MempoolAccountQueue:     DefaultMempoolAccountQueue,
 	}
 }
 
@@ -209,7 +222,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "dev"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -22,6 +22,14 @@ cache-preimage = {{ .EVM.EnablePreimageRecording }}
 # EVMChainID is the EIP-155 compatible replay protection chain ID. This is separate from the Cosmos chain ID.
 evm-chain-id = {{ .EVM.EVMChainID }}
 
+# MempoolPriceBump is the minimum tip and fee cap increase, in percent, for an eth tx to replace
+# a pooled one with the same sender and nonce.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolAccountQueue is the maximum number of eth txs of an account queued in the mempool ahead
+# of its nonce until the nonce gap is filled.
+mempool-account-queue = {{ .EVM.MempoolAccountQueue }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -65,6 +65,8 @@ const (
 	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
 	EVMEnablePreimageRecording = "evm.cache-preimage"
 	EVMChainID                 = "evm.evm-chain-id"
+	EVMMempoolPriceBump        = "evm.mempool-price-bump"
+	EVMMempoolAccountQueue     = "evm.mempool-account-queue"
 )
 
 // TLS flags
@@ -20,7 +20,7 @@ func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "index-eth-tx [backward|forward]",
 		Short: "Index historical eth txs",
-		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
+		Long: `Index historical eth txs and the bloom bits of their logs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
 
@@ -87,9 +87,16 @@ func NewIndexTxCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				if first == -1 {
+				firstBloom, err := idxer.FirstBloomIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if first == -1 || firstBloom == -1 {
 					// start from the latest block if indexer db is empty
 					first = blockStore.Height()
+				} else if firstBloom > first {
+					// backfill the bloom bits of the blocks indexed before them
+					first = firstBloom
 				}
 				for i := first - 1; i > 0; i-- {
 					if err := indexBlock(i); err != nil {
@@ -101,6 +108,14 @@ func NewIndexTxCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
+				latestBloom, err := idxer.LastBloomIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if latestBloom < latest {
+					// backfill the bloom bits of the blocks indexed before them
+					latest = latestBloom
+				}
 				if latest == -1 {
 					// start from genesis if empty
 					latest = 0
@@ -114,6 +129,12 @@ func NewIndexTxCmd() *cobra.Command {
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
 
+			// convert the sections completed by the backfill
+			sections, err := idxer.ProcessBloomSections()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("processed %d bloom sections\n", sections)
 			return nil
 		},
 	}
@@ -23,6 +23,7 @@ type EVMIndexerService struct {
 
 	txIdxr cosmosevmtypes.EVMTxIndexer
 	client rpcclient.Client
+	quit   chan struct{}
 }
 
 // NewEVMIndexerService returns a new service instance.
@@ -30,7 +31,7 @@ func NewEVMIndexerService(
 	txIdxr cosmosevmtypes.EVMTxIndexer,
 	client rpcclient.Client,
 ) *EVMIndexerService {
-	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client, quit: make(chan struct{})}
 	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
 	return is
 }
@@ -73,6 +74,29 @@ func (eis *EVMIndexerService) OnStart() error {
 		}
 	}()
 
+	// Convert the complete bloom sections in the background, as it rewrites
+	// all their bloom bits. The sections completed while the node was down or
+	// by a backfill are processed on start.
+	sectionSignal := make(chan struct{}, 1)
+	sectionSignal <- struct{}{}
+	go func() {
+		for {
+			select {
+			case <-eis.quit:
+				return
+			case <-sectionSignal:
+			}
+			sections, err := eis.txIdxr.ProcessBloomSections()
+			if err != nil {
+				eis.Logger.Error("failed to process bloom sections", "err", err)
+				continue
+			}
+			if sections > 0 {
+				eis.Logger.Info("processed bloom sections", "sections", sections)
+			}
+		}
+	}()
+
 	lastBlock, err := eis.txIdxr.LastIndexedBlock()
 	if err != nil {
 		return err
@@ -84,6 +108,8 @@ func (eis *EVMIndexerService) OnStart() error {
 		if latestBlock <= lastBlock {
 			// nothing to index. wait for signal of new block
 			select {
+			case <-eis.quit:
+				return nil
 			case <-newBlockSignal:
 			case <-time.After(NewBlockWaitTimeout):
 			}
@@ -103,7 +129,20 @@ func (eis *EVMIndexerService) OnStart() error {
 			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
 			}
+			if uint64(i+1)%cosmosevmtypes.BloomBitsBlocks == 0 { //#nosec G115 -- height is positive
+				// notify the last block of a section
+				select {
+				case sectionSignal <- struct{}{}:
+				default:
+				}
+			}
 			lastBlock = blockResult.Height
 		}
 	}
 }
+
+// OnStop implements service.Service by stopping the indexing loop and the
+// background processing of the bloom sections.
+func (eis *EVMIndexerService) OnStop() {
+	close(eis.quit)
+}
@@ -251,15 +251,9 @@ func (s *EvmUnitAnteTestSuite) TestCheckTxFee() {
 	} {
 		for _, tc := range testCases {
 			s.Run(fmt.Sprintf("%s, %s", chainID.ChainID, tc.name), func() {
-				// Call the configurator to set the EVM coin required for the
-				// function to be tested.
-				configurator := evmtypes.NewEVMConfigurator()
-				configurator.ResetTestConfig()
-				s.Require().NoError(configurator.WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[chainID]).Configure())
-
 				// If decimals is not 18 decimals, we have to convert txFeeInfo to original
 				// decimals representation.
-				evmExtendedDenom := evmtypes.GetEVMCoinExtendedDenom()
+				evmExtendedDenom := testconstants.ExampleChainCoinInfo[chainID].ExtendedDenom
 
 				coins := sdktypes.Coins{sdktypes.Coin{Denom: evmExtendedDenom, Amount: amount}}
 
@@ -270,7 +264,7 @@ func (s *EvmUnitAnteTestSuite) TestCheckTxFee() {
 				}
 
 				// Function under test
-				err := evm.CheckTxFee(txFeeInfo, tc.txFee, tc.txGasLimit)
+				err := evm.CheckTxFee(txFeeInfo, tc.txFee, tc.txGasLimit, evmExtendedDenom)
 
 				if tc.expError != nil {
 					s.Require().Error(err)
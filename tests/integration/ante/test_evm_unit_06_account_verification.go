@@ -5,6 +5,8 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/cosmos/evm/ante/evm"
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -53,6 +55,21 @@ func (s *EvmUnitAnteTestSuite) TestVerifyAccountBalance() {
 				return statedbAccount, txArgs
 			},
 		},
+		{
+			name:          "success: sender code is a delegation designator",
+			expectedError: nil,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+
+				code := gethtypes.AddressToDelegation(common.HexToAddress("0x1000000000000000000000000000000000000001"))
+				codeHash := crypto.Keccak256Hash(code)
+				unitNetwork.App.GetEVMKeeper().SetCode(unitNetwork.GetContext(), codeHash.Bytes(), code)
+				statedbAccount.CodeHash = codeHash.Bytes()
+				return statedbAccount, txArgs
+			},
+		},
 		{
 			name:          "fail: sender balance is lower than the transaction cost",
 			expectedError: errortypes.ErrInsufficientFunds,
@@ -118,10 +135,12 @@ func (s *EvmUnitAnteTestSuite) TestVerifyAccountBalance() {
 			//  Function to be tested
 			err = evm.VerifyAccountBalance(
 				unitNetwork.GetContext(),
+				unitNetwork.App.GetEVMKeeper(),
 				unitNetwork.App.GetAccountKeeper(),
 				statedbAccount,
 				senderKey.Addr,
 				txData,
+				false,
 			)
 
 			if tc.expectedError != nil {
@@ -0,0 +1,93 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantEvent() {
+	s.SetupTest()
+	method := s.precompile.Methods[authz.GrantMethod]
+	stateDB := s.network.GetStateDB()
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+	_, err := s.precompile.Grant(ctx, contract, stateDB, &method, []interface{}{
+		s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL, int64(0),
+	})
+	s.Require().NoError(err)
+
+	log := stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.Events[authz.EventTypeGrant]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+	var grantEvent authz.EventGrant
+	err = cmn.UnpackLog(s.precompile.ABI, &grantEvent, authz.EventTypeGrant, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), grantEvent.Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), grantEvent.Grantee)
+	s.Require().Equal(delegateMsgTypeURL, grantEvent.MsgTypeUrl)
+}
+
+func (s *PrecompileTestSuite) TestRevokeEvent() {
+	s.SetupTest()
+	method := s.precompile.Methods[authz.RevokeMethod]
+	stateDB := s.network.GetStateDB()
+	s.saveGrant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sdkauthz.NewGenericAuthorization(delegateMsgTypeURL))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+	_, err := s.precompile.Revoke(ctx, contract, stateDB, &method, []interface{}{
+		s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL,
+	})
+	s.Require().NoError(err)
+
+	log := stateDB.Logs()[0]
+	event := s.precompile.Events[authz.EventTypeRevoke]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var revokeEvent authz.EventRevoke
+	err = cmn.UnpackLog(s.precompile.ABI, &revokeEvent, authz.EventTypeRevoke, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), revokeEvent.Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), revokeEvent.Grantee)
+	s.Require().Equal(delegateMsgTypeURL, revokeEvent.MsgTypeUrl)
+}
+
+func (s *PrecompileTestSuite) TestExecEvent() {
+	s.SetupTest()
+	method := s.precompile.Methods[authz.ExecMethod]
+	stateDB := s.network.GetStateDB()
+	amount := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(500)))
+	s.saveGrant(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), banktypes.NewSendAuthorization(amount, nil))
+
+	msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), utiltx.GenerateAddress().Bytes(), amount)
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+	_, err := s.precompile.Exec(ctx, contract, stateDB, &method, []interface{}{
+		s.keyring.GetAddr(0), [][]byte{s.marshalMsg(msg)},
+	})
+	s.Require().NoError(err)
+
+	log := stateDB.Logs()[0]
+	event := s.precompile.Events[authz.EventTypeExec]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var execEvent authz.EventExec
+	err = cmn.UnpackLog(s.precompile.ABI, &execEvent, authz.EventTypeExec, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), execEvent.Grantee)
+	s.Require().Equal([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, execEvent.MsgTypeUrls)
+}
@@ -0,0 +1,185 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestGetGranterGrants() {
+	method := s.precompile.Methods[authz.GetGranterGrantsMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []authz.GrantData
+		args        func() []interface{}
+		expPass     bool
+		errContains string
+		expTotal    uint64
+	}{
+		{
+			name: "valid query - generic and send authorizations",
+			malleate: func() []authz.GrantData {
+				spendLimit := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000)))
+				s.saveGrant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sdkauthz.NewGenericAuthorization(delegateMsgTypeURL))
+				s.saveGrant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2), banktypes.NewSendAuthorization(spendLimit, []sdk.AccAddress{s.keyring.GetAccAddr(1)}))
+				// grant of another granter
+				s.saveGrant(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(2), sdkauthz.NewGenericAuthorization(delegateMsgTypeURL))
+
+				return []authz.GrantData{
+					{
+						Granter:    s.keyring.GetAddr(0),
+						Grantee:    s.keyring.GetAddr(1),
+						MsgTypeUrl: delegateMsgTypeURL,
+						SpendLimit: []cmn.Coin{},
+						AllowList:  []common.Address{},
+					},
+					{
+						Granter:    s.keyring.GetAddr(0),
+						Grantee:    s.keyring.GetAddr(2),
+						MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+						SpendLimit: []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1000)}},
+						AllowList:  []common.Address{s.keyring.GetAddr(1)},
+					},
+				}
+			},
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			expPass:  true,
+			expTotal: 2,
+		},
+		{
+			name: "valid query - no grants",
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - invalid number of args",
+			args: func() []interface{} {
+				return []interface{}{}
+			},
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name: "fail - empty granter address",
+			args: func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{Limit: 10}}
+			},
+			errContains: "invalid granter address",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			grants := []authz.GrantData{}
+			if tc.malleate != nil {
+				grants = tc.malleate()
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.GetGranterGrants(ctx, &method, contract, tc.args())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GetGranterGrantsMethod, bz)
+				s.Require().NoError(err)
+				s.Require().ElementsMatch(grants, out.Grants)
+				s.Require().Equal(tc.expTotal, out.PageResponse.Total)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetGranteeGrants() {
+	method := s.precompile.Methods[authz.GetGranteeGrantsMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []authz.GrantData
+		args        func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name: "valid query - grants of several granters",
+			malleate: func() []authz.GrantData {
+				s.saveGrant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2), sdkauthz.NewGenericAuthorization(delegateMsgTypeURL))
+				s.saveGrant(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(2), sdkauthz.NewGenericAuthorization(delegateMsgTypeURL))
+				// grant to another grantee
+				s.saveGrant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sdkauthz.NewGenericAuthorization(delegateMsgTypeURL))
+
+				return []authz.GrantData{
+					{
+						Granter:    s.keyring.GetAddr(0),
+						Grantee:    s.keyring.GetAddr(2),
+						MsgTypeUrl: delegateMsgTypeURL,
+						SpendLimit: []cmn.Coin{},
+						AllowList:  []common.Address{},
+					},
+					{
+						Granter:    s.keyring.GetAddr(1),
+						Grantee:    s.keyring.GetAddr(2),
+						MsgTypeUrl: delegateMsgTypeURL,
+						SpendLimit: []cmn.Coin{},
+						AllowList:  []common.Address{},
+					},
+				}
+			},
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{Limit: 10}}
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - empty grantee address",
+			args: func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{Limit: 10}}
+			},
+			errContains: "invalid grantee address",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			grants := []authz.GrantData{}
+			if tc.malleate != nil {
+				grants = tc.malleate()
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.GetGranteeGrants(ctx, &method, contract, tc.args())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GetGranteeGrantsMethod, bz)
+				s.Require().NoError(err)
+				s.Require().ElementsMatch(grants, out.Grants)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
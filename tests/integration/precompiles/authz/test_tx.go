@@ -0,0 +1,521 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var delegateMsgTypeURL = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{}, delegateMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL, int64(-1)}
+			},
+			func() {},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), delegateMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - grantee is the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0), delegateMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			sdkauthz.ErrGranteeIsGranter.Error(),
+		},
+		{
+			"fail - msg type re-entering the EVM",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			func() {},
+			true,
+			"msg type not allowed",
+		},
+		{
+			"fail - erc20 conversion msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}), int64(0)}
+			},
+			func() {},
+			true,
+			"msg type not allowed",
+		},
+		{
+			"fail - msg type not in the allowlist",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&govv1.MsgVote{}), int64(0)}
+			},
+			func() {},
+			true,
+			"msg type not allowed",
+		},
+		{
+			"fail - unknown msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "/cosmos.unknown.v1.MsgUnknown", int64(0)}
+			},
+			func() {},
+			true,
+			"msg type not allowed",
+		},
+		{
+			"success - generic authorization granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL, int64(0)}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(1),
+					s.keyring.GetAccAddr(0),
+					delegateMsgTypeURL,
+				)
+				s.Require().NotNil(authorization)
+				s.Require().IsType(&sdkauthz.GenericAuthorization{}, authorization)
+				s.Require().Nil(expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - generic authorization granted with an expiration",
+			func() []interface{} {
+				expiration := s.network.GetContext().BlockTime().Add(time.Hour).Unix()
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL, expiration}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(1),
+					s.keyring.GetAccAddr(0),
+					delegateMsgTypeURL,
+				)
+				s.Require().NotNil(authorization)
+				s.Require().NotNil(expiration)
+				s.Require().Equal(s.network.GetContext().BlockTime().Add(time.Hour).Unix(), expiration.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.Grant(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSend() {
+	method := s.precompile.Methods[authz.GrantSendMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - empty spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, []common.Address{}, int64(0)}
+			},
+			func() {},
+			true,
+			"spend limit",
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					s.keyring.GetAddr(2),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1000)}},
+					[]common.Address{},
+					int64(0),
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - send authorization granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1000)}},
+					[]common.Address{s.keyring.GetAddr(2)},
+					int64(0),
+				}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(1),
+					s.keyring.GetAccAddr(0),
+					sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				)
+				s.Require().NotNil(authorization)
+				sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000))), sendAuthz.SpendLimit)
+				s.Require().Equal([]string{s.keyring.GetAccAddr(2).String()}, sendAuthz.AllowList)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.GrantSend(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), delegateMsgTypeURL}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - authorization not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - authorization revoked",
+			func() []interface{} {
+				s.saveGrant(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sdkauthz.NewGenericAuthorization(delegateMsgTypeURL))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(1),
+					s.keyring.GetAccAddr(0),
+					delegateMsgTypeURL,
+				)
+				s.Require().Nil(authorization)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	method := s.precompile.Methods[authz.ExecMethod]
+	receiver := utiltx.GenerateAddress()
+	amount := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(500)))
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - no msgs",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{}}
+			},
+			func() {},
+			true,
+			"invalid msgs",
+		},
+		{
+			"fail - invalid msg json",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{[]byte("{}")}}
+			},
+			func() {},
+			true,
+			"invalid msgs",
+		},
+		{
+			"fail - msg.sender address does not match the grantee address",
+			func() []interface{} {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), receiver.Bytes(), amount)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{s.marshalMsg(msg)}}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no authorization",
+			func() []interface{} {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), receiver.Bytes(), amount)
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.marshalMsg(msg)}}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"fail - msg type not in the allowlist",
+			func() []interface{} {
+				msg := &erc20types.MsgConvertCoin{
+					Coin:     sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1)),
+					Receiver: receiver.Hex(),
+					Sender:   s.keyring.GetAccAddr(1).String(),
+				}
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.marshalMsg(msg)}}
+			},
+			func() {},
+			true,
+			"msg type not allowed",
+		},
+		{
+			"fail - nested grant",
+			func() []interface{} {
+				msg, err := sdkauthz.NewMsgGrant(
+					s.keyring.GetAccAddr(0),
+					s.keyring.GetAccAddr(1),
+					sdkauthz.NewGenericAuthorization(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+					nil,
+				)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.marshalMsg(msg)}}
+			},
+			func() {},
+			true,
+			"msg type not allowed",
+		},
+		{
+			"fail - spend limit exceeded",
+			func() []interface{} {
+				spendLimit := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(100)))
+				s.saveGrant(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), banktypes.NewSendAuthorization(spendLimit, nil))
+
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), receiver.Bytes(), amount)
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.marshalMsg(msg)}}
+			},
+			func() {},
+			true,
+			"insufficient funds",
+		},
+		{
+			"success - msg executed on behalf of the granter",
+			func() []interface{} {
+				spendLimit := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000)))
+				s.saveGrant(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), banktypes.NewSendAuthorization(spendLimit, nil))
+
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), receiver.Bytes(), amount)
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.marshalMsg(msg)}}
+			},
+			func() {
+				balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), receiver.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(amount[0], balance)
+
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(0),
+					s.keyring.GetAccAddr(1),
+					sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				)
+				sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(math.NewInt(500), sendAuthz.SpendLimit.AmountOf(s.network.GetBaseDenom()))
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.Exec(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				results, ok := out[0].([][]byte)
+				s.Require().True(ok)
+				s.Require().Len(results, 1)
+				tc.postCheck()
+			}
+		})
+	}
+}
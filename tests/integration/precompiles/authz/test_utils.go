@@ -0,0 +1,19 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// saveGrant stores an authorization without expiration given by the granter to the grantee.
+func (s *PrecompileTestSuite) saveGrant(granter, grantee sdk.AccAddress, authorization sdkauthz.Authorization) {
+	err := s.network.App.GetAuthzKeeper().SaveGrant(s.network.GetContext(), grantee, granter, authorization, nil)
+	s.Require().NoError(err)
+}
+
+// marshalMsg returns the JSON encoding of the msg expected by the exec method.
+func (s *PrecompileTestSuite) marshalMsg(msg sdk.Msg) []byte {
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	s.Require().NoError(err)
+	return bz
+}
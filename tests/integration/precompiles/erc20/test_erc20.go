@@ -20,6 +20,12 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 	s.Require().False(s.precompile.IsTransaction(&method))
 	method = s.precompile.Methods[erc20.TotalSupplyMethod]
 	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.NoncesMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.DomainSeparatorMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.AuthorizationStateMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
 
 	// Transactions
 	method = s.precompile.Methods[erc20.ApproveMethod]
@@ -32,6 +38,10 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 	s.Require().True(s.precompile.IsTransaction(&method))
 	method = s.precompile.Methods[erc20.TransferFromMethod]
 	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.PermitMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.TransferWithAuthorizationMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
 }
 
 func (s *PrecompileTestSuite) TestRequiredGas() {
@@ -0,0 +1,389 @@
+package erc20
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var eip712DomainTypes = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+}
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile2.Methods[erc20.PermitMethod]
+	value := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func(deadline *big.Int) []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func(*big.Int) []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - expired deadline",
+			malleate: func(deadline *big.Int) []interface{} {
+				deadline = new(big.Int).Sub(deadline, big.NewInt(2*3600))
+				return s.permitArgs(s.keyring.GetPrivKey(0), s.keyring.GetAddr(1), value, 0, deadline)
+			},
+			errContains: erc20.ErrPermitExpired.Error(),
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func(deadline *big.Int) []interface{} {
+				args := s.permitArgs(s.keyring.GetPrivKey(1), s.keyring.GetAddr(1), value, 0, deadline)
+				args[0] = s.keyring.GetAddr(0)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - signed with a wrong nonce",
+			malleate: func(deadline *big.Int) []interface{} {
+				return s.permitArgs(s.keyring.GetPrivKey(0), s.keyring.GetAddr(1), value, 1, deadline)
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - invalid signature values",
+			malleate: func(deadline *big.Int) []interface{} {
+				args := s.permitArgs(s.keyring.GetPrivKey(0), s.keyring.GetAddr(1), value, 0, deadline)
+				args[4] = uint8(0)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - replayed permit",
+			malleate: func(deadline *big.Int) []interface{} {
+				s.network.App.GetErc20Keeper().SetPermitNonce(s.network.GetContext(), s.precompile2.Address(), s.keyring.GetAddr(0), 1)
+				return s.permitArgs(s.keyring.GetPrivKey(0), s.keyring.GetAddr(1), value, 0, deadline)
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "pass - allowance set and nonce increased",
+			malleate: func(deadline *big.Int) []interface{} {
+				return s.permitArgs(s.keyring.GetPrivKey(0), s.keyring.GetAddr(1), value, 0, deadline)
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(s.precompile2.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), value)
+				nonce := s.network.App.GetErc20Keeper().GetPermitNonce(s.network.GetContext(), s.precompile2.Address(), s.keyring.GetAddr(0))
+				s.Require().Equal(uint64(1), nonce)
+
+				// the nonce of the other token is not increased
+				nonce = s.network.App.GetErc20Keeper().GetPermitNonce(s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0))
+				s.Require().Zero(nonce)
+			},
+		},
+		{
+			name: "pass - allowance removed with a zero value",
+			malleate: func(deadline *big.Int) []interface{} {
+				s.setAllowance(s.precompile2.Address(), s.keyring.GetPrivKey(0), s.keyring.GetAddr(1), value)
+				return s.permitArgs(s.keyring.GetPrivKey(0), s.keyring.GetAddr(1), common.Big0, 0, deadline)
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(s.precompile2.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big0)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// the permit is submitted by an account other than the owner
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(1),
+				s.precompile2.Address(),
+				200_000,
+			)
+
+			deadline := big.NewInt(ctx.BlockTime().Add(time.Hour).Unix())
+			stateDB := s.network.GetStateDB()
+			_, err := s.precompile2.Permit(ctx, contract, stateDB, &method, tc.malleate(deadline))
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+
+				// check the Approval event
+				s.Require().Len(stateDB.Logs(), 1)
+				event := s.precompile2.Events[erc20.EventTypeApproval]
+				s.Require().Equal(event.ID, stateDB.Logs()[0].Topics[0])
+			} else {
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNonces() {
+	s.SetupTest()
+	method := s.precompile2.Methods[erc20.NoncesMethod]
+	s.network.App.GetErc20Keeper().SetPermitNonce(s.network.GetContext(), s.precompile2.Address(), s.keyring.GetAddr(0), 3)
+
+	bz, err := s.precompile2.Nonces(s.network.GetContext(), nil, nil, &method, []interface{}{s.keyring.GetAddr(0)})
+	s.requireOut(bz, err, method, true, "", big.NewInt(3))
+
+	bz, err = s.precompile2.Nonces(s.network.GetContext(), nil, nil, &method, []interface{}{s.keyring.GetAddr(1)})
+	s.requireOut(bz, err, method, true, "", common.Big0)
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	s.SetupTest()
+	method := s.precompile2.Methods[erc20.DomainSeparatorMethod]
+
+	bz, err := s.precompile2.DomainSeparator(s.network.GetContext(), nil, nil, &method, nil)
+	s.Require().NoError(err)
+
+	typedData := s.typedData(s.precompile2, "Permit", nil, nil)
+	expected, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	s.Require().NoError(err)
+	s.requireOut(bz, err, method, true, "", [32]byte(common.BytesToHash(expected)))
+
+	// the domain of a token without a name can't be built
+	method = s.precompile.Methods[erc20.DomainSeparatorMethod]
+	_, err = s.precompile.DomainSeparator(s.network.GetContext(), nil, nil, &method, nil)
+	s.Require().ErrorContains(err, vm.ErrExecutionReverted.Error())
+}
+
+func (s *PrecompileTestSuite) TestTransferWithAuthorization() {
+	method := s.precompile2.Methods[erc20.TransferWithAuthorizationMethod]
+	value := big.NewInt(100)
+	nonce := common.BytesToHash(crypto.Keccak256([]byte("nonce")))
+	receiver := GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func(now int64) []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func(int64) []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - authorization not yet valid",
+			malleate: func(now int64) []interface{} {
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), receiver, value, now, now+3600, nonce)
+			},
+			errContains: erc20.ErrAuthorizationNotYetValid.Error(),
+		},
+		{
+			name: "fail - authorization expired",
+			malleate: func(now int64) []interface{} {
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), receiver, value, 0, now, nonce)
+			},
+			errContains: erc20.ErrAuthorizationExpired.Error(),
+		},
+		{
+			name: "fail - authorization already used",
+			malleate: func(now int64) []interface{} {
+				s.network.App.GetErc20Keeper().SetAuthorizationUsed(s.network.GetContext(), s.precompile2.Address(), s.keyring.GetAddr(0), nonce)
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), receiver, value, 0, now+3600, nonce)
+			},
+			errContains: erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func(now int64) []interface{} {
+				args := s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(1), receiver, value, 0, now+3600, nonce)
+				args[0] = s.keyring.GetAddr(0)
+				return args
+			},
+			errContains: erc20.ErrAuthorizationInvalidSignature.Error(),
+		},
+		{
+			name: "fail - transfer amount exceeds balance",
+			malleate: func(now int64) []interface{} {
+				_, privKey := NewAddrKey()
+				return s.transferWithAuthorizationArgs(privKey, receiver, value, 0, now+3600, nonce)
+			},
+			errContains: erc20.ErrTransferAmountExceedsBalance.Error(),
+		},
+		{
+			name: "pass - transfer executed",
+			malleate: func(now int64) []interface{} {
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), receiver, value, 0, now+3600, nonce)
+			},
+			expPass: true,
+			postCheck: func() {
+				balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), receiver.Bytes(), s.bondDenom)
+				s.Require().Equal(value, balance.Amount.BigInt())
+				s.Require().True(s.network.App.GetErc20Keeper().IsAuthorizationUsed(s.network.GetContext(), s.precompile2.Address(), s.keyring.GetAddr(0), nonce))
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// the authorization is submitted by an account other than the sender
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(1),
+				s.precompile2.Address(),
+				200_000,
+			)
+
+			stateDB := s.network.GetStateDB()
+			_, err := s.precompile2.TransferWithAuthorization(ctx, contract, stateDB, &method, tc.malleate(ctx.BlockTime().Unix()))
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+
+				// check the AuthorizationUsed and Transfer events
+				s.Require().Len(stateDB.Logs(), 2)
+				s.Require().Equal(s.precompile2.Events[erc20.EventTypeAuthorizationUsed].ID, stateDB.Logs()[0].Topics[0])
+				s.Require().Equal(nonce, stateDB.Logs()[0].Topics[2])
+				s.Require().Equal(s.precompile2.Events[erc20.EventTypeTransfer].ID, stateDB.Logs()[1].Topics[0])
+			} else {
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAuthorizationState() {
+	s.SetupTest()
+	method := s.precompile2.Methods[erc20.AuthorizationStateMethod]
+	nonce := common.BytesToHash(crypto.Keccak256([]byte("nonce")))
+
+	bz, err := s.precompile2.AuthorizationState(s.network.GetContext(), nil, nil, &method, []interface{}{s.keyring.GetAddr(0), [32]byte(nonce)})
+	s.requireOut(bz, err, method, true, "", false)
+
+	s.network.App.GetErc20Keeper().SetAuthorizationUsed(s.network.GetContext(), s.precompile2.Address(), s.keyring.GetAddr(0), nonce)
+
+	bz, err = s.precompile2.AuthorizationState(s.network.GetContext(), nil, nil, &method, []interface{}{s.keyring.GetAddr(0), [32]byte(nonce)})
+	s.requireOut(bz, err, method, true, "", true)
+
+	// the authorization is only used on the token it was signed for
+	method = s.precompile.Methods[erc20.AuthorizationStateMethod]
+	bz, err = s.precompile.AuthorizationState(s.network.GetContext(), nil, nil, &method, []interface{}{s.keyring.GetAddr(0), [32]byte(nonce)})
+	s.requireOut(bz, err, method, true, "", false)
+}
+
+// typedData returns the EIP-712 typed data of the given message on the precompile's token.
+func (s *PrecompileTestSuite) typedData(
+	precompile *erc20.Precompile, primaryType string, types []apitypes.Type, message apitypes.TypedDataMessage,
+) apitypes.TypedData {
+	metadata, found := s.network.App.GetBankKeeper().GetDenomMetaData(s.network.GetContext(), s.bondDenom)
+	s.Require().True(found, "expected bond denom metadata")
+
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainTypes,
+			primaryType:    types,
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              metadata.Name,
+			Version:           "1",
+			ChainId:           (*math.HexOrDecimal256)(evmtypes.GetEthChainConfig().ChainID),
+			VerifyingContract: precompile.Address().Hex(),
+		},
+		Message: message,
+	}
+}
+
+// signTypedData signs the EIP-712 typed data with the private key and returns
+// the v, r and s values of the signature.
+func (s *PrecompileTestSuite) signTypedData(privKey cryptotypes.PrivKey, typedData apitypes.TypedData) (uint8, [32]byte, [32]byte) {
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err, "failed to hash typed data")
+
+	key, err := privKey.(*ethsecp256k1.PrivKey).ToECDSA()
+	s.Require().NoError(err)
+	sig, err := crypto.Sign(digest, key)
+	s.Require().NoError(err, "failed to sign typed data")
+
+	return sig[64] + 27, [32]byte(sig[:32]), [32]byte(sig[32:64])
+}
+
+// permitArgs returns the arguments of a permit on the precompile2 token signed by the owner.
+func (s *PrecompileTestSuite) permitArgs(
+	ownerPriv cryptotypes.PrivKey, spender common.Address, value *big.Int, nonce int64, deadline *big.Int,
+) []interface{} {
+	owner := common.BytesToAddress(ownerPriv.PubKey().Address().Bytes())
+	typedData := s.typedData(s.precompile2, "Permit", []apitypes.Type{
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	}, apitypes.TypedDataMessage{
+		"owner":    owner.Hex(),
+		"spender":  spender.Hex(),
+		"value":    value.String(),
+		"nonce":    big.NewInt(nonce).String(),
+		"deadline": deadline.String(),
+	})
+
+	v, r, sig := s.signTypedData(ownerPriv, typedData)
+	return []interface{}{owner, spender, value, deadline, v, r, sig}
+}
+
+// transferWithAuthorizationArgs returns the arguments of a transfer authorization
+// on the precompile2 token signed by the sender.
+func (s *PrecompileTestSuite) transferWithAuthorizationArgs(
+	fromPriv cryptotypes.PrivKey, to common.Address, value *big.Int, validAfter, validBefore int64, nonce common.Hash,
+) []interface{} {
+	from := common.BytesToAddress(fromPriv.PubKey().Address().Bytes())
+	typedData := s.typedData(s.precompile2, "TransferWithAuthorization", []apitypes.Type{
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	}, apitypes.TypedDataMessage{
+		"from":        from.Hex(),
+		"to":          to.Hex(),
+		"value":       value.String(),
+		"validAfter":  big.NewInt(validAfter).String(),
+		"validBefore": big.NewInt(validBefore).String(),
+		"nonce":       hexutil.Encode(nonce.Bytes()),
+	})
+
+	v, r, sig := s.signTypedData(fromPriv, typedData)
+	return []interface{}{from, to, value, big.NewInt(validAfter), big.NewInt(validBefore), [32]byte(nonce), v, r, sig}
+}
@@ -0,0 +1,61 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowanceEvent() {
+	s.SetupTest()
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	stateDB := s.network.GetStateDB()
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+	_, err := s.precompile.GrantAllowance(ctx, contract, stateDB, &method, []interface{}{
+		s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), int64(0), []cmn.Coin{},
+	})
+	s.Require().NoError(err)
+
+	log := stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.Events[feegrant.EventTypeGrantAllowance]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+	var grantEvent feegrant.EventGrantAllowance
+	err = cmn.UnpackLog(s.precompile.ABI, &grantEvent, feegrant.EventTypeGrantAllowance, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), grantEvent.Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), grantEvent.Grantee)
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowanceEvent() {
+	s.SetupTest()
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	stateDB := s.network.GetStateDB()
+	s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), &sdkfeegrant.BasicAllowance{})
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+	_, err := s.precompile.RevokeAllowance(ctx, contract, stateDB, &method, []interface{}{
+		s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+	})
+	s.Require().NoError(err)
+
+	log := stateDB.Logs()[0]
+	event := s.precompile.Events[feegrant.EventTypeRevokeAllowance]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var revokeEvent feegrant.EventRevokeAllowance
+	err = cmn.UnpackLog(s.precompile.ABI, &revokeEvent, feegrant.EventTypeRevokeAllowance, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), revokeEvent.Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), revokeEvent.Grantee)
+}
@@ -0,0 +1,208 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() feegrant.FeeAllowance
+		args        func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name: "valid query - basic allowance",
+			malleate: func() feegrant.FeeAllowance {
+				expiration := s.network.GetContext().BlockTime().Add(time.Hour).Truncate(time.Second)
+				s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), &sdkfeegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000))),
+					Expiration: &expiration,
+				})
+
+				return feegrant.FeeAllowance{
+					Granter:          s.keyring.GetAddr(0),
+					Grantee:          s.keyring.GetAddr(1),
+					SpendLimit:       []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1000)}},
+					Expiration:       expiration.Unix(),
+					PeriodSpendLimit: []cmn.Coin{},
+					PeriodCanSpend:   []cmn.Coin{},
+				}
+			},
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			expPass: true,
+		},
+		{
+			name: "valid query - periodic allowance restricted to some msgs",
+			malleate: func() feegrant.FeeAllowance {
+				periodReset := s.network.GetContext().BlockTime().Add(time.Hour).Truncate(time.Second)
+				periodSpendLimit := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(100)))
+				allowance, err := sdkfeegrant.NewAllowedMsgAllowance(&sdkfeegrant.PeriodicAllowance{
+					Period:           time.Hour,
+					PeriodSpendLimit: periodSpendLimit,
+					PeriodCanSpend:   periodSpendLimit,
+					PeriodReset:      periodReset,
+				}, []string{"/cosmos.bank.v1beta1.MsgSend"})
+				s.Require().NoError(err)
+				s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), allowance)
+
+				return feegrant.FeeAllowance{
+					Granter:          s.keyring.GetAddr(0),
+					Grantee:          s.keyring.GetAddr(1),
+					SpendLimit:       []cmn.Coin{},
+					Period:           3600,
+					PeriodSpendLimit: []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+					PeriodCanSpend:   []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+					PeriodReset:      periodReset.Unix(),
+				}
+			},
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - allowance not found",
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			errContains: "fee-grant not found",
+		},
+		{
+			name: "fail - invalid number of args",
+			args: func() []interface{} {
+				return []interface{}{}
+			},
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name: "fail - empty granter address",
+			args: func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1)}
+			},
+			errContains: "invalid granter address",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var allowance feegrant.FeeAllowance
+			if tc.malleate != nil {
+				allowance = tc.malleate()
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Allowance(ctx, &method, contract, tc.args())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var out feegrant.AllowanceOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(allowance, out.Allowance)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []feegrant.FeeAllowance
+		args        func() []interface{}
+		expPass     bool
+		errContains string
+		expTotal    uint64
+	}{
+		{
+			name: "valid query - allowances of several granters",
+			malleate: func() []feegrant.FeeAllowance {
+				s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2), &sdkfeegrant.BasicAllowance{})
+				s.grantAllowance(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(2), &sdkfeegrant.BasicAllowance{})
+				// allowance to another grantee
+				s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), &sdkfeegrant.BasicAllowance{})
+
+				allowances := make([]feegrant.FeeAllowance, 2)
+				for i := range allowances {
+					allowances[i] = feegrant.FeeAllowance{
+						Granter:          s.keyring.GetAddr(i),
+						Grantee:          s.keyring.GetAddr(2),
+						SpendLimit:       []cmn.Coin{},
+						PeriodSpendLimit: []cmn.Coin{},
+						PeriodCanSpend:   []cmn.Coin{},
+					}
+				}
+				return allowances
+			},
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			expPass:  true,
+			expTotal: 2,
+		},
+		{
+			name: "valid query - no allowances",
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - empty grantee address",
+			args: func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{Limit: 10}}
+			},
+			errContains: "invalid grantee address",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			allowances := []feegrant.FeeAllowance{}
+			if tc.malleate != nil {
+				allowances = tc.malleate()
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Allowances(ctx, &method, contract, tc.args())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var out feegrant.AllowancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+				s.Require().NoError(err)
+				s.Require().ElementsMatch(allowances, out.Allowances)
+				s.Require().Equal(tc.expTotal, out.PageResponse.Total)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	noCoins := []cmn.Coin{}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{}, noCoins, int64(0), int64(0), noCoins}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - negative period",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), noCoins, int64(0), int64(-1), noCoins}
+			},
+			func() {},
+			true,
+			"invalid period",
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), noCoins, int64(0), int64(0), noCoins}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - self grant",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0), noCoins, int64(0), int64(0), noCoins}
+			},
+			func() {},
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"fail - periodic allowance without period spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), noCoins, int64(0), int64(3600), noCoins}
+			},
+			func() {},
+			true,
+			"spend limit must be positive",
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), &sdkfeegrant.BasicAllowance{})
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), noCoins, int64(0), int64(0), noCoins}
+			},
+			func() {},
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"success - basic allowance granted",
+			func() []interface{} {
+				spendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1000)}}
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(0), noCoins}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000))), basic.SpendLimit)
+				s.Require().Nil(basic.Expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - periodic allowance granted to a new account",
+			func() []interface{} {
+				expiration := s.network.GetContext().BlockTime().Add(24 * time.Hour).Unix()
+				periodSpendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}}
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(2), noCoins, expiration, int64(3600), periodSpendLimit}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2))
+				s.Require().NoError(err)
+				periodic, ok := allowance.(*sdkfeegrant.PeriodicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(time.Hour, periodic.Period)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(100))), periodic.PeriodSpendLimit)
+				s.Require().Equal(periodic.PeriodSpendLimit, periodic.PeriodCanSpend)
+				s.Require().Nil(periodic.Basic.SpendLimit)
+				s.Require().NotNil(periodic.Basic.Expiration)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.GrantAllowance(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantAllowanceNewAccount() {
+	s.SetupTest()
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	grantee := utiltx.GenerateAddress()
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	_, err := s.precompile.GrantAllowance(ctx, contract, s.network.GetStateDB(), &method, []interface{}{
+		s.keyring.GetAddr(0), grantee, []cmn.Coin{}, int64(0), int64(0), []cmn.Coin{},
+	})
+	s.Require().NoError(err)
+
+	// the account of the grantee is created to let it sign transactions
+	s.Require().NotNil(s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), grantee.Bytes()))
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - allowance revoked",
+			func() []interface{} {
+				s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), &sdkfeegrant.BasicAllowance{})
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {
+				_, err := s.network.App.GetFeeGrantKeeper().GetAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().ErrorContains(err, "fee-grant not found")
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package feegrant
+
+import (
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// grantAllowance stores an allowance given by the granter to the grantee.
+func (s *PrecompileTestSuite) grantAllowance(granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) {
+	err := s.network.App.GetFeeGrantKeeper().GrantAllowance(s.network.GetContext(), granter, grantee, allowance)
+	s.Require().NoError(err)
+}
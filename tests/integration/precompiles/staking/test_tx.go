@@ -1322,3 +1322,87 @@ func (s *PrecompileTestSuite) TestCancelUnbondingDelegation() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSetUnbondingCallback() {
+	method := s.precompile.Methods[staking.SetUnbondingCallbackMethod]
+	queryMethod := s.precompile.Methods[staking.HasUnbondingCallbackMethod]
+
+	testCases := []struct {
+		name        string
+		registered  bool
+		args        []interface{}
+		expError    bool
+		errContains string
+		expEnabled  bool
+	}{
+		{
+			"fail - empty input args",
+			false,
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+			false,
+		},
+		{
+			"fail - invalid enabled flag",
+			false,
+			[]interface{}{"true"},
+			true,
+			"invalid type for enabled",
+			false,
+		},
+		{
+			"success - enable the callback",
+			false,
+			[]interface{}{true},
+			false,
+			"",
+			true,
+		},
+		{
+			"success - disable the callback",
+			true,
+			[]interface{}{false},
+			false,
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			delegator := s.keyring.GetKey(0)
+			if tc.registered {
+				s.network.App.GetEVMKeeper().SetUnbondingCallback(ctx, delegator.Addr, true)
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.SetUnbondingCallback(ctx, contract, stDB, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+			s.Require().NoError(err)
+
+			success, err := s.precompile.Unpack(staking.SetUnbondingCallbackMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			logs := stDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.Events[staking.EventTypeSetUnbondingCallback].ID, logs[0].Topics[0])
+
+			bz, err = s.precompile.HasUnbondingCallback(ctx, &queryMethod, contract, []interface{}{delegator.Addr})
+			s.Require().NoError(err)
+			registered, err := s.precompile.Unpack(staking.HasUnbondingCallbackMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expEnabled, registered[0])
+		})
+	}
+}
@@ -149,6 +149,29 @@ func (s *TestSuite) TestGetProof() {
 					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					evmtypes.CodeHashKey(address1),
+					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					evmtypes.StorageHashKey(address1),
+					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
+				balanceKey, err := rpctypes.BalanceKey(address1, evmtypes.GetEVMCoinDenom())
+				s.Require().NoError(err)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/bank/key",
+					balanceKey,
+					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpctypes.AccountResult{
@@ -157,7 +180,7 @@ func (s *TestSuite) TestGetProof() {
 				Balance:      (*hexutil.Big)(big.NewInt(0)),
 				CodeHash:     common.HexToHash(""),
 				Nonce:        0x0,
-				StorageHash:  common.Hash{},
+				StorageHash:  common.BytesToHash([]byte{2}),
 				StorageProof: []rpctypes.StorageResult{
 					{
 						Key:   "0x0",
@@ -165,6 +188,9 @@ func (s *TestSuite) TestGetProof() {
 						Proof: []string{""},
 					},
 				},
+				StorageHashProof: []string{""},
+				CodeHashProof:    []string{""},
+				BalanceProof:     []string{""},
 			},
 		},
 	}
@@ -97,6 +97,7 @@ func (s *TestSuite) SetupTest() {
 	s.backend.Cfg.JSONRPC.AllowInsecureUnlock = true
 	s.backend.Cfg.EVM.EVMChainID = ChainID.EVMChainID
 	s.backend.QueryClient.QueryClient = mocks.NewEVMQueryClient(s.T())
+	RegisterConfig(s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient))
 	s.backend.QueryClient.FeeMarket = mocks.NewFeeMarketQueryClient(s.T())
 	s.backend.Ctx = rpctypes.ContextWithHeight(1)
 
@@ -212,8 +213,7 @@ func (s *TestSuite) signAndEncodeEthTx(msgEthereumTx *evmtypes.MsgEthereumTx) []
 	err := msgEthereumTx.Sign(ethSigner, signer)
 	s.Require().NoError(err)
 
-	evmDenom := evmtypes.GetEVMCoinDenom()
-	tx, err := msgEthereumTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), evmDenom)
+	tx, err := msgEthereumTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinInfo())
 	s.Require().NoError(err)
 
 	txEncoder := s.backend.ClientCtx.TxConfig.TxEncoder()
@@ -300,7 +300,7 @@ func (s *TestSuite) TestSendRawTransaction() {
 	s.Require().NoError(err)
 
 	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
-	evmDenom := evmtypes.GetEVMCoinDenom()
+	coinInfo := evmtypes.GetEVMCoinInfo()
 
 	testCases := []struct {
 		name         string
@@ -361,7 +361,7 @@ func (s *TestSuite) TestSendRawTransaction() {
 		{
 			"fail - failed to broadcast transaction",
 			func() {
-				cosmosTx, _ := ethTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), evmDenom)
+				cosmosTx, _ := ethTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), coinInfo)
 				txBytes, _ := s.backend.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
 
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
@@ -379,7 +379,7 @@ func (s *TestSuite) TestSendRawTransaction() {
 		{
 			"pass - Gets the correct transaction hash of the eth transaction",
 			func() {
-				cosmosTx, _ := ethTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), evmDenom)
+				cosmosTx, _ := ethTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), coinInfo)
 				txBytes, _ := s.backend.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
 
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
@@ -467,6 +467,44 @@ func (s *TestSuite) TestDoCall() {
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
+		{
+			"fail - pending block query error",
+			func() {
+				var header metadata.MD
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(QueryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterPendingBlockError(QueryClient, &evmtypes.QueryPendingBlockRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64()})
+			},
+			rpctypes.EthPendingBlockNumber,
+			callArgs,
+			&evmtypes.MsgEthereumTxResponse{},
+			false,
+		},
+		{
+			"pass - call on top of the pending block",
+			func() {
+				var header metadata.MD
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(QueryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterPendingBlock(
+					QueryClient,
+					&evmtypes.QueryPendingBlockRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64()},
+					&evmtypes.QueryPendingBlockResponse{Call: &evmtypes.MsgEthereumTxResponse{Ret: []byte{0x1}}},
+				)
+			},
+			rpctypes.EthPendingBlockNumber,
+			callArgs,
+			&evmtypes.MsgEthereumTxResponse{Ret: []byte{0x1}},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -474,7 +512,7 @@ func (s *TestSuite) TestDoCall() {
 			s.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum, nil)
 
 			if tc.expPass {
 				s.Require().Equal(tc.expEthTx, msgEthTx)
@@ -485,6 +523,160 @@ func (s *TestSuite) TestDoCall() {
 	}
 }
 
+func (s *TestSuite) TestCreateAccessList() {
+	_, bz := s.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	slot := common.HexToHash("0x01")
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	s.Require().NoError(err)
+	accessList := ethtypes.AccessList{{Address: toAddr, StorageKeys: []common.Hash{slot}}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - invalid request",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterCreateAccessListError(QueryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64()})
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returned access list",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterCreateAccessList(
+					QueryClient,
+					&evmtypes.EthCallRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64()},
+					&evmtypes.CreateAccessListResponse{AccessList: evmtypes.NewAccessList(&accessList), GasUsed: 23000, VmError: "execution reverted"},
+				)
+			},
+			&rpctypes.AccessListResult{AccessList: &accessList, GasUsed: 23000, Error: "execution reverted"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.CreateAccessList(callArgs, rpctypes.BlockNumber(1), nil)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestSimulateV1() {
+	_, bz := s.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	opts := rpctypes.SimOpts{
+		BlockStateCalls: []evmtypes.SimBlock{
+			{Calls: []evmtypes.TransactionArgs{{To: &toAddr}}},
+		},
+	}
+	optsBz, err := json.Marshal(&opts)
+	s.Require().NoError(err)
+
+	expResults := []rpctypes.SimBlockResult{
+		{
+			Number:       2,
+			Transactions: []common.Hash{},
+			Calls:        []evmtypes.SimCallResult{{ReturnData: hexutil.Bytes{}, Status: 1, Logs: []*ethtypes.Log{}}},
+		},
+	}
+	resultBz, err := json.Marshal(expResults)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		opts         rpctypes.SimOpts
+		expResults   []rpctypes.SimBlockResult
+		expPass      bool
+	}{
+		{
+			"fail - empty input",
+			func() {},
+			rpctypes.SimOpts{},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid request",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				block, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterSimulateV1Error(QueryClient, &evmtypes.SimulateV1Request{
+					Opts:        optsBz,
+					ChainId:     s.backend.EvmChainID.Int64(),
+					BlockNumber: 1,
+					BlockHash:   common.Bytes2Hex(block.BlockID.Hash),
+					BlockTime:   block.Block.Time,
+				})
+			},
+			opts,
+			nil,
+			false,
+		},
+		{
+			"pass - returned simulated blocks",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				block, err := RegisterBlock(client, 1, bz)
+				s.Require().NoError(err)
+				RegisterSimulateV1(QueryClient, &evmtypes.SimulateV1Request{
+					Opts:        optsBz,
+					ChainId:     s.backend.EvmChainID.Int64(),
+					BlockNumber: 1,
+					BlockHash:   common.Bytes2Hex(block.BlockID.Hash),
+					BlockTime:   block.Block.Time,
+				}, resultBz)
+			},
+			opts,
+			expResults,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			results, err := s.backend.SimulateV1(tc.opts, rpctypes.BlockNumber(1))
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResults, results)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -21,6 +21,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (s *TestSuite) TestBaseFee() {
@@ -191,6 +192,62 @@ func (s *TestSuite) TestChainID() {
 	}
 }
 
+func (s *TestSuite) TestChainConfigAtHeight() {
+	height := int64(5)
+	osakaTime := sdkmath.NewInt(1000)
+	scheduledConfig := *evmtypes.GetChainConfig()
+	scheduledConfig.OsakaTime = &osakaTime
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expOsakaTime *uint64
+	}{
+		{
+			"pass - chain config stored at the height",
+			func() {
+				// replace the mock client to drop the default config
+				QueryClient := mocks.NewEVMQueryClient(s.T())
+				s.backend.QueryClient.QueryClient = QueryClient
+				RegisterConfigAtHeight(QueryClient, height, &scheduledConfig)
+			},
+			scheduledConfig.EthereumConfig(nil).OsakaTime,
+		},
+		{
+			"pass - query error, fall back to the latest chain config",
+			func() {
+				QueryClient := mocks.NewEVMQueryClient(s.T())
+				s.backend.QueryClient.QueryClient = QueryClient
+				RegisterConfigError(QueryClient, height)
+				RegisterConfig(QueryClient)
+			},
+			evmtypes.GetEthChainConfig().OsakaTime,
+		},
+		{
+			"pass - query errors, fall back to the default chain config",
+			func() {
+				QueryClient := mocks.NewEVMQueryClient(s.T())
+				s.backend.QueryClient.QueryClient = QueryClient
+				RegisterConfigError(QueryClient, height)
+				QueryClient.On("Config", s.backend.Ctx, &evmtypes.QueryConfigRequest{}).
+					Return(nil, errortypes.ErrInvalidRequest)
+			},
+			evmtypes.DefaultChainConfig(s.backend.EvmChainID.Uint64()).EthereumConfig(nil).OsakaTime,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			config := s.backend.ChainConfigAtHeight(height)
+			s.Require().Equal(tc.expOsakaTime, config.OsakaTime)
+			s.Require().Equal(evmtypes.GetEthChainConfig().ChainID, config.ChainID)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetCoinbase() {
 	validatorAcc := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
 	testCases := []struct {
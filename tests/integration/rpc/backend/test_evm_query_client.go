@@ -67,6 +67,25 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// IntermediateRoots
+func RegisterIntermediateRoots(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx, roots [][]byte) {
+	queryClient.On("IntermediateRoots", rpc.ContextWithHeight(1),
+		&evmtypes.QueryIntermediateRootsRequest{Txs: txs, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1, FeePayers: make([]string, len(txs))}). //nolint:gosec // G115
+		Return(&evmtypes.QueryIntermediateRootsResponse{Roots: roots}, nil)
+}
+
+func RegisterIntermediateRootsError(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx) {
+	queryClient.On("IntermediateRoots", rpc.ContextWithHeight(1),
+		&evmtypes.QueryIntermediateRootsRequest{Txs: txs, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1, FeePayers: make([]string, len(txs))}). //nolint:gosec // G115
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// Preimage
+func RegisterPreimage(queryClient *mocks.EVMQueryClient, hash common.Hash, preimage []byte) {
+	queryClient.On("Preimage", rpc.ContextWithHeight(1), &evmtypes.QueryPreimageRequest{Hash: hash.Hex()}).
+		Return(&evmtypes.QueryPreimageResponse{Preimage: preimage}, nil)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -154,6 +173,39 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// CreateAccessList
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, response *evmtypes.CreateAccessListResponse) {
+	queryClient.On("CreateAccessList", mock.Anything, request).
+		Return(response, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// SimulateV1
+func RegisterSimulateV1(queryClient *mocks.EVMQueryClient, request *evmtypes.SimulateV1Request, result []byte) {
+	queryClient.On("SimulateV1", mock.Anything, request).
+		Return(&evmtypes.SimulateV1Response{Result: result}, nil)
+}
+
+func RegisterSimulateV1Error(queryClient *mocks.EVMQueryClient, request *evmtypes.SimulateV1Request) {
+	queryClient.On("SimulateV1", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// PendingBlock
+func RegisterPendingBlock(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryPendingBlockRequest, response *evmtypes.QueryPendingBlockResponse) {
+	queryClient.On("PendingBlock", mock.Anything, request).
+		Return(response, nil)
+}
+
+func RegisterPendingBlockError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryPendingBlockRequest) {
+	queryClient.On("PendingBlock", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -279,6 +331,29 @@ func RegisterBalanceError(queryClient *mocks.EVMQueryClient, addr common.Address
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Config
+//
+// RegisterConfig is optional, it returns the chain config set on startup at
+// every height.
+func RegisterConfig(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("Config", mock.Anything, &evmtypes.QueryConfigRequest{}).
+		Return(&evmtypes.QueryConfigResponse{
+			Config:        evmtypes.GlobalEVMAppConfig().ChainConfig,
+			ExtendedDenom: evmtypes.GetEVMCoinExtendedDenom(),
+		}, nil).
+		Maybe()
+}
+
+func RegisterConfigAtHeight(queryClient *mocks.EVMQueryClient, height int64, config *evmtypes.ChainConfig) {
+	queryClient.On("Config", rpc.ContextWithHeight(height), &evmtypes.QueryConfigRequest{}).
+		Return(&evmtypes.QueryConfigResponse{Config: config, ExtendedDenom: evmtypes.GetEVMCoinExtendedDenom()}, nil)
+}
+
+func RegisterConfigError(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("Config", rpc.ContextWithHeight(height), &evmtypes.QueryConfigRequest{}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // GlobalMinGasPrice
 func RegisterGlobalMinGasPrice(queryClient *mocks.EVMQueryClient, height int64) {
 	queryClient.On("GlobalMinGasPrice", rpc.ContextWithHeight(height), &evmtypes.QueryGlobalMinGasPriceRequest{}).
@@ -12,6 +12,7 @@ import (
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/keystore"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
@@ -95,7 +96,8 @@ func (s *TestSuite) TestGenerateMinGasCoin() {
 			appConf := config.DefaultConfig()
 			appConf.SetMinGasPrices(tc.minGas)
 
-			output := s.backend.GenerateMinGasCoin(tc.gasPrice, *appConf)
+			output, err := s.backend.GenerateMinGasCoin(tc.gasPrice, *appConf)
+			s.Require().NoError(err)
 			s.Require().Equal(tc.expectedOutput, output)
 		})
 	}
@@ -362,3 +364,56 @@ func (s *TestSuite) TestImportRawKey() {
 		})
 	}
 }
+
+func (s *TestSuite) TestImportKeystore() {
+	priv, _ := ethsecp256k1.GenerateKey()
+	pubAddr := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	keyJSON, err := keystore.Encrypt(priv, "password", keystore.KDFPBKDF2)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		keyJSON  []byte
+		password string
+		expAddr  common.Address
+		expPass  bool
+	}{
+		{
+			"fail - not a keystore file",
+			[]byte("{}"),
+			"password",
+			common.Address{},
+			false,
+		},
+		{
+			"fail - wrong password",
+			keyJSON,
+			"wrong",
+			common.Address{},
+			false,
+		},
+		{
+			"pass - returning correct address",
+			keyJSON,
+			"password",
+			pubAddr,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+
+			output, err := s.backend.ImportKeystore(tc.keyJSON, tc.password)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAddr, output)
+				_, err = s.backend.ClientCtx.Keyring.KeyByAddress(sdk.AccAddress(tc.expAddr.Bytes()))
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -257,8 +257,7 @@ func broadcastTx(suite *TestSuite, priv *ethsecp256k1.PrivKey, baseFee math.Int,
 	msg := callArgsDefault.ToTransaction()
 	err = msg.Sign(ethSigner, suite.backend.ClientCtx.Keyring)
 	suite.Require().NoError(err)
-	baseDenom := evmtypes.GetEVMCoinDenom()
-	tx, _ := msg.BuildTx(suite.backend.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	tx, _ := msg.BuildTx(suite.backend.ClientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinInfo())
 	txEncoder := suite.backend.ClientCtx.TxConfig.TxEncoder()
 	txBytes, _ = txEncoder(tx)
 	return client, txBytes
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -40,15 +41,15 @@ func (s *TestSuite) TestTraceTransaction() {
 	msgEthereumTx.From = from.Bytes()
 	_ = msgEthereumTx.Sign(ethSigner, s.signer)
 
-	baseDenom := evmtypes.GetEVMCoinDenom()
+	coinInfo := evmtypes.GetEVMCoinInfo()
 
-	tx, _ := msgEthereumTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	tx, _ := msgEthereumTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), coinInfo)
 	txBz, _ := txEncoder(tx)
 
 	msgEthereumTx2.From = from.Bytes()
 	_ = msgEthereumTx2.Sign(ethSigner, s.signer)
 
-	tx2, _ := msgEthereumTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	tx2, _ := msgEthereumTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), coinInfo)
 	txBz2, _ := txEncoder(tx2)
 
 	testCases := []struct {
@@ -264,3 +265,131 @@ func (s *TestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestIntermediateRoots() {
+	msgEthTx, bz := s.buildEthereumTx()
+	emptyBlock := types.MakeBlock(1, []types.Tx{}, nil, nil)
+	emptyBlock.ChainID = ChainID.ChainID
+	filledBlock := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+	filledBlock.ChainID = ChainID.ChainID
+	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
+	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+	root := common.HexToHash("0x01")
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		resBlock     *tmrpctypes.ResultBlock
+		expRoots     []common.Hash
+		expPass      bool
+	}{
+		{
+			"pass - no transaction returning empty array",
+			func() {},
+			&resBlockEmpty,
+			[]common.Hash{},
+			true,
+		},
+		{
+			"fail - query client errors",
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterIntermediateRootsError(QueryClient, []*evmtypes.MsgEthereumTx{msgEthTx})
+				RegisterConsensusParams(client, 1)
+			},
+			&resBlockFilled,
+			nil,
+			false,
+		},
+		{
+			"pass - returns a root per transaction",
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterIntermediateRoots(QueryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, [][]byte{root.Bytes()})
+				RegisterConsensusParams(client, 1)
+			},
+			&resBlockFilled,
+			[]common.Hash{root},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			roots, err := s.backend.IntermediateRoots(1, tc.resBlock)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expRoots, roots)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestPreimage() {
+	preimage := []byte("preimage")
+	hash := ethcrypto.Keccak256Hash(preimage)
+	emptyHash := ethcrypto.Keccak256Hash(nil)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		hash         common.Hash
+		expPreimage  []byte
+		expPass      bool
+	}{
+		{
+			"fail - unknown preimage",
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPreimage(QueryClient, hash, nil)
+			},
+			hash,
+			nil,
+			false,
+		},
+		{
+			"pass - recorded preimage",
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPreimage(QueryClient, hash, preimage)
+			},
+			hash,
+			preimage,
+			true,
+		},
+		{
+			"pass - preimage of the empty data",
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPreimage(QueryClient, emptyHash, nil)
+			},
+			emptyHash,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := s.backend.Preimage(tc.hash)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expPreimage, res)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// buildEthereumTxWithNonce returns an encoded legacy Ethereum transaction sent
+// from the suite account with the given nonce
+func (s *TestSuite) buildEthereumTxWithNonce(nonce uint64) []byte {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  s.backend.EvmChainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(1),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(2),
+	})
+	msgEthereumTx.From = s.from.Bytes()
+
+	txBuilder := s.backend.ClientCtx.TxConfig.NewTxBuilder()
+	err := txBuilder.SetMsgs(msgEthereumTx)
+	s.Require().NoError(err)
+
+	bz, err := s.backend.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *TestSuite) TestTxPool() {
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   []string
+		expQueued    []string
+		expPass      bool
+	}{
+		{
+			"fail - pending transactions returns error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - contiguous nonces are pending, gapped nonces are queued",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{
+					s.buildEthereumTxWithNonce(3),
+					s.buildEthereumTxWithNonce(0),
+					s.buildEthereumTxWithNonce(1),
+				})
+				RegisterAccount(queryClient, s.from, 1)
+			},
+			[]string{"0", "1"},
+			[]string{"3"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			tc.registerMock()
+
+			status, err := s.backend.Status()
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(hexutil.Uint(len(tc.expPending)), status["pending"])
+			s.Require().Equal(hexutil.Uint(len(tc.expQueued)), status["queued"])
+
+			content, err := s.backend.ContentFrom(s.from)
+			s.Require().NoError(err)
+			s.Require().Len(content["pending"], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				s.Require().Contains(content["pending"], nonce)
+			}
+			s.Require().Len(content["queued"], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				s.Require().Contains(content["queued"], nonce)
+			}
+
+			inspect, err := s.backend.Inspect()
+			s.Require().NoError(err)
+			for _, nonce := range tc.expPending {
+				s.Require().Equal(
+					"0x0000000000000000000000000000000000000000: 1 wei + 21000 gas × 2 wei",
+					inspect["pending"][s.from.Hex()][nonce],
+				)
+			}
+		})
+	}
+}
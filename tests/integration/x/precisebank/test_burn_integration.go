@@ -36,7 +36,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsMatchingErrors() {
 			"invalid module",
 			"notamodule",
 			func() {},
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"",
 			"module account notamodule does not exist: unknown address",
 		},
@@ -45,7 +45,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsMatchingErrors() {
 			// Check app.go to ensure this module has no burn permissions
 			authtypes.FeeCollectorName,
 			func() {},
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"",
 			"module account fee_collector does not have permissions to burn tokens: unauthorized",
 		},
@@ -54,16 +54,16 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsMatchingErrors() {
 			// Has burn permissions so it goes to the amt check
 			evmtypes.ModuleName,
 			func() {},
-			sdk.Coins{sdk.Coin{Denom: types.IntegerCoinDenom(), Amount: sdkmath.NewInt(-100)}},
-			fmt.Sprintf("-100%s: invalid coins", types.IntegerCoinDenom()),
+			sdk.Coins{sdk.Coin{Denom: evmtypes.GetEVMCoinDenom(), Amount: sdkmath.NewInt(-100)}},
+			fmt.Sprintf("-100%s: invalid coins", evmtypes.GetEVMCoinDenom()),
 			"",
 		},
 		{
 			"insufficient balance - empty",
 			evmtypes.ModuleName,
 			func() {},
-			cs(c(types.IntegerCoinDenom(), 1000)),
-			fmt.Sprintf("spendable balance 0%s is smaller than 1000%s: insufficient funds", types.IntegerCoinDenom(), types.IntegerCoinDenom()),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
+			fmt.Sprintf("spendable balance 0%s is smaller than 1000%s: insufficient funds", evmtypes.GetEVMCoinDenom(), evmtypes.GetEVMCoinDenom()),
 			"",
 		},
 	}
@@ -126,42 +126,42 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoins() {
 		},
 		{
 			"passthrough - integer denom",
-			cs(c(types.IntegerCoinDenom(), 2000)),
-			cs(c(types.IntegerCoinDenom(), 1000)),
-			cs(c(types.ExtendedCoinDenom(), 1000000000000000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 2000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000000000000000)),
 			"",
 		},
 		{
 			"fractional only - no borrow",
-			cs(c(types.ExtendedCoinDenom(), 1000)),
-			cs(c(types.ExtendedCoinDenom(), 500)),
-			cs(c(types.ExtendedCoinDenom(), 500)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 500)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 500)),
 			"",
 		},
 		{
 			"fractional burn - borrows",
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(100))),
-			cs(c(types.ExtendedCoinDenom(), 500)),
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(400))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().AddRaw(100))),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 500)),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(400))),
 			"",
 		},
 		{
 			"error - insufficient integer balance",
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor())),
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(2))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor())),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(2))),
 			cs(),
 			// Returns correct error with aatom balance (rewrites Bank BurnCoins err)
 			fmt.Sprintf("spendable balance 1000000000000%s is smaller than 2000000000000%s: insufficient funds",
-				types.ExtendedCoinDenom(), types.ExtendedCoinDenom()),
+				evmtypes.GetEVMCoinExtendedDenom(), evmtypes.GetEVMCoinExtendedDenom()),
 		},
 		{
 			"error - insufficient fractional, borrow",
-			cs(c(types.ExtendedCoinDenom(), 1000)),
-			cs(c(types.ExtendedCoinDenom(), 2000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 2000)),
 			cs(),
 			// Error from SendCoins to reserve
 			fmt.Sprintf("spendable balance 1000%s is smaller than 2000%s: insufficient funds",
-				types.ExtendedCoinDenom(), types.ExtendedCoinDenom()),
+				evmtypes.GetEVMCoinExtendedDenom(), evmtypes.GetEVMCoinExtendedDenom()),
 		},
 	}
 
@@ -199,14 +199,14 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoins() {
 				"unexpected balance after minting %s to %s",
 			)
 
-			intCoinAmt := tt.burnCoins.AmountOf(types.IntegerCoinDenom()).
+			intCoinAmt := tt.burnCoins.AmountOf(evmtypes.GetEVMCoinDenom()).
 				Mul(types.ConversionFactor())
 
-			fraCoinAmt := tt.burnCoins.AmountOf(types.ExtendedCoinDenom())
+			fraCoinAmt := tt.burnCoins.AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 
 			totalExtCoinAmt := intCoinAmt.Add(fraCoinAmt)
 			spentCoins := sdk.NewCoins(sdk.NewCoin(
-				types.ExtendedCoinDenom(),
+				evmtypes.GetEVMCoinExtendedDenom(),
 				totalExtCoinAmt,
 			))
 
@@ -236,7 +236,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRemainder() {
 	moduleName := evmtypes.ModuleName
 	moduleAddr := s.network.App.GetAccountKeeper().GetModuleAddress(moduleName)
 
-	startCoins := cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(5)))
+	startCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(5)))
 
 	// Start balance
 	err := s.network.App.GetPreciseBankKeeper().MintCoins(
@@ -247,20 +247,20 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRemainder() {
 	s.Require().NoError(err)
 
 	burnAmt := types.ConversionFactor().QuoRaw(10)
-	burnCoins := cs(ci(types.ExtendedCoinDenom(), burnAmt))
+	burnCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), burnAmt))
 
 	// Burn 0.1 until balance is 0
 	for {
 		reserveBalBefore := s.network.App.GetBankKeeper().GetBalance(
 			s.network.GetContext(),
 			reserveAddr,
-			types.IntegerCoinDenom(),
+			evmtypes.GetEVMCoinDenom(),
 		)
 
 		balBefore := s.network.App.GetPreciseBankKeeper().GetBalance(
 			s.network.GetContext(),
 			moduleAddr,
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 		)
 		remainderBefore := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
 
@@ -279,12 +279,12 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRemainder() {
 		balAfter := s.network.App.GetPreciseBankKeeper().GetBalance(
 			s.network.GetContext(),
 			moduleAddr,
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 		)
 		reserveBalAfter := s.network.App.GetBankKeeper().GetBalance(
 			s.network.GetContext(),
 			reserveAddr,
-			types.IntegerCoinDenom(),
+			evmtypes.GetEVMCoinDenom(),
 		)
 
 		s.Require().Equal(
@@ -326,7 +326,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsSpreadRemainder() {
 	burnerAddr := s.network.App.GetAccountKeeper().GetModuleAddress(burnerModuleName)
 
 	accCount := 20
-	startCoins := cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(5)))
+	startCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(5)))
 
 	addrs := []sdk.AccAddress{}
 
@@ -338,20 +338,20 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsSpreadRemainder() {
 	}
 
 	burnAmt := types.ConversionFactor().QuoRaw(10)
-	burnCoins := cs(ci(types.ExtendedCoinDenom(), burnAmt))
+	burnCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), burnAmt))
 
 	// Burn 0.1 from each account
 	for _, addr := range addrs {
 		reserveBalBefore := s.network.App.GetBankKeeper().GetBalance(
 			s.network.GetContext(),
 			reserveAddr,
-			types.IntegerCoinDenom(),
+			evmtypes.GetEVMCoinDenom(),
 		)
 
 		balBefore := s.network.App.GetPreciseBankKeeper().GetBalance(
 			s.network.GetContext(),
 			addr,
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 		)
 		remainderBefore := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
 
@@ -378,12 +378,12 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsSpreadRemainder() {
 		balAfter := s.network.App.GetPreciseBankKeeper().GetBalance(
 			s.network.GetContext(),
 			addr,
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 		)
 		reserveBalAfter := s.network.App.GetBankKeeper().GetBalance(
 			s.network.GetContext(),
 			reserveAddr,
-			types.IntegerCoinDenom(),
+			evmtypes.GetEVMCoinDenom(),
 		)
 
 		s.Require().Equal(
@@ -455,7 +455,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRandomValueMultiDecimals() {
 
 			// Initial balance large enough to cover many small burns
 			initialBalance := types.ConversionFactor().MulRaw(100)
-			initialCoin := cs(ci(types.ExtendedCoinDenom(), initialBalance))
+			initialCoin := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), initialBalance))
 			err := s.network.App.GetPreciseBankKeeper().MintCoins(s.network.GetContext(), burnerModuleName, initialCoin)
 			s.Require().NoError(err)
 			err = s.network.App.GetPreciseBankKeeper().SendCoinsFromModuleToAccount(s.network.GetContext(), burnerModuleName, burner, initialCoin)
@@ -471,7 +471,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRandomValueMultiDecimals() {
 			// Continue burns as long as burner has balance remaining
 			for {
 				// Check current burner balance
-				burnerAmount := s.GetAllBalances(burner).AmountOf(types.ExtendedCoinDenom())
+				burnerAmount := s.GetAllBalances(burner).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 				if burnerAmount.IsZero() {
 					break
 				}
@@ -484,7 +484,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRandomValueMultiDecimals() {
 				randAmount := sdkmath.NewIntFromBigInt(new(big.Int).Rand(r, maxPossibleBurn.BigInt())).AddRaw(1)
 
 				// 1. send to burner module
-				burnCoins := cs(ci(types.ExtendedCoinDenom(), randAmount))
+				burnCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), randAmount))
 				err := s.network.App.GetPreciseBankKeeper().SendCoinsFromAccountToModule(s.network.GetContext(), burner, burnerModuleName, burnCoins)
 				s.Require().NoError(err)
 
@@ -499,7 +499,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRandomValueMultiDecimals() {
 			s.T().Logf("Completed %d random burns, total burned: %s", burnCount, totalBurned)
 
 			// Check burner balance
-			burnerBal := s.GetAllBalances(burner).AmountOf(types.ExtendedCoinDenom())
+			burnerBal := s.GetAllBalances(burner).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 			s.Equal(burnerBal.BigInt().Cmp(big.NewInt(0)), 0, "burner balance mismatch (expected: %s, actual: %s)", big.NewInt(0), burnerBal)
 
 			// Check remainder
@@ -544,7 +544,7 @@ func FuzzBurnCoins(f *testing.F) {
 		err := suite.network.App.GetPreciseBankKeeper().MintCoins(
 			suite.network.GetContext(),
 			moduleName,
-			cs(ci(types.ExtendedCoinDenom(), sdkmath.NewInt(amount).MulRaw(burnCount))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(amount).MulRaw(burnCount))),
 		)
 		suite.Require().NoError(err)
 
@@ -553,13 +553,13 @@ func FuzzBurnCoins(f *testing.F) {
 			err := suite.network.App.GetPreciseBankKeeper().BurnCoins(
 				suite.network.GetContext(),
 				moduleName,
-				cs(c(types.ExtendedCoinDenom(), amount)),
+				cs(c(evmtypes.GetEVMCoinExtendedDenom(), amount)),
 			)
 			suite.Require().NoError(err)
 		}
 
 		// Check full balances
-		balAfter := suite.network.App.GetPreciseBankKeeper().GetBalance(suite.network.GetContext(), moduleAddr, types.ExtendedCoinDenom())
+		balAfter := suite.network.App.GetPreciseBankKeeper().GetBalance(suite.network.GetContext(), moduleAddr, evmtypes.GetEVMCoinExtendedDenom())
 
 		suite.Require().Equalf(
 			int64(0),
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/x/precisebank"
 	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -64,7 +65,7 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 				err := s.network.App.GetBankKeeper().MintCoins(
 					s.network.GetContext(),
 					types.ModuleName,
-					sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(2))),
+					sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(2))),
 				)
 				s.Require().NoError(err)
 			},
@@ -103,7 +104,7 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 				sdkmath.NewInt(2),
 			),
 			fmt.Sprintf("module account balance does not match sum of fractional balances and remainder, balance is 0%s but expected 2000000000000%s (2%s)",
-				types.IntegerCoinDenom(), types.ExtendedCoinDenom(), types.IntegerCoinDenom()),
+				evmtypes.GetEVMCoinDenom(), evmtypes.GetEVMCoinExtendedDenom(), evmtypes.GetEVMCoinDenom()),
 		},
 		{
 			"invalid - module balance excessive",
@@ -112,7 +113,7 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 				err := s.network.App.GetBankKeeper().MintCoins(
 					s.network.GetContext(),
 					types.ModuleName,
-					sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(100))),
+					sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(100))),
 				)
 				s.Require().NoError(err)
 			},
@@ -124,7 +125,7 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 				sdkmath.NewInt(2),
 			),
 			fmt.Sprintf("module account balance does not match sum of fractional balances and remainder, balance is 100%s but expected 2000000000000%s (2%s)",
-				types.IntegerCoinDenom(), types.ExtendedCoinDenom(), types.IntegerCoinDenom()),
+				evmtypes.GetEVMCoinDenom(), evmtypes.GetEVMCoinExtendedDenom(), evmtypes.GetEVMCoinDenom()),
 		},
 		{
 			"sets module account",
@@ -218,7 +219,7 @@ func (s *GenesisTestSuite) TestExportGenesis() {
 				err := s.network.App.GetBankKeeper().MintCoins(
 					s.network.GetContext(),
 					types.ModuleName,
-					sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))),
+					sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1))),
 				)
 				s.Require().NoError(err)
 
@@ -237,7 +238,7 @@ func (s *GenesisTestSuite) TestExportGenesis() {
 				err := s.network.App.GetBankKeeper().MintCoins(
 					s.network.GetContext(),
 					types.ModuleName,
-					sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))),
+					sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1))),
 				)
 				s.Require().NoError(err)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -18,14 +19,14 @@ func (s *KeeperIntegrationTestSuite) TestQueryRemainder() {
 	)
 	s.Require().NoError(err)
 
-	expRemainder := sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.ZeroInt())
+	expRemainder := sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.ZeroInt())
 	s.Require().Equal(expRemainder, res.Remainder)
 
 	// Mint fractional coins to create non-zero remainder
 
 	pbk := s.network.App.GetPreciseBankKeeper()
 
-	coin := sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.OneInt())
+	coin := sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.OneInt())
 	err = pbk.MintCoins(
 		s.network.GetContext(),
 		minttypes.ModuleName,
@@ -76,7 +77,7 @@ func (s *KeeperIntegrationTestSuite) TestQueryFractionalBalance() {
 
 			addr := sdk.AccAddress([]byte("test"))
 
-			coin := sdk.NewCoin(types.ExtendedCoinDenom(), tc.giveBalance)
+			coin := sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), tc.giveBalance)
 			s.MintToAccount(addr, sdk.NewCoins(coin))
 
 			res, err := s.network.GetPreciseBankClient().FractionalBalance(
@@ -89,7 +90,7 @@ func (s *KeeperIntegrationTestSuite) TestQueryFractionalBalance() {
 
 			// Only fractional amount, even if minted more than conversion factor
 			expAmount := tc.giveBalance.Mod(types.ConversionFactor())
-			expFractionalBalance := sdk.NewCoin(types.ExtendedCoinDenom(), expAmount)
+			expFractionalBalance := sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), expAmount)
 			s.Require().Equal(expFractionalBalance, res.FractionalBalance)
 		})
 	}
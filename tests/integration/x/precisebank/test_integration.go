@@ -47,7 +47,7 @@ func (s *KeeperIntegrationTestSuite) TestMintBurnSendCoinsRandomValueMultiDecima
 
 			// Mint initial balance to sender
 			initialBalance := types.ConversionFactor().MulRaw(100)
-			initialCoins := cs(ci(types.ExtendedCoinDenom(), initialBalance))
+			initialCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), initialBalance))
 			s.Require().NoError(s.network.App.GetPreciseBankKeeper().MintCoins(s.network.GetContext(), moduleName, initialCoins))
 			s.Require().NoError(s.network.App.GetPreciseBankKeeper().SendCoinsFromModuleToAccount(s.network.GetContext(), moduleName, sender, initialCoins))
 
@@ -69,7 +69,7 @@ func (s *KeeperIntegrationTestSuite) TestMintBurnSendCoinsRandomValueMultiDecima
 				switch op {
 				case 0: // Mint to sender via module
 					randAmount := sdkmath.NewIntFromBigInt(new(big.Int).Rand(r, maxUnit.BigInt())).AddRaw(1)
-					mintCoins := cs(ci(types.ExtendedCoinDenom(), randAmount))
+					mintCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), randAmount))
 					if err := s.network.App.GetPreciseBankKeeper().MintCoins(s.network.GetContext(), moduleName, mintCoins); err != nil {
 						continue
 					}
@@ -81,13 +81,13 @@ func (s *KeeperIntegrationTestSuite) TestMintBurnSendCoinsRandomValueMultiDecima
 					mintCount++
 
 				case 1: // Burn from sender via module
-					senderBal := s.GetAllBalances(sender).AmountOf(types.ExtendedCoinDenom())
+					senderBal := s.GetAllBalances(sender).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 					if senderBal.IsZero() {
 						continue
 					}
 					burnable := sdkmath.MinInt(senderBal, maxUnit)
 					randAmount := sdkmath.NewIntFromBigInt(new(big.Int).Rand(r, burnable.BigInt())).AddRaw(1)
-					burnCoins := cs(ci(types.ExtendedCoinDenom(), randAmount))
+					burnCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), randAmount))
 					if err := s.network.App.GetPreciseBankKeeper().SendCoinsFromAccountToModule(s.network.GetContext(), sender, moduleName, burnCoins); err != nil {
 						continue
 					}
@@ -99,13 +99,13 @@ func (s *KeeperIntegrationTestSuite) TestMintBurnSendCoinsRandomValueMultiDecima
 					burnCount++
 
 				case 2: // Send from sender to recipient
-					senderBal := s.GetAllBalances(sender).AmountOf(types.ExtendedCoinDenom())
+					senderBal := s.GetAllBalances(sender).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 					if senderBal.IsZero() {
 						continue
 					}
 					sendable := sdkmath.MinInt(senderBal, maxUnit)
 					randAmount := sdkmath.NewIntFromBigInt(new(big.Int).Rand(r, sendable.BigInt())).AddRaw(1)
-					sendCoins := cs(ci(types.ExtendedCoinDenom(), randAmount))
+					sendCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), randAmount))
 					if err := s.network.App.GetPreciseBankKeeper().SendCoins(s.network.GetContext(), sender, recipient, sendCoins); err != nil {
 						continue
 					}
@@ -118,8 +118,8 @@ func (s *KeeperIntegrationTestSuite) TestMintBurnSendCoinsRandomValueMultiDecima
 			s.T().Logf("Executed operations: %d mints, %d burns, %d sends", mintCount, burnCount, sendCount)
 
 			// Check balances
-			actualSenderBal := s.GetAllBalances(sender).AmountOf(types.ExtendedCoinDenom())
-			actualRecipientBal := s.GetAllBalances(recipient).AmountOf(types.ExtendedCoinDenom())
+			actualSenderBal := s.GetAllBalances(sender).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
+			actualRecipientBal := s.GetAllBalances(recipient).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 			s.Require().Equal(expectedSenderBal.BigInt().Cmp(actualSenderBal.BigInt()), 0, "Sender balance mismatch (expected: %s, actual: %s)", expectedSenderBal, actualSenderBal)
 			s.Require().Equal(expectedRecipientBal.BigInt().Cmp(actualRecipientBal.BigInt()), 0, "Recipient balance mismatch (expected: %s, actual: %s)", expectedRecipientBal, actualRecipientBal)
 
@@ -168,7 +168,7 @@ func (s *KeeperIntegrationTestSuite) TestSendEvmTxRandomValueMultiDecimals() {
 
 			// Burn balance from sender except for initial balance
 			initialBalance := types.ConversionFactor().MulRaw(100)
-			senderBal := s.GetAllBalances(sender.AccAddr).AmountOf(types.ExtendedCoinDenom()).Sub(gasFee).Sub(initialBalance)
+			senderBal := s.GetAllBalances(sender.AccAddr).AmountOf(evmtypes.GetEVMCoinExtendedDenom()).Sub(gasFee).Sub(initialBalance)
 			_, err = s.factory.ExecuteEthTx(sender.Priv, evmtypes.EvmTxArgs{
 				To:       &burnerAddr,
 				Amount:   senderBal.BigInt(),
@@ -178,7 +178,7 @@ func (s *KeeperIntegrationTestSuite) TestSendEvmTxRandomValueMultiDecimals() {
 			s.Require().NoError(err)
 
 			// Burn balance from recipient
-			recipientBal := s.GetAllBalances(recipient.AccAddr).AmountOf(types.ExtendedCoinDenom()).Sub(gasFee)
+			recipientBal := s.GetAllBalances(recipient.AccAddr).AmountOf(evmtypes.GetEVMCoinExtendedDenom()).Sub(gasFee)
 			_, err = s.factory.ExecuteEthTx(recipient.Priv, evmtypes.EvmTxArgs{
 				To:       &burnerAddr,
 				Amount:   recipientBal.BigInt(),
@@ -235,12 +235,12 @@ func (s *KeeperIntegrationTestSuite) TestSendEvmTxRandomValueMultiDecimals() {
 			s.T().Logf("Completed %d random evm sends", sentCount)
 
 			// Check sender balance
-			actualSenderBal := s.GetAllBalances(sender.AccAddr).AmountOf(types.ExtendedCoinDenom())
+			actualSenderBal := s.GetAllBalances(sender.AccAddr).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 			s.Require().Equal(expectedSenderBal.BigInt().Cmp(actualSenderBal.BigInt()), 0,
 				"Sender balance mismatch (expected: %s, actual: %s)", expectedSenderBal, actualSenderBal)
 
 			// Check recipient balance
-			actualRecipientBal := s.GetAllBalances(recipient.AccAddr).AmountOf(types.ExtendedCoinDenom())
+			actualRecipientBal := s.GetAllBalances(recipient.AccAddr).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 			s.Require().Equal(expectedRecipientBal.BigInt().Cmp(actualRecipientBal.BigInt()), 0,
 				"Recipient balance mismatch (expected: %s, actual: %s)", expectedRecipientBal, actualRecipientBal)
 		})
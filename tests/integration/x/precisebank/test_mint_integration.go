@@ -30,7 +30,7 @@ func (s *KeeperIntegrationTestSuite) TestBlockedRecipient() {
 
 	// To x/precisebank
 	toAddr := s.network.App.GetAccountKeeper().GetModuleAddress(types.ModuleName)
-	amount := cs(c(types.IntegerCoinDenom(), 1000))
+	amount := cs(c(evmtypes.GetEVMCoinDenom(), 1000))
 
 	msg := banktypes.NewMsgSend(fromAddr, toAddr, amount)
 
@@ -58,7 +58,7 @@ func (s *KeeperIntegrationTestSuite) TestMintCoinsMatchingErrors() {
 		{
 			"invalid module",
 			"notamodule",
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"",
 			"module account notamodule does not exist: unknown address",
 		},
@@ -66,15 +66,15 @@ func (s *KeeperIntegrationTestSuite) TestMintCoinsMatchingErrors() {
 			"no mint permissions",
 			// Check app.go to ensure this module has no mint permissions
 			authtypes.FeeCollectorName,
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"",
 			"module account fee_collector does not have permissions to mint tokens: unauthorized",
 		},
 		{
 			"invalid amount",
 			evmtypes.ModuleName,
-			sdk.Coins{sdk.Coin{Denom: types.IntegerCoinDenom(), Amount: sdkmath.NewInt(-100)}},
-			fmt.Sprintf("-100%s: invalid coins", types.IntegerCoinDenom()),
+			sdk.Coins{sdk.Coin{Denom: evmtypes.GetEVMCoinDenom(), Amount: sdkmath.NewInt(-100)}},
+			fmt.Sprintf("-100%s: invalid coins", evmtypes.GetEVMCoinDenom()),
 			"",
 		},
 	}
@@ -149,8 +149,8 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 			evmtypes.ModuleName,
 			[]mintTest{
 				{
-					mintAmount:  cs(c(types.IntegerCoinDenom(), 1000)),
-					wantBalance: cs(c(types.ExtendedCoinDenom(), 1000000000000000)),
+					mintAmount:  cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
+					wantBalance: cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000000000000000)),
 				},
 			},
 		},
@@ -159,12 +159,12 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 			evmtypes.ModuleName,
 			[]mintTest{
 				{
-					mintAmount:  cs(c(types.ExtendedCoinDenom(), 1000)),
-					wantBalance: cs(c(types.ExtendedCoinDenom(), 1000)),
+					mintAmount:  cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
+					wantBalance: cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 				},
 				{
-					mintAmount:  cs(c(types.ExtendedCoinDenom(), 1000)),
-					wantBalance: cs(c(types.ExtendedCoinDenom(), 2000)),
+					mintAmount:  cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
+					wantBalance: cs(c(evmtypes.GetEVMCoinExtendedDenom(), 2000)),
 				},
 			},
 		},
@@ -174,13 +174,13 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 			[]mintTest{
 				{
 					// Start with (1/4 * 3) = 0.75
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().QuoRaw(4).MulRaw(3))),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().QuoRaw(4).MulRaw(3))),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().QuoRaw(4).MulRaw(3))),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().QuoRaw(4).MulRaw(3))),
 				},
 				{
 					// Add another 0.50 to incur carry to test reserve on carry
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().QuoRaw(2))),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().QuoRaw(4).MulRaw(5))),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().QuoRaw(2))),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().QuoRaw(4).MulRaw(5))),
 				},
 			},
 		},
@@ -190,14 +190,14 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 			[]mintTest{
 				// mint 0.5, acc = 0.5, reserve = 1
 				{
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().QuoRaw(2))),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().QuoRaw(2))),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().QuoRaw(2))),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().QuoRaw(2))),
 				},
 				// mint another 0.5, acc = 1, reserve = 0
 				// Reserve actually goes down by 1 for integer carry
 				{
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().QuoRaw(2))),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor())),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().QuoRaw(2))),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor())),
 				},
 			},
 		},
@@ -206,13 +206,13 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 			evmtypes.ModuleName,
 			[]mintTest{
 				{
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor())),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor())),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor())),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor())),
 				},
 				// Carry again - exact amount
 				{
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor())),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(2))),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor())),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(2))),
 				},
 			},
 		},
@@ -222,13 +222,13 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 			[]mintTest{
 				// MintCoins(C + 100)
 				{
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(100))),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(100))),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().AddRaw(100))),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().AddRaw(100))),
 				},
 				// MintCoins(C + 5), total = 2C + 105
 				{
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(5))),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(2).AddRaw(105))),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().AddRaw(5))),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(2).AddRaw(105))),
 				},
 			},
 		},
@@ -237,12 +237,12 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 			evmtypes.ModuleName,
 			[]mintTest{
 				{
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(5).AddRaw(100))),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(5).AddRaw(100))),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(5).AddRaw(100))),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(5).AddRaw(100))),
 				},
 				{
-					mintAmount:  cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(2).AddRaw(5))),
-					wantBalance: cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(7).AddRaw(105))),
+					mintAmount:  cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(2).AddRaw(5))),
+					wantBalance: cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(7).AddRaw(105))),
 				},
 			},
 		},
@@ -252,21 +252,21 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 			[]mintTest{
 				{
 					mintAmount: cs(
-						ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(5).AddRaw(100)),
+						ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(5).AddRaw(100)),
 						c("busd", 1000),
 					),
 					wantBalance: cs(
-						ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(5).AddRaw(100)),
+						ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(5).AddRaw(100)),
 						c("busd", 1000),
 					),
 				},
 				{
 					mintAmount: cs(
-						ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(2).AddRaw(5)),
+						ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(2).AddRaw(5)),
 						c("meow", 40),
 					),
 					wantBalance: cs(
-						ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(7).AddRaw(105)),
+						ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(7).AddRaw(105)),
 						c("busd", 1000),
 						c("meow", 40),
 					),
@@ -296,7 +296,7 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 				var denoms []string
 				for _, coin := range bankCoins {
 					// Ignore integer coins, query the extended denom instead
-					if coin.Denom == types.IntegerCoinDenom() {
+					if coin.Denom == evmtypes.GetEVMCoinDenom() {
 						continue
 					}
 
@@ -306,7 +306,7 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 				// Add the extended denom to the list of denoms to balance check
 				// Will be included in balance check even if x/bank doesn't have
 				// uatom.
-				denoms = append(denoms, types.ExtendedCoinDenom())
+				denoms = append(denoms, evmtypes.GetEVMCoinExtendedDenom())
 
 				// All balance queries through x/precisebank
 				afterBalance := sdk.NewCoins()
@@ -322,13 +322,13 @@ func (s *KeeperIntegrationTestSuite) TestMintCoins() {
 				)
 
 				// Get event for minted coins
-				intCoinAmt := mt.mintAmount.AmountOf(types.IntegerCoinDenom()).
+				intCoinAmt := mt.mintAmount.AmountOf(evmtypes.GetEVMCoinDenom()).
 					Mul(types.ConversionFactor())
 
-				fraCoinAmt := mt.mintAmount.AmountOf(types.ExtendedCoinDenom())
+				fraCoinAmt := mt.mintAmount.AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 
 				totalExtCoinAmt := intCoinAmt.Add(fraCoinAmt)
-				extCoins := sdk.NewCoins(sdk.NewCoin(types.ExtendedCoinDenom(), totalExtCoinAmt))
+				extCoins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), totalExtCoinAmt))
 
 				// Check for mint event
 				events := s.network.GetContext().EventManager().Events()
@@ -395,7 +395,7 @@ func (s *KeeperIntegrationTestSuite) TestMintCoinsRandomValueMultiDecimals() {
 			// Continue mints as long as target balance is not reached
 			for {
 				// Check current minter balance
-				minterBal := s.GetAllBalances(minter).AmountOf(types.ExtendedCoinDenom())
+				minterBal := s.GetAllBalances(minter).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 				if minterBal.GTE(targetBalance) {
 					break
 				}
@@ -406,7 +406,7 @@ func (s *KeeperIntegrationTestSuite) TestMintCoinsRandomValueMultiDecimals() {
 				randAmount := sdkmath.NewIntFromBigInt(new(big.Int).Rand(r, maxPossible.BigInt())).AddRaw(1)
 
 				// 1. mint to evm module
-				mintCoins := cs(ci(types.ExtendedCoinDenom(), randAmount))
+				mintCoins := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), randAmount))
 				err := s.network.App.GetPreciseBankKeeper().MintCoins(s.network.GetContext(), minterModuleName, mintCoins)
 				s.Require().NoError(err)
 
@@ -421,7 +421,7 @@ func (s *KeeperIntegrationTestSuite) TestMintCoinsRandomValueMultiDecimals() {
 			s.T().Logf("Completed %d random mints, total minted: %s", mintCount, totalMinted)
 
 			// Check minter balance
-			minterBal := s.GetAllBalances(minter).AmountOf(types.ExtendedCoinDenom())
+			minterBal := s.GetAllBalances(minter).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 			s.Equal(minterBal.BigInt().Cmp(targetBalance.BigInt()), 0, "minter balance mismatch (expected: %s, actual: %s)", targetBalance, minterBal)
 
 			// Check remainder
@@ -466,14 +466,14 @@ func FuzzMintCoins(f *testing.F) {
 			err := suite.network.App.GetPreciseBankKeeper().MintCoins(
 				suite.network.GetContext(),
 				evmtypes.ModuleName,
-				cs(c(types.ExtendedCoinDenom(), amount)),
+				cs(c(evmtypes.GetEVMCoinExtendedDenom(), amount)),
 			)
 			suite.Require().NoError(err)
 		}
 
 		// Check full balances
 		recipientAddr := suite.network.App.GetAccountKeeper().GetModuleAddress(evmtypes.ModuleName)
-		bal := suite.network.App.GetPreciseBankKeeper().GetBalance(suite.network.GetContext(), recipientAddr, types.ExtendedCoinDenom())
+		bal := suite.network.App.GetPreciseBankKeeper().GetBalance(suite.network.GetContext(), recipientAddr, evmtypes.GetEVMCoinExtendedDenom())
 
 		suite.Require().Equalf(
 			amount*mintCount,
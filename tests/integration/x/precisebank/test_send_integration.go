@@ -54,7 +54,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsFromAccountToModuleMatchingErr
 			"missing module account - extended",
 			sdk.AccAddress([]byte{2}),
 			"cat",
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			"module account cat does not exist: unknown address",
 		},
 	}
@@ -139,7 +139,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsFromModuleToAccountMatchingErr
 			"missing module account - extended",
 			"cat",
 			sdk.AccAddress([]byte{2}),
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			"",
 			"module account cat does not exist: unknown address",
 		},
@@ -155,7 +155,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsFromModuleToAccountMatchingErr
 			"blocked recipient address - extended",
 			senderModuleName,
 			blockedAddr,
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			fmt.Sprintf("%s is not allowed to receive funds: unauthorized", blockedAddr.String()),
 			"",
 		},
@@ -164,17 +164,17 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsFromModuleToAccountMatchingErr
 			"invalid coins",
 			senderModuleName,
 			sdk.AccAddress([]byte{2}),
-			sdk.Coins{sdk.Coin{Denom: types.IntegerCoinDenom(), Amount: sdkmath.NewInt(-1)}},
-			fmt.Sprintf("-1%s: invalid coins", types.IntegerCoinDenom()),
+			sdk.Coins{sdk.Coin{Denom: evmtypes.GetEVMCoinDenom(), Amount: sdkmath.NewInt(-1)}},
+			fmt.Sprintf("-1%s: invalid coins", evmtypes.GetEVMCoinDenom()),
 			"",
 		},
 		{
 			"insufficient balance - passthrough",
 			senderModuleName,
 			sdk.AccAddress([]byte{2}),
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			fmt.Sprintf("spendable balance 0%s is smaller than 1000%s: insufficient funds",
-				types.IntegerCoinDenom(), types.IntegerCoinDenom()),
+				evmtypes.GetEVMCoinDenom(), evmtypes.GetEVMCoinDenom()),
 			"",
 		},
 		{
@@ -183,9 +183,9 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsFromModuleToAccountMatchingErr
 			sdk.AccAddress([]byte{2}),
 			// We can still test insufficient bal errors with "aatom" since
 			// we also expect it to not exist in x/bank
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			fmt.Sprintf("spendable balance 0%s is smaller than 1000%s: insufficient funds",
-				types.ExtendedCoinDenom(), types.ExtendedCoinDenom()),
+				evmtypes.GetEVMCoinExtendedDenom(), evmtypes.GetEVMCoinExtendedDenom()),
 			"",
 		},
 	}
@@ -243,32 +243,32 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsMatchingErrors() {
 		{
 			"invalid coins",
 			cs(),
-			sdk.Coins{sdk.Coin{Denom: types.IntegerCoinDenom(), Amount: sdkmath.NewInt(-1)}},
+			sdk.Coins{sdk.Coin{Denom: evmtypes.GetEVMCoinDenom(), Amount: sdkmath.NewInt(-1)}},
 			fmt.Sprintf("-1%s: invalid coins",
-				types.IntegerCoinDenom()),
+				evmtypes.GetEVMCoinDenom()),
 		},
 		{
 			"insufficient empty balance - passthrough",
 			cs(),
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			fmt.Sprintf("spendable balance 0%s is smaller than 1000%s: insufficient funds",
-				types.IntegerCoinDenom(), types.IntegerCoinDenom()),
+				evmtypes.GetEVMCoinDenom(), evmtypes.GetEVMCoinDenom()),
 		},
 		{
 			"insufficient empty balance - extended",
 			cs(),
 			// We can still test insufficient bal errors with "aatom" since
 			// we also expect it to not exist in x/bank
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			fmt.Sprintf("spendable balance 0%s is smaller than 1000%s: insufficient funds",
-				types.ExtendedCoinDenom(), types.ExtendedCoinDenom()),
+				evmtypes.GetEVMCoinExtendedDenom(), evmtypes.GetEVMCoinExtendedDenom()),
 		},
 		{
 			"insufficient non-empty balance - passthrough",
-			cs(c(types.IntegerCoinDenom(), 100), c("usdc", 1000)),
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 100), c("usdc", 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			fmt.Sprintf("spendable balance 100%s is smaller than 1000%s: insufficient funds",
-				types.IntegerCoinDenom(), types.IntegerCoinDenom()),
+				evmtypes.GetEVMCoinDenom(), evmtypes.GetEVMCoinDenom()),
 		},
 		// non-empty aatom transfer error is tested in SendCoins, not here since
 		// x/bank doesn't hold aatom
@@ -315,11 +315,11 @@ func (s *KeeperIntegrationTestSuite) TestSendCoins() {
 	}{
 		{
 			"insufficient balance error denom matches",
-			cs(c(types.ExtendedCoinDenom(), 10), c("usdc", 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 10), c("usdc", 1000)),
 			cs(),
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			fmt.Sprintf("spendable balance 10%s is smaller than 1000%s: insufficient funds",
-				types.ExtendedCoinDenom(), types.ExtendedCoinDenom()),
+				evmtypes.GetEVMCoinExtendedDenom(), evmtypes.GetEVMCoinExtendedDenom()),
 		},
 		{
 			"passthrough - unrelated",
@@ -330,59 +330,59 @@ func (s *KeeperIntegrationTestSuite) TestSendCoins() {
 		},
 		{
 			"passthrough - integer denom",
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			cs(),
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"",
 		},
 		{
 			"passthrough & extended",
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			cs(),
-			cs(c(types.IntegerCoinDenom(), 10), c(types.ExtendedCoinDenom(), 1)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 10), c(evmtypes.GetEVMCoinExtendedDenom(), 1)),
 			"",
 		},
 		{
 			"aatom send - 1aatom to 0 balance",
 			// Starting balances
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(5))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(5))),
 			cs(),
 			// Send amount
-			cs(c(types.ExtendedCoinDenom(), 1)), // aatom
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1)), // aatom
 			"",
 		},
 		{
 			"sender borrow from integer",
 			// 1uatom, 0 fractional
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor())),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor())),
 			cs(),
 			// Send 1 with 0 fractional balance
-			cs(c(types.ExtendedCoinDenom(), 1)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1)),
 			"",
 		},
 		{
 			"sender borrow from integer - max fractional amount",
 			// 1uatom, 0 fractional
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor())),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor())),
 			cs(),
 			// Max fractional amount
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(1))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(1))),
 			"",
 		},
 		{
 			"receiver carry",
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			// max fractional amount, carries over to integer
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(1))),
-			cs(c(types.ExtendedCoinDenom(), 1)),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(1))),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1)),
 			"",
 		},
 		{
 			"receiver carry - max fractional amount",
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(5))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(5))),
 			// max fractional amount, carries over to integer
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(1))),
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(1))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(1))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(1))),
 			"",
 		},
 	}
@@ -418,14 +418,14 @@ func (s *KeeperIntegrationTestSuite) TestSendCoins() {
 			// includes uatom, convert it so that its the equivalent aatom
 			// amount so its easier to compare. Compare extended coins only.
 			sendAmountFullExtended := tt.giveAmt
-			sendAmountInteger := tt.giveAmt.AmountOf(types.IntegerCoinDenom())
+			sendAmountInteger := tt.giveAmt.AmountOf(evmtypes.GetEVMCoinDenom())
 			if !sendAmountInteger.IsZero() {
-				integerCoin := sdk.NewCoin(types.IntegerCoinDenom(), sendAmountInteger)
+				integerCoin := sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sendAmountInteger)
 				sendAmountFullExtended = sendAmountFullExtended.Sub(integerCoin)
 
 				// Add equivalent extended coin
 				extendedCoinAmount := sendAmountInteger.Mul(types.ConversionFactor())
-				extendedCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedCoinAmount)
+				extendedCoin := sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), extendedCoinAmount)
 				sendAmountFullExtended = sendAmountFullExtended.Add(extendedCoin)
 			}
 
@@ -443,8 +443,8 @@ func (s *KeeperIntegrationTestSuite) TestSendCoins() {
 
 			// FULL aatom equivalent, including uatom only/mixed sends
 			sendExtendedAmount := sdk.NewCoin(
-				types.ExtendedCoinDenom(),
-				sendAmountFullExtended.AmountOf(types.ExtendedCoinDenom()),
+				evmtypes.GetEVMCoinExtendedDenom(),
+				sendAmountFullExtended.AmountOf(evmtypes.GetEVMCoinExtendedDenom()),
 			)
 			extCoins := sdk.NewCoins(sendExtendedAmount)
 
@@ -493,11 +493,11 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsMatrix() {
 	// Test matrix fields:
 	startBalances := []startBalance{
 		{"empty", cs()},
-		{"integer only", cs(c(types.IntegerCoinDenom(), 1000))},
-		{"extended only", cs(c(types.ExtendedCoinDenom(), 1000))},
-		{"integer & extended", cs(c(types.IntegerCoinDenom(), 1000), c(types.ExtendedCoinDenom(), 1000))},
-		{"integer & extended - max fractional", cs(c(types.IntegerCoinDenom(), 1000), ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(1)))},
-		{"integer & extended - min fractional", cs(c(types.IntegerCoinDenom(), 1000), c(types.ExtendedCoinDenom(), 1))},
+		{"integer only", cs(c(evmtypes.GetEVMCoinDenom(), 1000))},
+		{"extended only", cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000))},
+		{"integer & extended", cs(c(evmtypes.GetEVMCoinDenom(), 1000), c(evmtypes.GetEVMCoinExtendedDenom(), 1000))},
+		{"integer & extended - max fractional", cs(c(evmtypes.GetEVMCoinDenom(), 1000), ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(1)))},
+		{"integer & extended - min fractional", cs(c(evmtypes.GetEVMCoinDenom(), 1000), c(evmtypes.GetEVMCoinExtendedDenom(), 1))},
 	}
 
 	sendAmts := []struct {
@@ -510,23 +510,23 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsMatrix() {
 		},
 		{
 			"integer only",
-			cs(c(types.IntegerCoinDenom(), 10)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 10)),
 		},
 		{
 			"extended only",
-			cs(c(types.ExtendedCoinDenom(), 10)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 10)),
 		},
 		{
 			"integer & extended",
-			cs(c(types.IntegerCoinDenom(), 10), c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 10), c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 		},
 		{
 			"integer & extended - max fractional",
-			cs(c(types.IntegerCoinDenom(), 10), ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(1))),
+			cs(c(evmtypes.GetEVMCoinDenom(), 10), ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(1))),
 		},
 		{
 			"integer & extended - min fractional",
-			cs(c(types.IntegerCoinDenom(), 10), c(types.ExtendedCoinDenom(), 1)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 10), c(evmtypes.GetEVMCoinExtendedDenom(), 1)),
 		},
 	}
 
@@ -601,7 +601,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsFromAccountToModule() {
 	recipientModule := minttypes.ModuleName
 	recipientAddr := s.network.App.GetAccountKeeper().GetModuleAddress(recipientModule)
 
-	sendAmt := cs(c(types.ExtendedCoinDenom(), 1000))
+	sendAmt := cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000))
 
 	s.MintToAccount(sender, sendAmt)
 
@@ -634,8 +634,8 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsFromAccountToModuleBlockedReci
 
 	sender := sdk.AccAddress([]byte{1})
 
-	sendAmt := cs(c(types.ExtendedCoinDenom(), 1000))
-	sendAmt2 := cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(10)))
+	sendAmt := cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000))
+	sendAmt2 := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(10)))
 
 	s.MintToAccount(sender, sendAmt.Add(sendAmt2...))
 
@@ -662,8 +662,8 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsBlockedRecipientCarry() {
 	// which also should not fail when sending to a blocked module account.
 	sender := sdk.AccAddress([]byte{1})
 
-	sendAmt := cs(c(types.ExtendedCoinDenom(), 1000))
-	sendAmt2 := cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(10)))
+	sendAmt := cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000))
+	sendAmt2 := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(10)))
 
 	s.MintToAccount(sender, sendAmt.Add(sendAmt2...))
 
@@ -697,7 +697,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsFromModuleToAccount() {
 
 	recipient := sdk.AccAddress([]byte{1})
 
-	sendAmt := cs(c(types.ExtendedCoinDenom(), 1000))
+	sendAmt := cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000))
 
 	s.MintToModuleAccount(senderModule, sendAmt)
 
@@ -752,7 +752,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsRandomValueMultiDecimals() {
 
 			// Initial balance large enough to cover many small sends
 			initialBalance := types.ConversionFactor().MulRaw(100)
-			s.MintToAccount(sender, cs(ci(types.ExtendedCoinDenom(), initialBalance)))
+			s.MintToAccount(sender, cs(ci(evmtypes.GetEVMCoinExtendedDenom(), initialBalance)))
 
 			// Setup test parameters
 			maxSendUnit := types.ConversionFactor().MulRaw(2).SubRaw(1)
@@ -764,7 +764,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsRandomValueMultiDecimals() {
 			// Continue transfers as long as sender has balance remaining
 			for {
 				// Check current sender balance
-				senderAmount := s.GetAllBalances(sender).AmountOf(types.ExtendedCoinDenom())
+				senderAmount := s.GetAllBalances(sender).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 				if senderAmount.IsZero() {
 					break
 				}
@@ -776,7 +776,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsRandomValueMultiDecimals() {
 				}
 				randAmount := sdkmath.NewIntFromBigInt(new(big.Int).Rand(r, maxPossibleSend.BigInt())).AddRaw(1)
 
-				sendAmount := cs(ci(types.ExtendedCoinDenom(), randAmount))
+				sendAmount := cs(ci(evmtypes.GetEVMCoinExtendedDenom(), randAmount))
 				err := s.network.App.GetPreciseBankKeeper().SendCoins(s.network.GetContext(), sender, recipient, sendAmount)
 				s.NoError(err)
 				totalSent = totalSent.Add(randAmount)
@@ -786,12 +786,12 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsRandomValueMultiDecimals() {
 			s.T().Logf("Completed %d random sends, total sent: %s", sentCount, totalSent.String())
 
 			// Check sender balance
-			senderAmount := s.GetAllBalances(sender).AmountOf(types.ExtendedCoinDenom())
+			senderAmount := s.GetAllBalances(sender).AmountOf(evmtypes.GetEVMCoinExtendedDenom())
 			s.Equal(senderAmount.BigInt().Cmp(big.NewInt(0)), 0, "sender balance should be zero")
 
 			// Check recipient balance
 			recipientBal := s.GetAllBalances(recipient)
-			intReceived := recipientBal.AmountOf(types.ExtendedCoinDenom()).Quo(types.ConversionFactor())
+			intReceived := recipientBal.AmountOf(evmtypes.GetEVMCoinExtendedDenom()).Quo(types.ConversionFactor())
 			fracReceived := s.network.App.GetPreciseBankKeeper().GetFractionalBalance(s.network.GetContext(), recipient)
 
 			expectedInt := totalSent.Quo(types.ConversionFactor())
@@ -835,11 +835,11 @@ func FuzzSendCoins(f *testing.F) {
 		recipient := sdk.AccAddress([]byte{2})
 
 		// Initial balances
-		suite.MintToAccount(sender, cs(c(types.ExtendedCoinDenom(), int64(startBalSender))))      //nolint:gosec // G115
-		suite.MintToAccount(recipient, cs(c(types.ExtendedCoinDenom(), int64(startBalReceiver)))) //nolint:gosec // G115
+		suite.MintToAccount(sender, cs(c(evmtypes.GetEVMCoinExtendedDenom(), int64(startBalSender))))      //nolint:gosec // G115
+		suite.MintToAccount(recipient, cs(c(evmtypes.GetEVMCoinExtendedDenom(), int64(startBalReceiver)))) //nolint:gosec // G115
 
 		// Send amount
-		sendCoins := cs(c(types.ExtendedCoinDenom(), int64(sendAmount))) //nolint:gosec // G115
+		sendCoins := cs(c(evmtypes.GetEVMCoinExtendedDenom(), int64(sendAmount))) //nolint:gosec // G115
 		err := suite.network.App.GetPreciseBankKeeper().SendCoins(suite.network.GetContext(), sender, recipient, sendCoins)
 		if startBalSender < sendAmount {
 			suite.Require().Error(err, "expected insufficient funds error")
@@ -854,11 +854,11 @@ func FuzzSendCoins(f *testing.F) {
 
 		suite.Require().Equal(
 			startBalSender-sendAmount,
-			balSender.AmountOf(types.ExtendedCoinDenom()).Uint64(),
+			balSender.AmountOf(evmtypes.GetEVMCoinExtendedDenom()).Uint64(),
 		)
 		suite.Require().Equal(
 			startBalReceiver+sendAmount,
-			balReceiver.AmountOf(types.ExtendedCoinDenom()).Uint64(),
+			balReceiver.AmountOf(evmtypes.GetEVMCoinExtendedDenom()).Uint64(),
 		)
 	})
 }
@@ -2,6 +2,7 @@ package precisebank
 
 import (
 	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -61,13 +62,13 @@ func (s *KeeperIntegrationTestSuite) GetAllBalances(addr sdk.AccAddress) sdk.Coi
 
 	// Remove integer coins from the balance
 	for _, coin := range bankBalances {
-		if coin.Denom == types.IntegerCoinDenom() {
+		if coin.Denom == evmtypes.GetEVMCoinDenom() {
 			bankBalances = bankBalances.Sub(coin)
 		}
 	}
 
 	// Replace the integer coin with the extended coin, from x/precisebank
-	extendedBal := s.network.App.GetPreciseBankKeeper().GetBalance(s.network.GetContext(), addr, types.ExtendedCoinDenom())
+	extendedBal := s.network.App.GetPreciseBankKeeper().GetBalance(s.network.GetContext(), addr, evmtypes.GetEVMCoinExtendedDenom())
 
 	return bankBalances.Add(extendedBal)
 }
@@ -77,16 +78,16 @@ func (s *KeeperIntegrationTestSuite) GetAllBalances(addr sdk.AccAddress) sdk.Coi
 // for testing to make sure only extended amounts are compared instead of double
 // counting balances.
 func ConvertCoinsToExtendedCoinDenom(coins sdk.Coins) sdk.Coins {
-	integerCoinAmt := coins.AmountOf(types.IntegerCoinDenom())
+	integerCoinAmt := coins.AmountOf(evmtypes.GetEVMCoinDenom())
 	if integerCoinAmt.IsZero() {
 		return coins
 	}
 
 	// Remove the integer coin from the coins
-	integerCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerCoinAmt)
+	integerCoin := sdk.NewCoin(evmtypes.GetEVMCoinDenom(), integerCoinAmt)
 
 	// Add the equivalent extended coin to the coins
-	extendedCoin := sdk.NewCoin(types.ExtendedCoinDenom(), integerCoinAmt.Mul(types.ConversionFactor()))
+	extendedCoin := sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), integerCoinAmt.Mul(types.ConversionFactor()))
 
 	return coins.Sub(integerCoin).Add(extendedCoin)
 }
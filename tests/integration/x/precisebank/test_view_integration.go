@@ -2,6 +2,7 @@ package precisebank
 
 import (
 	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -23,46 +24,46 @@ func (s *KeeperIntegrationTestSuite) TestKeeperSpendableCoin() {
 	}{
 		{
 			"extended denom, no fractional - locked coins",
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 			// queried bank balance in uatom when querying for aatom
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.ZeroInt(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(10))),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(10))),
 			// (integer + fractional) - locked
 			sdk.NewCoin(
-				types.ExtendedCoinDenom(),
+				evmtypes.GetEVMCoinExtendedDenom(),
 				types.ConversionFactor().MulRaw(1000-10),
 			),
 		},
 		{
 			"extended denom, with fractional - locked coins",
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 			// queried bank balance in uatom when querying for aatom
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.NewInt(5000),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(10))),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(10))),
 			sdk.NewCoin(
-				types.ExtendedCoinDenom(),
+				evmtypes.GetEVMCoinExtendedDenom(),
 				// (integer - locked) + fractional
 				types.ConversionFactor().MulRaw(1000-10).AddRaw(5000),
 			),
 		},
 		{
 			"non-extended denom - uatom returns uatom",
-			types.IntegerCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.ZeroInt(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(10))),
-			sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(990)),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(10))),
+			sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(990)),
 		},
 		{
 			"non-extended denom, with fractional - uatom returns uatom",
-			types.IntegerCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			// does not affect balance
 			sdkmath.NewInt(100),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(10))),
-			sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(990)),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(10))),
+			sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(990)),
 		},
 	}
 
@@ -126,7 +127,7 @@ func (s *KeeperIntegrationTestSuite) TestKeeperHiddenReserve() {
 	// Make the reserve hold a non-zero balance
 	// Mint fractional coins to an account, which should cause a mint of 1
 	// integer coin to the reserve to back it.
-	extCoin := sdk.NewCoin(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(1000))
+	extCoin := sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().AddRaw(1000))
 	unrelatedCoin := sdk.NewCoin("unrelated", sdkmath.NewInt(1000))
 	s.MintToAccount(
 		addr1,
@@ -137,7 +138,7 @@ func (s *KeeperIntegrationTestSuite) TestKeeperHiddenReserve() {
 	)
 
 	// Check underlying x/bank balance for reserve
-	reserveIntCoin := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), moduleAddr, types.IntegerCoinDenom())
+	reserveIntCoin := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), moduleAddr, evmtypes.GetEVMCoinDenom())
 	s.Require().Equal(
 		sdkmath.NewInt(1),
 		reserveIntCoin.Amount,
@@ -153,25 +154,25 @@ func (s *KeeperIntegrationTestSuite) TestKeeperHiddenReserve() {
 		{
 			"reserve account - hidden extended denom",
 			moduleAddr,
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 			sdkmath.ZeroInt(),
 		},
 		{
 			"reserve account - visible integer denom",
 			moduleAddr,
-			types.IntegerCoinDenom(),
+			evmtypes.GetEVMCoinDenom(),
 			sdkmath.OneInt(),
 		},
 		{
 			"user account - visible extended denom",
 			addr1,
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 			extCoin.Amount,
 		},
 		{
 			"user account - visible integer denom",
 			addr1,
-			types.IntegerCoinDenom(),
+			evmtypes.GetEVMCoinDenom(),
 			extCoin.Amount.Quo(types.ConversionFactor()),
 		},
 	}
@@ -116,4 +116,11 @@ func (s *KeeperTestSuite) SetupTest() {
 		}).
 		Configure()
 	s.Require().NoError(err)
+
+	// the keeper keeps the chain config of the app set on startup, so the
+	// disabled hard forks must be stored in state to take effect
+	if !s.EnableLondonHF {
+		err = s.Network.App.GetEVMKeeper().SetChainConfig(s.Network.GetContext(), *chainConfig)
+		s.Require().NoError(err)
+	}
 }
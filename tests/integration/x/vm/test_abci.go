@@ -1,9 +1,23 @@
 package vm
 
 import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (s *KeeperTestSuite) TestEndBlock() {
@@ -24,3 +38,110 @@ func (s *KeeperTestSuite) TestEndBlock() {
 	s.Require().Equal(1, len(postEventManager.Events()))
 	s.Require().Equal(evmtypes.EventTypeBlockBloom, postEventManager.Events()[0].Type)
 }
+
+func (s *KeeperTestSuite) TestEndBlockUnbondingCallbacks() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	ctx := unitNetwork.GetContext()
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+
+	// stores the amount argument of onUnbondingCompleted in the first slot
+	recorder := utiltx.GenerateAddress()
+	recorderCode := []byte{0x60, 0x24, 0x35, 0x60, 0x00, 0x55, 0x00}
+	// reverts every call
+	reverter := utiltx.GenerateAddress()
+	reverterCode := []byte{0x60, 0x00, 0x80, 0xfd}
+	unregistered := utiltx.GenerateAddress()
+
+	for addr, code := range map[common.Address][]byte{recorder: recorderCode, reverter: reverterCode, unregistered: recorderCode} {
+		codeHash := crypto.Keccak256Hash(code)
+		evmKeeper.SetCode(ctx, codeHash.Bytes(), code)
+		account := statedb.NewEmptyAccount()
+		account.CodeHash = codeHash.Bytes()
+		s.Require().NoError(evmKeeper.SetAccount(ctx, addr, *account))
+	}
+	evmKeeper.SetUnbondingCallback(ctx, recorder, true)
+	evmKeeper.SetUnbondingCallback(ctx, reverter, true)
+	s.Require().ElementsMatch([]common.Address{recorder, reverter}, evmKeeper.GetUnbondingCallbacks(ctx))
+
+	validator := unitNetwork.GetValidators()[0].OperatorAddress
+	for _, delegator := range []common.Address{recorder, reverter, unregistered} {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			stakingtypes.EventTypeCompleteUnbonding,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "1000"+unitNetwork.GetBaseDenom()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, validator),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, sdk.AccAddress(delegator.Bytes()).String()),
+		))
+	}
+
+	err := evmKeeper.EndBlock(ctx)
+	s.Require().NoError(err)
+
+	s.Require().Equal(common.BigToHash(big.NewInt(1000)), evmKeeper.GetState(ctx, recorder, common.Hash{}))
+	s.Require().Equal(common.Hash{}, evmKeeper.GetState(ctx, unregistered, common.Hash{}))
+
+	var callbackEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == evmtypes.EventTypeUnbondingCallback {
+			callbackEvents = append(callbackEvents, event)
+		}
+	}
+	s.Require().Len(callbackEvents, 2)
+
+	_, failed := callbackEvents[0].GetAttribute(evmtypes.AttributeKeyError)
+	s.Require().False(failed)
+	attr, failed := callbackEvents[1].GetAttribute(evmtypes.AttributeKeyError)
+	s.Require().True(failed)
+	s.Require().Contains(attr.Value, evmtypes.ErrUnbondingCallbackFailed.Error())
+}
+
+func (s *KeeperTestSuite) TestEndBlockUnbondingCallbacksLimit() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	ctx := unitNetwork.GetContext()
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+
+	// increments the first slot on each call
+	counter := utiltx.GenerateAddress()
+	counterCode := []byte{0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x60, 0x00, 0x55, 0x00}
+	codeHash := crypto.Keccak256Hash(counterCode)
+	evmKeeper.SetCode(ctx, codeHash.Bytes(), counterCode)
+	account := statedb.NewEmptyAccount()
+	account.CodeHash = codeHash.Bytes()
+	s.Require().NoError(evmKeeper.SetAccount(ctx, counter, *account))
+	evmKeeper.SetUnbondingCallback(ctx, counter, true)
+
+	validator := unitNetwork.GetValidators()[0].OperatorAddress
+	total := keeper.MaxUnbondingCallbacksPerBlock + 5
+	for range total {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			stakingtypes.EventTypeCompleteUnbonding,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "1000"+unitNetwork.GetBaseDenom()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, validator),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, sdk.AccAddress(counter.Bytes()).String()),
+		))
+	}
+
+	// the callbacks of a block can use twice the gas limit of a call
+	budget := 2 * keeper.UnbondingCallbackGasLimit
+	ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(budget * keeper.UnbondingCallbacksGasDivisor))
+	s.Require().NoError(evmKeeper.EndBlock(ctx))
+	called := evmKeeper.GetState(ctx, counter, common.Hash{}).Big().Int64()
+	s.Require().Greater(called, int64(1))
+	s.Require().LessOrEqual(called, int64(budget/ethparams.TxGas))
+	s.Require().Len(evmKeeper.GetPendingUnbondingCallbacks(ctx), total-int(called))
+
+	// the deferred callbacks are called in the next blocks
+	for i := 0; i < total && len(evmKeeper.GetPendingUnbondingCallbacks(ctx)) > 0; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(evmKeeper.EndBlock(ctx))
+	}
+	s.Require().Equal(common.BigToHash(big.NewInt(int64(total))), evmKeeper.GetState(ctx, counter, common.Hash{}))
+	s.Require().Empty(evmKeeper.GetPendingUnbondingCallbacks(ctx))
+}
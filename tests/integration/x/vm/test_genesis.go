@@ -16,6 +16,8 @@ import (
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -110,6 +112,27 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 			},
 			expPanic: false,
 		},
+		{
+			name:     "valid unbonding callbacks",
+			malleate: func(_ *network.UnitTestNetwork) {},
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				UnbondingCallbacks: []string{address.Hex()},
+			},
+			expPanic: false,
+		},
+		{
+			name:     "valid pending unbonding callbacks",
+			malleate: func(_ *network.UnitTestNetwork) {},
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				UnbondingCallbacks: []string{address.Hex()},
+				PendingUnbondingCallbacks: []types.PendingUnbondingCallback{
+					{ContractAddress: address.Hex(), ValidatorAddress: "cosmosvaloper1", Amount: sdkmath.NewInt(1000)},
+				},
+			},
+			expPanic: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -172,6 +195,11 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 					}
 				}
 
+				for _, contract := range tc.genState.UnbondingCallbacks {
+					s.Require().True(s.network.App.GetEVMKeeper().HasUnbondingCallback(ctx, common.HexToAddress(contract)))
+				}
+				s.Require().Equal(len(tc.genState.PendingUnbondingCallbacks), len(s.network.App.GetEVMKeeper().GetPendingUnbondingCallbacks(ctx)))
+
 				// verify preinstalls
 				for _, preinstall := range tc.genState.Preinstalls {
 					preinstallAddr := common.HexToAddress(preinstall.Address)
@@ -220,9 +248,11 @@ func (s *GenesisTestSuite) TestExportGenesis() {
 	s.Require().NoError(err)
 	s.Require().NoError(s.network.NextBlock())
 
+	s.network.App.GetEVMKeeper().SetUnbondingCallback(s.network.GetContext(), contractAddr, true)
+
 	genState := vm.ExportGenesis(s.network.GetContext(), s.network.App.GetEVMKeeper())
-	// Exported accounts 4 default preinstalls
-	s.Require().Len(genState.Accounts, 7)
+	// Exported accounts 5 default preinstalls
+	s.Require().Len(genState.Accounts, 8)
 
 	addrs := make([]string, len(genState.Accounts))
 	for i, acct := range genState.Accounts {
@@ -231,6 +261,8 @@ func (s *GenesisTestSuite) TestExportGenesis() {
 	s.Require().Contains(addrs, contractAddr.Hex())
 	s.Require().Contains(addrs, contractAddr2.Hex())
 
+	s.Require().Equal([]string{contractAddr.Hex()}, genState.UnbondingCallbacks)
+
 	// Since preinstalls gets exported as normal contracts, it should be empty on export genesis
 	s.Require().Empty(genState.Preinstalls)
 }
@@ -1,10 +1,12 @@
 package vm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -13,21 +15,29 @@ import (
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/precompiles/testutil/contracts"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
+	basefactory "github.com/cosmos/evm/testutil/integration/base/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/testutil/integration/evm/utils"
 	"github.com/cosmos/evm/testutil/keyring"
 	testutiltypes "github.com/cosmos/evm/testutil/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/keeper/testdata"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Not valid Ethereum address
@@ -1248,6 +1258,154 @@ func (s *KeeperTestSuite) TestTraceTx() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceTxPrecompileTracer() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	stakingABI, err := staking.LoadABI()
+	s.Require().NoError(err)
+
+	senderKey := s.Keyring.GetKey(0)
+	stakingAddr := common.HexToAddress(types.StakingPrecompileAddress)
+	valAddr := s.Network.GetValidators()[0].OperatorAddress
+	amount := big.NewInt(1e18)
+
+	input, err := factory.GenerateContractCallArgs(testutiltypes.CallArgs{
+		ContractABI: stakingABI,
+		MethodName:  staking.DelegateMethod,
+		Args:        []interface{}{senderKey.Addr, valAddr, amount},
+	})
+	s.Require().NoError(err)
+
+	signedTx, err := s.Factory.GenerateSignedEthTx(senderKey.Priv, types.EvmTxArgs{
+		To:    &stakingAddr,
+		Input: input,
+	})
+	s.Require().NoError(err)
+	msgToTrace, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+	s.Require().True(ok)
+
+	res, err := s.Network.GetEvmClient().TraceTx(s.Network.GetContext(), &types.QueryTraceTxRequest{
+		Msg:         msgToTrace,
+		TraceConfig: &types.TraceConfig{Tracer: keeper.PrecompileTracerName},
+		BlockNumber: s.Network.GetContext().BlockHeight(),
+		ChainId:     s.Network.GetEIP155ChainID().Int64(),
+		BlockMaxGas: s.Network.GetContext().ConsensusParams().Block.MaxGas,
+	})
+	s.Require().NoError(err)
+
+	var result struct {
+		Type   string `json:"type"`
+		To     string `json:"to"`
+		Error  string `json:"error"`
+		Cosmos struct {
+			Method string                 `json:"method"`
+			Args   map[string]interface{} `json:"args"`
+			Events []struct {
+				Type string `json:"type"`
+			} `json:"events"`
+			BalanceDeltas map[string]map[string]string `json:"balanceDeltas"`
+		} `json:"cosmos"`
+	}
+	s.Require().NoError(json.Unmarshal(res.Data, &result))
+
+	// the callTracer fields are kept
+	s.Require().Equal("CALL", result.Type)
+	s.Require().Equal(strings.ToLower(types.StakingPrecompileAddress), result.To)
+	s.Require().Empty(result.Error)
+
+	// the precompile call is decoded
+	s.Require().Equal(staking.DelegateMethod, result.Cosmos.Method)
+	s.Require().Equal(valAddr, result.Cosmos.Args["validatorAddress"])
+
+	// the Cosmos side effects are recorded
+	eventTypes := make([]string, 0, len(result.Cosmos.Events))
+	for _, event := range result.Cosmos.Events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	s.Require().Contains(eventTypes, stakingtypes.EventTypeDelegate)
+
+	bondDenom, err := s.Network.App.GetStakingKeeper().BondDenom(s.Network.GetContext())
+	s.Require().NoError(err)
+	s.Require().Equal(new(big.Int).Neg(amount).String(), result.Cosmos.BalanceDeltas[senderKey.Addr.Hex()][bondDenom])
+}
+
+func (s *KeeperTestSuite) TestTraceTxPrecompileTracerNestedRevert() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	senderKey := s.Keyring.GetKey(0)
+	valAddr := s.Network.GetValidators()[0].OperatorAddress
+
+	reverterContract, err := contracts.LoadStakingReverterContract()
+	s.Require().NoError(err)
+	reverterAddr, err := s.Factory.DeployContract(senderKey.Priv, types.EvmTxArgs{}, testutiltypes.ContractDeploymentData{
+		Contract: reverterContract,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the contract delegates out of its own balance
+	err = utils.FundAccountWithBaseDenom(s.Factory, s.Network, senderKey, reverterAddr.Bytes(), sdkmath.NewInt(1e18))
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	input, err := factory.GenerateContractCallArgs(testutiltypes.CallArgs{
+		ContractABI: reverterContract.ABI,
+		MethodName:  "callPrecompileBeforeAndAfterRevert",
+		Args:        []interface{}{big.NewInt(1), valAddr},
+	})
+	s.Require().NoError(err)
+
+	signedTx, err := s.Factory.GenerateSignedEthTx(senderKey.Priv, types.EvmTxArgs{
+		To:       &reverterAddr,
+		Input:    input,
+		GasLimit: 1_000_000,
+	})
+	s.Require().NoError(err)
+	msgToTrace, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+	s.Require().True(ok)
+
+	res, err := s.Network.GetEvmClient().TraceTx(s.Network.GetContext(), &types.QueryTraceTxRequest{
+		Msg:         msgToTrace,
+		TraceConfig: &types.TraceConfig{Tracer: keeper.PrecompileTracerName},
+		BlockNumber: s.Network.GetContext().BlockHeight(),
+		ChainId:     s.Network.GetEIP155ChainID().Int64(),
+		BlockMaxGas: s.Network.GetContext().ConsensusParams().Block.MaxGas,
+	})
+	s.Require().NoError(err)
+
+	type callFrame struct {
+		To     string          `json:"to"`
+		Error  string          `json:"error"`
+		Cosmos json.RawMessage `json:"cosmos"`
+		Calls  []callFrame     `json:"calls"`
+	}
+	var result callFrame
+	s.Require().NoError(json.Unmarshal(res.Data, &result))
+	s.Require().Empty(result.Error)
+
+	// the contract delegates, calls itself to delegate and revert, and
+	// delegates again
+	stakingAddr := strings.ToLower(types.StakingPrecompileAddress)
+	s.Require().Len(result.Calls, 3)
+	s.Require().Equal(stakingAddr, result.Calls[0].To)
+	s.Require().NotEmpty(result.Calls[0].Cosmos)
+	s.Require().Equal(stakingAddr, result.Calls[2].To)
+	s.Require().NotEmpty(result.Calls[2].Cosmos)
+
+	// the delegation nested in the reverted call succeeded, but its side
+	// effects are discarded along with the ones of its parent
+	reverted := result.Calls[1]
+	s.Require().NotEmpty(reverted.Error)
+	s.Require().Len(reverted.Calls, 1)
+	s.Require().Equal(stakingAddr, reverted.Calls[0].To)
+	s.Require().Empty(reverted.Calls[0].Error)
+	s.Require().Empty(reverted.Calls[0].Cosmos)
+}
+
 func (s *KeeperTestSuite) TestTraceBlock() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -1425,6 +1583,130 @@ func (s *KeeperTestSuite) TestTraceBlock() {
 	}
 }
 
+func (s *KeeperTestSuite) TestIntermediateRoots() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	senderKey := s.Keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, s.Factory)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the second account pays the fees of the sender, the recipient of the
+	// transfers didn't grant it any allowance
+	recipient := common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101")
+	granterKey := s.Keyring.GetKey(1)
+	allowance := &feegrant.BasicAllowance{}
+	msgGrant, err := feegrant.NewMsgGrantAllowance(allowance, granterKey.AccAddr, senderKey.AccAddr)
+	s.Require().NoError(err)
+	grantRes, err := s.Factory.ExecuteCosmosTx(granterKey.Priv, basefactory.CosmosTxArgs{Msgs: []sdk.Msg{msgGrant}})
+	s.Require().NoError(err)
+	s.Require().True(grantRes.IsOK(), grantRes.Log)
+	s.Require().NoError(s.Network.NextBlock())
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+	input, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+	s.Require().NoError(err)
+
+	// the transfers are signed but not broadcasted, so that they are valid
+	// on top of the current state
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), senderKey.Addr)
+	transferMsg := func(nonce uint64) *types.MsgEthereumTx {
+		msg, err := s.Factory.GenerateSignedMsgEthereumTx(senderKey.Priv, types.EvmTxArgs{
+			To:       &contractAddr,
+			Input:    input,
+			Nonce:    nonce,
+			GasLimit: 100_000,
+		})
+		s.Require().NoError(err)
+		return &msg
+	}
+
+	testCases := []struct {
+		msg       string
+		txs       []*types.MsgEthereumTx
+		feePayers []string
+		expErrors []string
+	}{
+		{
+			"empty block",
+			nil,
+			nil,
+			[]string{},
+		},
+		{
+			"single transaction",
+			[]*types.MsgEthereumTx{transferMsg(nonce)},
+			nil,
+			[]string{""},
+		},
+		{
+			"multiple transactions",
+			[]*types.MsgEthereumTx{transferMsg(nonce), transferMsg(nonce + 1)},
+			nil,
+			[]string{"", ""},
+		},
+		{
+			"failed transaction is recorded",
+			[]*types.MsgEthereumTx{transferMsg(nonce), transferMsg(nonce), transferMsg(nonce + 1)},
+			nil,
+			[]string{"", "invalid nonce", ""},
+		},
+		{
+			"sponsored transaction",
+			[]*types.MsgEthereumTx{transferMsg(nonce), transferMsg(nonce + 1)},
+			[]string{granterKey.AccAddr.String(), ""},
+			[]string{"", ""},
+		},
+		{
+			"sponsored transaction without allowance is recorded",
+			[]*types.MsgEthereumTx{transferMsg(nonce)},
+			[]string{sdk.AccAddress(recipient.Bytes()).String()},
+			[]string{"does not allow to pay fees"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			defaultReq := getDefaultTraceBlockRequest(s.Network)
+			req := types.QueryIntermediateRootsRequest{
+				Txs:         tc.txs,
+				BlockNumber: s.Network.GetContext().BlockHeight(),
+				BlockMaxGas: defaultReq.BlockMaxGas,
+				ChainId:     defaultReq.ChainId,
+				BlockTime:   defaultReq.BlockTime,
+				FeePayers:   tc.feePayers,
+			}
+
+			res, err := s.Network.GetEvmClient().IntermediateRoots(s.Network.GetContext(), &req)
+			s.Require().NoError(err)
+			s.Require().Len(res.Roots, len(tc.txs))
+			s.Require().Len(res.Errors, len(tc.expErrors))
+			for i, root := range res.Roots {
+				s.Require().NotEqual(common.Hash{}, common.BytesToHash(root))
+				if tc.expErrors[i] == "" {
+					s.Require().Empty(res.Errors[i])
+				} else {
+					s.Require().Contains(res.Errors[i], tc.expErrors[i])
+				}
+			}
+
+			// re-executing the same transactions yields the same roots
+			resAgain, err := s.Network.GetEvmClient().IntermediateRoots(s.Network.GetContext(), &req)
+			s.Require().NoError(err)
+			s.Require().Equal(res.Roots, resAgain.Roots)
+
+			// each transfer updates the balances of the token contract, and the
+			// nonce and balance of the sender
+			if len(res.Roots) > 1 {
+				s.Require().NotEqual(res.Roots[0], res.Roots[1])
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestNonceInQuery() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -1532,6 +1814,7 @@ func (s *KeeperTestSuite) TestQueryBaseFee() {
 					WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]).
 					Configure()
 				s.Require().NoError(err)
+				s.Require().NoError(s.Network.App.GetEVMKeeper().SetChainConfig(s.Network.GetContext(), *chainConfig))
 			},
 			true,
 		},
@@ -1587,6 +1870,7 @@ func (s *KeeperTestSuite) TestQueryBaseFee() {
 				WithEVMCoinInfo(coinInfo).
 				Configure()
 			s.Require().NoError(err)
+			s.Require().NoError(s.Network.App.GetEVMKeeper().SetChainConfig(s.Network.GetContext(), *chainConfig))
 		})
 	}
 }
@@ -1700,6 +1984,548 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestEthCallWithStateOverrides() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	slot := common.Hash{}
+	value := common.BigToHash(big.NewInt(42))
+	committed := common.BigToHash(big.NewInt(7))
+	s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), contract, slot, committed.Bytes())
+
+	// PUSH1 0x00 SLOAD PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	// SELFBALANCE PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	balanceCode := hexutil.Bytes(common.FromHex("0x4760005260206000f3"))
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expPass   bool
+		expRet    common.Hash
+	}{
+		{
+			"pass - override code and state diff",
+			types.StateOverride{
+				contract: {
+					Code:      &sloadCode,
+					StateDiff: &map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			true,
+			value,
+		},
+		{
+			"pass - override code and full state",
+			types.StateOverride{
+				contract: {
+					Code:  &sloadCode,
+					State: &map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			true,
+			value,
+		},
+		{
+			"pass - full state replaces the committed storage",
+			types.StateOverride{
+				contract: {
+					Code:  &sloadCode,
+					State: &map[common.Hash]common.Hash{common.BigToHash(big.NewInt(1)): value},
+				},
+			},
+			true,
+			common.Hash{},
+		},
+		{
+			"pass - override code and balance",
+			types.StateOverride{
+				contract: {
+					Code:    &balanceCode,
+					Balance: &balance,
+				},
+			},
+			true,
+			common.BigToHash(balance.ToInt()),
+		},
+		{
+			"fail - both state and state diff",
+			types.StateOverride{
+				contract: {
+					Code:      &sloadCode,
+					State:     &map[common.Hash]common.Hash{slot: value},
+					StateDiff: &map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			false,
+			common.Hash{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+			s.Require().NoError(err)
+			overrides, err := json.Marshal(tc.overrides)
+			s.Require().NoError(err)
+
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides}
+			res, err := s.Network.GetEvmClient().EthCall(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Empty(res.VmError)
+			s.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+
+			gasRes, err := s.Network.GetEvmClient().EstimateGas(s.Network.GetContext(), req)
+			s.Require().NoError(err)
+			s.Require().Greater(gasRes.Gas, ethparams.TxGas)
+
+			// overrides must not be persisted
+			code := s.Network.App.GetEVMKeeper().GetCode(s.Network.GetContext(), common.BytesToHash(crypto.Keccak256(sloadCode)))
+			s.Require().Empty(code)
+			s.Require().Equal(committed, s.Network.App.GetEVMKeeper().GetState(s.Network.GetContext(), contract, slot))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreateAccessList() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	// PUSH20 <other> BALANCE POP STOP
+	balanceCode := hexutil.Bytes(append(append([]byte{byte(vm.PUSH20)}, other.Bytes()...), byte(vm.BALANCE), byte(vm.POP), byte(vm.STOP)))
+
+	testCases := []struct {
+		name          string
+		to            common.Address
+		overrides     types.StateOverride
+		expAccessList types.AccessList
+	}{
+		{
+			"pass - plain transfer has an empty access list",
+			other,
+			nil,
+			nil,
+		},
+		{
+			"pass - accessed account is included",
+			contract,
+			types.StateOverride{contract: {Code: &balanceCode}},
+			types.AccessList{{Address: other.Hex()}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &tc.to})
+			s.Require().NoError(err)
+			overrides, err := json.Marshal(tc.overrides)
+			s.Require().NoError(err)
+
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides}
+			res, err := s.Network.GetEvmClient().CreateAccessList(s.Network.GetContext(), req)
+			s.Require().NoError(err)
+			s.Require().Empty(res.VmError)
+			s.Require().Equal(tc.expAccessList, res.AccessList)
+			s.Require().GreaterOrEqual(res.GasUsed, ethparams.TxGas)
+		})
+	}
+
+	s.Run("fail - canceled request", func() {
+		args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &other})
+		s.Require().NoError(err)
+
+		goCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ctx := s.Network.GetContext().WithContext(goCtx)
+
+		req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+		_, err = s.Network.App.GetEVMKeeper().CreateAccessList(ctx, req)
+		s.Require().ErrorContains(err, context.Canceled.Error())
+	})
+}
+
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	counter := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	// PUSH1 0x00 SLOAD PUSH1 0x01 ADD DUP1 PUSH1 0x00 SSTORE PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	counterCode := hexutil.Bytes(common.FromHex("0x6000546001018060005560005260206000f3"))
+	// NUMBER PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	numberCode := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+	parentHash := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	// NUMBER PUSH1 0x01 SWAP1 SUB BLOCKHASH PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	parentHashCode := hexutil.Bytes(common.FromHex("0x43600190034060005260206000f3"))
+	value := (*hexutil.Big)(big.NewInt(1000))
+	baseHeight := s.Network.GetContext().BlockHeight()
+	baseHash := common.HexToHash("0xba5e")
+	blockNumber := (*hexutil.Big)(big.NewInt(baseHeight + 10))
+	tooFarBlockNumber := (*hexutil.Big)(big.NewInt(baseHeight + types.MaxSimulateBlocks + 1))
+	wrongNonce := hexutil.Uint64(100)
+
+	testCases := []struct {
+		name     string
+		opts     types.SimOpts
+		expPass  bool
+		malleate func(results []types.SimBlockResult)
+	}{
+		{
+			"fail - empty input",
+			types.SimOpts{},
+			false,
+			nil,
+		},
+		{
+			"pass - state changes are chained across calls and blocks",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: &types.StateOverride{counter: {Code: &counterCode}},
+						Calls: []types.TransactionArgs{
+							{From: &sender, To: &counter},
+							{From: &sender, To: &counter},
+						},
+					},
+					{
+						StateOverrides: &types.StateOverride{parentHash: {Code: &parentHashCode}},
+						Calls: []types.TransactionArgs{
+							{From: &sender, To: &counter},
+							{From: &sender, To: &parentHash},
+						},
+					},
+				},
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				s.Require().Len(results, 2)
+				s.Require().Equal(uint64(baseHeight)+1, uint64(results[0].Number))
+				s.Require().Equal(baseHash, results[0].ParentHash)
+				s.Require().Equal(uint64(results[0].Number)+1, uint64(results[1].Number))
+				s.Require().Equal(results[0].Hash, results[1].ParentHash)
+				s.Require().Equal(results[0].Hash, common.BytesToHash(results[1].Calls[1].ReturnData))
+				s.Require().Greater(uint64(results[1].Timestamp), uint64(results[0].Timestamp))
+				s.Require().Equal(common.BigToHash(big.NewInt(1)), common.BytesToHash(results[0].Calls[0].ReturnData))
+				s.Require().Equal(common.BigToHash(big.NewInt(2)), common.BytesToHash(results[0].Calls[1].ReturnData))
+				s.Require().Equal(common.BigToHash(big.NewInt(3)), common.BytesToHash(results[1].Calls[0].ReturnData))
+			},
+		},
+		{
+			"pass - block number override, the skipped blocks are filled",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						BlockOverrides: &types.BlockOverrides{Number: blockNumber},
+						StateOverrides: &types.StateOverride{
+							counter:    {Code: &numberCode},
+							parentHash: {Code: &parentHashCode},
+						},
+						Calls: []types.TransactionArgs{
+							{From: &sender, To: &counter},
+							{From: &sender, To: &parentHash},
+						},
+					},
+				},
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				s.Require().Len(results, 10)
+				for i, result := range results {
+					s.Require().Equal(uint64(baseHeight)+uint64(i)+1, uint64(result.Number)) //nolint:gosec // G115
+					if i > 0 {
+						s.Require().Equal(results[i-1].Hash, result.ParentHash)
+						s.Require().Greater(uint64(result.Timestamp), uint64(results[i-1].Timestamp))
+					}
+				}
+				s.Require().Empty(results[0].Calls)
+				s.Require().Equal(baseHash, results[0].ParentHash)
+
+				last := results[len(results)-1]
+				s.Require().Equal(common.BigToHash(blockNumber.ToInt()), common.BytesToHash(last.Calls[0].ReturnData))
+				s.Require().Equal(results[len(results)-2].Hash, common.BytesToHash(last.Calls[1].ReturnData))
+			},
+		},
+		{
+			"fail - block number override too far ahead",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{BlockOverrides: &types.BlockOverrides{Number: tooFarBlockNumber}},
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - trace native transfers",
+			types.SimOpts{
+				TraceTransfers: true,
+				BlockStateCalls: []types.SimBlock{
+					{
+						Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}},
+					},
+				},
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				logs := results[0].Calls[0].Logs
+				s.Require().Len(logs, 1)
+				s.Require().Equal(types.TransferLogAddress, logs[0].Address)
+				s.Require().Equal(types.TransferTopic, logs[0].Topics[0])
+				s.Require().Equal(common.BytesToHash(sender.Bytes()), logs[0].Topics[1])
+				s.Require().Equal(common.BytesToHash(recipient.Bytes()), logs[0].Topics[2])
+				s.Require().Equal(results[0].Hash, logs[0].BlockHash)
+			},
+		},
+		{
+			"fail - block numbers out of order",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{BlockOverrides: &types.BlockOverrides{Number: blockNumber}},
+					{BlockOverrides: &types.BlockOverrides{Number: blockNumber}},
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - wrong nonce with validation",
+			types.SimOpts{
+				Validation: true,
+				BlockStateCalls: []types.SimBlock{
+					{
+						Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Nonce: &wrongNonce}},
+					},
+				},
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(tc.opts)
+			s.Require().NoError(err)
+
+			ctx := s.Network.GetContext()
+			req := &types.SimulateV1Request{
+				Opts:        opts,
+				GasCap:      config.DefaultGasCap,
+				BlockNumber: ctx.BlockHeight(),
+				BlockHash:   common.Bytes2Hex(baseHash.Bytes()),
+				BlockTime:   ctx.BlockTime(),
+			}
+			res, err := s.Network.GetEvmClient().SimulateV1(ctx, req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var results []types.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Result, &results))
+			tc.malleate(results)
+
+			// the simulated state changes must not be persisted
+			code := s.Network.App.GetEVMKeeper().GetCode(s.Network.GetContext(), common.BytesToHash(crypto.Keccak256(counterCode)))
+			s.Require().Empty(code)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPendingBlock() {
+	s.SetupTest()
+
+	senderKey := s.Keyring.GetKey(0)
+	recipient := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	// PUSH20 <recipient> BALANCE PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	balanceCode := hexutil.Bytes(append(append([]byte{byte(vm.PUSH20)}, recipient.Bytes()...), common.FromHex("0x3160005260206000f3")...))
+	value := big.NewInt(1000)
+
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), senderKey.Addr)
+	pendingTx := func(nonce uint64) *types.MsgEthereumTx {
+		msg, err := s.Factory.GenerateSignedMsgEthereumTx(senderKey.Priv, types.EvmTxArgs{
+			Nonce:    nonce,
+			To:       &recipient,
+			Amount:   value,
+			GasLimit: ethparams.TxGas,
+		})
+		s.Require().NoError(err)
+		return &msg
+	}
+	// the transactions are received out of order, and the last one has a
+	// nonce gap
+	txs := []*types.MsgEthereumTx{pendingTx(nonce + 1), pendingTx(nonce), pendingTx(nonce + 3)}
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &senderKey.Addr, To: &contract})
+	s.Require().NoError(err)
+	overrides, err := json.Marshal(types.StateOverride{contract: {Code: &balanceCode}})
+	s.Require().NoError(err)
+
+	req := &types.QueryPendingBlockRequest{
+		Txs:       txs,
+		Args:      args,
+		GasCap:    config.DefaultGasCap,
+		Overrides: overrides,
+	}
+	res, err := s.Network.GetEvmClient().PendingBlock(s.Network.GetContext(), req)
+	s.Require().NoError(err)
+
+	s.Require().Len(res.TxResponses, 2)
+	s.Require().Equal(txs[1].Hash, res.TxResponses[0].Hash)
+	s.Require().Equal(txs[0].Hash, res.TxResponses[1].Hash)
+	s.Require().Equal(2*ethparams.TxGas, res.GasUsed)
+
+	// the call is executed on top of the pending transactions
+	s.Require().NotNil(res.Call)
+	s.Require().Empty(res.Call.VmError)
+	s.Require().Equal(common.BigToHash(new(big.Int).Mul(value, big.NewInt(2))), common.BytesToHash(res.Call.Ret))
+
+	// the pending state changes must not be persisted
+	balance := s.Network.App.GetEVMKeeper().GetBalance(s.Network.GetContext(), recipient)
+	s.Require().True(balance.IsZero())
+	s.Require().Equal(nonce, s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), senderKey.Addr))
+
+	// the pending transactions are capped by the gas cap
+	res, err = s.Network.GetEvmClient().PendingBlock(s.Network.GetContext(), &types.QueryPendingBlockRequest{
+		Txs:    txs,
+		GasCap: ethparams.TxGas,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.TxResponses, 1)
+	s.Require().Equal(txs[1].Hash, res.TxResponses[0].Hash)
+
+	// the pending block must be built before the timeout
+	_, err = s.Network.GetEvmClient().PendingBlock(s.Network.GetContext(), &types.QueryPendingBlockRequest{
+		Txs:     txs,
+		Timeout: "1ns",
+	})
+	s.Require().ErrorContains(err, "deadline exceeded")
+	_, err = s.Network.GetEvmClient().PendingBlock(s.Network.GetContext(), &types.QueryPendingBlockRequest{
+		Txs:     txs,
+		Timeout: "invalid",
+	})
+	s.Require().ErrorContains(err, "timeout value")
+}
+
+func (s *KeeperTestSuite) TestPendingBlockOrdering() {
+	s.SetupTest()
+
+	recipient := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	baseFee := s.Network.App.GetEVMKeeper().GetBaseFee(s.Network.GetContext())
+	pendingTx := func(index int, nonceOffset uint64, tip int64) *types.MsgEthereumTx {
+		key := s.Keyring.GetKey(index)
+		nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), key.Addr)
+		msg, err := s.Factory.GenerateSignedMsgEthereumTx(key.Priv, types.EvmTxArgs{
+			Nonce:     nonce + nonceOffset,
+			To:        &recipient,
+			Amount:    big.NewInt(1),
+			GasLimit:  ethparams.TxGas,
+			GasTipCap: big.NewInt(tip),
+			GasFeeCap: new(big.Int).Add(baseFee, big.NewInt(tip)),
+		})
+		s.Require().NoError(err)
+		return &msg
+	}
+
+	// the transactions of each sender are applied in nonce order, and the
+	// senders are interleaved by the effective tip of their next transaction
+	first, second := pendingTx(0, 0, 2), pendingTx(0, 1, 2)
+	expensive, cheap := pendingTx(1, 0, 3), pendingTx(1, 1, 1)
+	res, err := s.Network.GetEvmClient().PendingBlock(s.Network.GetContext(), &types.QueryPendingBlockRequest{
+		Txs: []*types.MsgEthereumTx{cheap, second, first, expensive},
+	})
+	s.Require().NoError(err)
+
+	hashes := make([]string, 0, len(res.TxResponses))
+	for _, txRes := range res.TxResponses {
+		hashes = append(hashes, txRes.Hash)
+	}
+	s.Require().Equal([]string{expensive.Hash, first.Hash, second.Hash, cheap.Hash}, hashes)
+}
+
+func (s *KeeperTestSuite) TestPreimage() {
+	s.SetupTest()
+	s.Network.App.GetEVMKeeper().WithPreimageDB(dbm.NewMemDB())
+
+	senderKey := s.Keyring.GetKey(0)
+	// PUSH1 <word> PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 SHA3 POP STOP
+	hashWord := func(word byte) ([]byte, common.Hash) {
+		initCode := []byte{byte(vm.PUSH1), word, byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.KECCAK256), byte(vm.POP), byte(vm.STOP)}
+		return initCode, crypto.Keccak256Hash(common.LeftPadBytes([]byte{word}, 32))
+	}
+
+	// the preimages of a transaction are recorded
+	initCode, hash := hashWord(0x2a)
+	_, err := s.Factory.ExecuteEthTx(senderKey.Priv, types.EvmTxArgs{Input: initCode, GasPrice: big.NewInt(1)})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	res, err := s.Network.GetEvmClient().Preimage(s.Network.GetContext(), &types.QueryPreimageRequest{Hash: hash.Hex()})
+	s.Require().NoError(err)
+	s.Require().Equal(common.LeftPadBytes([]byte{0x2a}, 32), res.Preimage)
+
+	// the preimages of a call are not
+	initCode, hash = hashWord(0x2b)
+	args, err := json.Marshal(&types.TransactionArgs{From: &senderKey.Addr, Data: (*hexutil.Bytes)(&initCode)})
+	s.Require().NoError(err)
+	_, err = s.Network.GetEvmClient().EthCall(s.Network.GetContext(), &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	s.Require().NoError(err)
+
+	res, err = s.Network.GetEvmClient().Preimage(s.Network.GetContext(), &types.QueryPreimageRequest{Hash: hash.Hex()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Preimage)
+
+	_, err = s.Network.GetEvmClient().Preimage(s.Network.GetContext(), &types.QueryPreimageRequest{Hash: "0x1234"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPrevRandao() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+
+	prevRandao := evmKeeper.GetPrevRandao(s.Network.GetContext())
+	appHash := s.Network.App.GetBaseApp().LastCommitID().Hash
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the value is mixed from the previous one and the app hash of the header,
+	// as vote extensions are disabled
+	ctx := s.Network.GetContext()
+	expRandao := crypto.Keccak256Hash(prevRandao.Bytes(), appHash)
+	s.Require().NotEqual(prevRandao, expRandao)
+	s.Require().Equal(expRandao, evmKeeper.GetPrevRandao(ctx))
+
+	res, err := s.Network.GetEvmClient().PrevRandao(ctx, &types.QueryPrevRandaoRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(expRandao.Hex(), res.PrevRandao)
+
+	// the values of the last blocks are queryable by height
+	res, err = s.Network.GetEvmClient().PrevRandao(ctx, &types.QueryPrevRandaoRequest{Height: ctx.BlockHeight() - 1})
+	s.Require().NoError(err)
+	s.Require().Equal(prevRandao.Hex(), res.PrevRandao)
+	_, err = s.Network.GetEvmClient().PrevRandao(ctx, &types.QueryPrevRandaoRequest{Height: ctx.BlockHeight() + 1})
+	s.Require().ErrorContains(err, "not found")
+
+	// PREVRANDAO PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	sender := s.Keyring.GetAddr(0)
+	code := []byte{byte(vm.PREVRANDAO), byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN)}
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, Data: (*hexutil.Bytes)(&code)})
+	s.Require().NoError(err)
+	callRes, err := s.Network.GetEvmClient().EthCall(ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	s.Require().NoError(err)
+	s.Require().Empty(callRes.VmError)
+	s.Require().Equal(expRandao.Bytes(), callRes.Ret)
+}
+
 func (s *KeeperTestSuite) TestEmptyRequest() {
 	s.SetupTest()
 	k := s.Network.App.GetEVMKeeper()
@@ -1750,6 +2576,36 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.EthCall(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return k.CreateAccessList(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"PendingBlock method",
+			func() (interface{}, error) {
+				return k.PendingBlock(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"PrevRandao method",
+			func() (interface{}, error) {
+				return k.PrevRandao(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"Preimage method",
+			func() (interface{}, error) {
+				return k.Preimage(s.Network.GetContext(), nil)
+			},
+		},
 		{
 			"EstimateGas method",
 			func() (interface{}, error) {
@@ -1768,6 +2624,12 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"IntermediateRoots method",
+			func() (interface{}, error) {
+				return k.IntermediateRoots(s.Network.GetContext(), nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,11 +1,23 @@
 package vm
 
 import (
+	"encoding/json"
+	"math"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/testutil/integration/base/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -79,6 +91,189 @@ func (s *KeeperTestSuite) TestEthereumTx() {
 	s.EnableFeemarket = false
 }
 
+func (s *KeeperTestSuite) TestEthereumTxBatch() {
+	s.SetupTest()
+	sender := s.Keyring.GetKey(0)
+	recipient := s.Keyring.GetAddr(1)
+	// init code reverting the contract creation: PUSH1 0 PUSH1 0 REVERT
+	revertingCode := common.FromHex("0x60006000fd")
+
+	testCases := []struct {
+		name        string
+		secondArgs  types.EvmTxArgs
+		expectedErr error
+	}{
+		{
+			"success - both txs are executed",
+			types.EvmTxArgs{To: &recipient, Amount: big.NewInt(1000)},
+			nil,
+		},
+		{
+			"fail - a reverted tx reverts the whole batch",
+			types.EvmTxArgs{Input: revertingCode},
+			types.ErrBatchTxFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.Network.GetContext()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+			nonce := evmKeeper.GetNonce(ctx, sender.Addr)
+			senderBalance := evmKeeper.GetBalance(ctx, sender.Addr).ToBig()
+			recipientBalance := evmKeeper.GetBalance(ctx, recipient).ToBig()
+
+			firstArgs := types.EvmTxArgs{
+				Nonce:    nonce,
+				To:       &recipient,
+				Amount:   big.NewInt(1000),
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+			}
+			secondArgs := tc.secondArgs
+			secondArgs.Nonce = nonce + 1
+			secondArgs.GasLimit = 100000
+			secondArgs.GasPrice = big.NewInt(1)
+
+			msgs := make([]*types.MsgEthereumTx, 0, 2)
+			for _, args := range []types.EvmTxArgs{firstArgs, secondArgs} {
+				msg, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, args)
+				s.Require().NoError(err)
+				msgs = append(msgs, &msg)
+			}
+
+			txConfig := s.Network.GetEncodingConfig().TxConfig
+			tx, err := types.BuildBatchTx(txConfig.NewTxBuilder(), s.Network.GetEVMCoinInfo(), msgs...)
+			s.Require().NoError(err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			res, err := s.Network.BroadcastTxSync(txBytes)
+			s.Require().NoError(err)
+			s.Require().NoError(s.Network.NextBlock())
+
+			ctx = s.Network.GetContext()
+			// the nonces of both txs are consumed in any case
+			s.Require().Equal(nonce+2, evmKeeper.GetNonce(ctx, sender.Addr))
+
+			if tc.expectedErr != nil {
+				s.Require().False(res.IsOK())
+				s.Require().Contains(res.Log, tc.expectedErr.Error())
+				// the whole gas limit of the batch is charged
+				s.Require().Equal(int64(200000), res.GasUsed)
+				s.Require().Equal(
+					new(big.Int).Sub(senderBalance, big.NewInt(200000)),
+					evmKeeper.GetBalance(ctx, sender.Addr).ToBig(),
+				)
+				// the transfer of the first tx is reverted
+				s.Require().Equal(recipientBalance, evmKeeper.GetBalance(ctx, recipient).ToBig())
+			} else {
+				s.Require().True(res.IsOK(), res.Log)
+				s.Require().Equal(
+					new(big.Int).Add(recipientBalance, big.NewInt(2000)),
+					evmKeeper.GetBalance(ctx, recipient).ToBig(),
+				)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEthereumTxFeeGrant() {
+	s.SetupTest()
+	granter := s.Keyring.GetKey(1)
+	// the sender is a new account without funds
+	sender := s.Keyring.GetKey(s.Keyring.AddKey())
+	recipient := s.Keyring.GetAddr(2)
+	gasPrice := big.NewInt(1e9)
+	extendedDenom := types.GetEVMCoinExtendedDenom()
+	spendLimit := sdkmath.NewInt(1e18)
+
+	testCases := []struct {
+		name   string
+		grant  bool
+		signer int
+		expErr string
+	}{
+		{
+			"fail - no allowance from the fee payer",
+			false,
+			1,
+			"does not allow to pay fees",
+		},
+		{
+			"fail - fee payer signature from another account",
+			false,
+			2,
+			"fee payer signature",
+		},
+		{
+			"success - fees paid by the fee payer",
+			true,
+			1,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.Network.GetContext()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+			feegrantKeeper := s.Network.App.GetFeeGrantKeeper()
+			if tc.grant {
+				allowance := &feegrant.BasicAllowance{SpendLimit: sdktypes.NewCoins(sdktypes.NewCoin(extendedDenom, spendLimit))}
+				msgGrant, err := feegrant.NewMsgGrantAllowance(allowance, granter.AccAddr, sender.AccAddr)
+				s.Require().NoError(err)
+				res, err := s.Factory.ExecuteCosmosTx(granter.Priv, factory.CosmosTxArgs{Msgs: []sdktypes.Msg{msgGrant}})
+				s.Require().NoError(err)
+				s.Require().True(res.IsOK(), res.Log)
+				s.Require().NoError(s.Network.NextBlock())
+				ctx = s.Network.GetContext()
+			}
+			granterBalance := evmKeeper.GetBalance(ctx, granter.Addr).ToBig()
+
+			msg, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, types.EvmTxArgs{
+				To:       &recipient,
+				GasLimit: 100000,
+				GasPrice: gasPrice,
+			})
+			s.Require().NoError(err)
+
+			txConfig := s.Network.GetEncodingConfig().TxConfig
+			feePayerSig, err := s.Keyring.GetPrivKey(tc.signer).Sign(types.FeePayerSignHash(s.Network.GetEIP155ChainID(), &msg).Bytes())
+			s.Require().NoError(err)
+			tx, err := types.BuildSponsoredTx(txConfig.NewTxBuilder(), s.Network.GetEVMCoinInfo(), granter.AccAddr, feePayerSig, &msg)
+			s.Require().NoError(err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			res, err := s.Network.BroadcastTxSync(txBytes)
+			s.Require().NoError(err)
+			s.Require().NoError(s.Network.NextBlock())
+			ctx = s.Network.GetContext()
+
+			if tc.expErr != "" {
+				s.Require().False(res.IsOK())
+				s.Require().Contains(res.Log, tc.expErr)
+				s.Require().Equal(uint64(0), evmKeeper.GetNonce(ctx, sender.Addr))
+				return
+			}
+
+			s.Require().True(res.IsOK(), res.Log)
+			s.Require().Equal(uint64(1), evmKeeper.GetNonce(ctx, sender.Addr))
+			// the sender pays nothing and the fee payer gets the leftover gas refunded
+			s.Require().Zero(evmKeeper.GetBalance(ctx, sender.Addr).Sign())
+			fees := new(big.Int).Mul(big.NewInt(res.GasUsed), gasPrice)
+			s.Require().Equal(new(big.Int).Sub(granterBalance, fees), evmKeeper.GetBalance(ctx, granter.Addr).ToBig())
+
+			// the allowance is only charged with the fees of the gas used
+			allowance, err := feegrantKeeper.GetAllowance(ctx, granter.AccAddr, sender.AccAddr)
+			s.Require().NoError(err)
+			expLimit := spendLimit.Sub(sdkmath.NewIntFromBigInt(fees))
+			s.Require().Equal(expLimit, allowance.(*feegrant.BasicAllowance).SpendLimit.AmountOf(extendedDenom))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	s.SetupTest()
 	testCases := []struct {
@@ -178,3 +373,240 @@ func (s *KeeperTestSuite) TestRegisterPreinstalls() {
 		s.Require().NoError(err)
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateChainConfig() {
+	s.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name        string
+		getMsg      func() *types.MsgUpdateChainConfig
+		expectedErr error
+	}{
+		{
+			name: "fail - invalid authority",
+			getMsg: func() *types.MsgUpdateChainConfig {
+				return &types.MsgUpdateChainConfig{Authority: "foobar"}
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - change an activated fork",
+			getMsg: func() *types.MsgUpdateChainConfig {
+				ctx := s.Network.GetContext()
+				pragueTime := sdkmath.NewInt(ctx.BlockTime().Unix() + 3600)
+				chainConfig := *s.Network.App.GetEVMKeeper().GetChainConfig(ctx)
+				chainConfig.PragueTime = &pragueTime
+				return &types.MsgUpdateChainConfig{Authority: authority, ChainConfig: chainConfig}
+			},
+			expectedErr: types.ErrInvalidChainConfig,
+		},
+		{
+			name: "fail - schedule a fork in the past",
+			getMsg: func() *types.MsgUpdateChainConfig {
+				ctx := s.Network.GetContext()
+				osakaTime := sdkmath.NewInt(ctx.BlockTime().Unix())
+				chainConfig := *s.Network.App.GetEVMKeeper().GetChainConfig(ctx)
+				chainConfig.OsakaTime = &osakaTime
+				return &types.MsgUpdateChainConfig{Authority: authority, ChainConfig: chainConfig}
+			},
+			expectedErr: types.ErrInvalidChainConfig,
+		},
+		{
+			name: "pass - schedule a fork",
+			getMsg: func() *types.MsgUpdateChainConfig {
+				ctx := s.Network.GetContext()
+				osakaTime := sdkmath.NewInt(ctx.BlockTime().Unix() + 3600)
+				chainConfig := *s.Network.App.GetEVMKeeper().GetChainConfig(ctx)
+				chainConfig.OsakaTime = &osakaTime
+				return &types.MsgUpdateChainConfig{Authority: authority, ChainConfig: chainConfig}
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run("MsgUpdateChainConfig_"+tc.name, func() {
+			msg := tc.getMsg()
+			ctx := s.Network.GetContext()
+			_, err := s.Network.App.GetEVMKeeper().UpdateChainConfig(ctx, msg)
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Contains(err.Error(), tc.expectedErr.Error())
+				return
+			}
+
+			s.Require().NoError(err)
+			ethCfg := s.Network.App.GetEVMKeeper().GetEthChainConfig(ctx)
+			s.Require().NotNil(ethCfg.OsakaTime)
+			s.Require().Equal(msg.ChainConfig.OsakaTime.Uint64(), *ethCfg.OsakaTime)
+			s.Require().False(ethCfg.IsOsaka(ethCfg.LondonBlock, uint64(ctx.BlockTime().Unix()))) //#nosec G115 -- int overflow is not a concern here
+		})
+
+		err := s.Network.NextBlock()
+		s.Require().NoError(err)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetChainConfigCache() {
+	s.SetupTest()
+	k := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+
+	chainConfig := *k.GetChainConfig(ctx)
+	osakaTime := sdkmath.NewInt(ctx.BlockTime().Unix() + 3600)
+	chainConfig.OsakaTime = &osakaTime
+	s.Require().NoError(k.SetChainConfig(ctx, chainConfig))
+
+	// the read of the chain config isn't metered
+	gasMeter := storetypes.NewGasMeter(1_000_000)
+	s.Require().Equal(osakaTime, *k.GetChainConfig(ctx.WithGasMeter(gasMeter)).OsakaTime)
+	s.Require().Zero(gasMeter.GasConsumed())
+
+	// an update of a reverted cache context doesn't leak into the cached config
+	cacheCtx, _ := ctx.CacheContext()
+	laterOsakaTime := sdkmath.NewInt(ctx.BlockTime().Unix() + 7200)
+	updated := chainConfig
+	updated.OsakaTime = &laterOsakaTime
+	s.Require().NoError(k.SetChainConfig(cacheCtx, updated))
+	s.Require().Equal(laterOsakaTime, *k.GetChainConfig(cacheCtx).OsakaTime)
+	s.Require().Equal(osakaTime, *k.GetChainConfig(ctx).OsakaTime)
+
+	// callers can't modify the cached config
+	k.GetChainConfig(ctx).OsakaTime = nil
+	s.Require().Equal(osakaTime, *k.GetChainConfig(ctx).OsakaTime)
+}
+
+func (s *KeeperTestSuite) enableDevMode() {
+	params := s.Network.App.GetEVMKeeper().GetParams(s.Network.GetContext())
+	params.DevMode = true
+	params.DevAuthority = s.Keyring.GetAccAddr(0).String()
+	err := s.Network.App.GetEVMKeeper().SetParams(s.Network.GetContext(), params)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestDevAuthority() {
+	s.SetupTest()
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msg := &types.MsgDevIncreaseTime{Sender: s.Keyring.GetAccAddr(1).String(), Seconds: 1}
+
+	s.enableDevMode()
+	_, err := s.Network.App.GetEVMKeeper().DevIncreaseTime(s.Network.GetContext(), msg)
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the dev msgs fall back to the module authority without a dev authority
+	params := s.Network.App.GetEVMKeeper().GetParams(s.Network.GetContext())
+	params.DevAuthority = ""
+	err = s.Network.App.GetEVMKeeper().SetParams(s.Network.GetContext(), params)
+	s.Require().NoError(err)
+
+	_, err = s.Network.App.GetEVMKeeper().DevIncreaseTime(s.Network.GetContext(), msg)
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	msg.Sender = govAuthority
+	_, err = s.Network.App.GetEVMKeeper().DevIncreaseTime(s.Network.GetContext(), msg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestDevSetState() {
+	s.SetupTest()
+	sender := s.Keyring.GetAccAddr(0).String()
+	addr := utiltx.GenerateAddress()
+	slot := common.BytesToHash([]byte{1})
+
+	newMsg := func() *types.MsgDevSetState {
+		nonce := hexutil.Uint64(5)
+		balance := (*hexutil.Big)(big.NewInt(1e18))
+		code := hexutil.Bytes{0x60, 0x00}
+		stateDiff := map[common.Hash]common.Hash{slot: common.BytesToHash([]byte{2})}
+		bz, err := json.Marshal(types.StateOverride{
+			addr: {Nonce: &nonce, Balance: &balance, Code: &code, StateDiff: &stateDiff},
+		})
+		s.Require().NoError(err)
+		return &types.MsgDevSetState{Sender: sender, Overrides: bz}
+	}
+
+	ctx := s.Network.GetContext()
+	_, err := s.Network.App.GetEVMKeeper().DevSetState(ctx, newMsg())
+	s.Require().ErrorIs(err, types.ErrDevModeDisabled)
+
+	s.enableDevMode()
+	ctx = s.Network.GetContext()
+	_, err = s.Network.App.GetEVMKeeper().DevSetState(ctx, newMsg())
+	s.Require().NoError(err)
+
+	account := s.Network.App.GetEVMKeeper().GetAccount(ctx, addr)
+	s.Require().NotNil(account)
+	s.Require().Equal(uint64(5), account.Nonce)
+	s.Require().Equal(big.NewInt(1e18), account.Balance.ToBig())
+	s.Require().Equal([]byte{0x60, 0x00}, s.Network.App.GetEVMKeeper().GetCode(ctx, common.BytesToHash(account.CodeHash)))
+	s.Require().Equal(common.BytesToHash([]byte{2}), s.Network.App.GetEVMKeeper().GetState(ctx, addr, slot))
+
+	dump, err := s.Network.App.GetEVMKeeper().DumpState(ctx, keeper.MaxDevStateDumpEntries)
+	s.Require().NoError(err)
+	s.Require().Contains(dump, addr)
+	s.Require().Equal(hexutil.Uint64(5), *dump[addr].Nonce)
+	s.Require().Equal(map[common.Hash]common.Hash{slot: common.BytesToHash([]byte{2})}, *dump[addr].State)
+
+	// the dump fails past its limit of accounts and storage slots
+	_, err = s.Network.App.GetEVMKeeper().DumpState(ctx, len(dump))
+	s.Require().ErrorIs(err, types.ErrDevStateTooLarge)
+
+	// a full state override deletes the slots out of it
+	otherSlot := common.BytesToHash([]byte{3})
+	state := map[common.Hash]common.Hash{otherSlot: common.BytesToHash([]byte{4})}
+	bz, err := json.Marshal(types.StateOverride{addr: {State: &state}})
+	s.Require().NoError(err)
+	_, err = s.Network.App.GetEVMKeeper().DevSetState(ctx, &types.MsgDevSetState{Sender: sender, Overrides: bz})
+	s.Require().NoError(err)
+	s.Require().Equal(common.Hash{}, s.Network.App.GetEVMKeeper().GetState(ctx, addr, slot))
+	s.Require().Equal(common.BytesToHash([]byte{4}), s.Network.App.GetEVMKeeper().GetState(ctx, addr, otherSlot))
+}
+
+func (s *KeeperTestSuite) TestDevIncreaseTime() {
+	s.SetupTest()
+	msg := &types.MsgDevIncreaseTime{Sender: s.Keyring.GetAccAddr(0).String(), Seconds: 3600}
+
+	_, err := s.Network.App.GetEVMKeeper().DevIncreaseTime(s.Network.GetContext(), msg)
+	s.Require().ErrorIs(err, types.ErrDevModeDisabled)
+
+	s.enableDevMode()
+	for i := uint64(1); i <= 2; i++ {
+		res, err := s.Network.App.GetEVMKeeper().DevIncreaseTime(s.Network.GetContext(), msg)
+		s.Require().NoError(err)
+		s.Require().Equal(i*3600, res.TimeOffset)
+		s.Require().Equal(i*3600, s.Network.App.GetEVMKeeper().GetDevTimeOffset(s.Network.GetContext()))
+	}
+
+	msg.Seconds = math.MaxUint64
+	_, err = s.Network.App.GetEVMKeeper().DevIncreaseTime(s.Network.GetContext(), msg)
+	s.Require().ErrorContains(err, "overflow")
+}
+
+func (s *KeeperTestSuite) TestDevSendTransaction() {
+	s.SetupTest()
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+	value := big.NewInt(1000)
+
+	bz, err := json.Marshal(types.TransactionArgs{From: &from, To: &to, Value: (*hexutil.Big)(value)})
+	s.Require().NoError(err)
+	msg := &types.MsgDevSendTransaction{Sender: s.Keyring.GetAccAddr(0).String(), Args: bz}
+
+	_, err = s.Network.App.GetEVMKeeper().DevSendTransaction(s.Network.GetContext(), msg)
+	s.Require().ErrorIs(err, types.ErrDevModeDisabled)
+
+	s.enableDevMode()
+	ctx := s.Network.GetContext()
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	overrides, err := json.Marshal(types.StateOverride{from: {Balance: &balance}})
+	s.Require().NoError(err)
+	_, err = s.Network.App.GetEVMKeeper().DevSetState(ctx, &types.MsgDevSetState{Sender: msg.Sender, Overrides: overrides})
+	s.Require().NoError(err)
+
+	res, err := s.Network.App.GetEVMKeeper().DevSendTransaction(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().False(res.Result.Failed(), res.Result.VmError)
+	s.Require().Equal(uint64(1), s.Network.App.GetEVMKeeper().GetNonce(ctx, from))
+	s.Require().Equal(value, s.Network.App.GetEVMKeeper().GetAccount(ctx, to).Balance.ToBig())
+}
@@ -1,19 +1,25 @@
 package vm
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	servercfg "github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/config"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
@@ -61,7 +67,7 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 			uint64(s.Network.GetContext().BlockHeight()), //nolint:gosec // G115
 			func() sdk.Context {
 				header := tmproto.Header{}
-				header.Height = s.Network.GetContext().BlockHeight()
+				header.Height = h.Height
 				return s.Network.GetContext().WithBlockHeader(header)
 			},
 			common.Hash{},
@@ -75,31 +81,31 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 			common.BytesToHash(hash),
 		},
 		{
-			"case 2.1: height lower than current one, hist info not found",
+			"case 2.1: height out of the history storage window, hist info not found",
 			1,
 			func() sdk.Context {
-				return s.Network.GetContext().WithBlockHeight(10)
+				return s.Network.GetContext().WithBlockHeight(8200)
 			},
 			common.Hash{},
 		},
 		{
-			"case 2.2: height lower than current one, invalid hist info header",
+			"case 2.2: height out of the history storage window, invalid hist info header",
 			1,
 			func() sdk.Context {
 				s.Require().NoError(s.Network.App.GetStakingKeeper().SetHistoricalInfo(s.Network.GetContext(), 1, &stakingtypes.HistoricalInfo{}))
-				return s.Network.GetContext().WithBlockHeight(10)
+				return s.Network.GetContext().WithBlockHeight(8200)
 			},
 			common.Hash{},
 		},
 		{
-			"case 2.3: height lower than current one, calculated from hist info header",
+			"case 2.3: height out of the history storage window, calculated from hist info header",
 			1,
 			func() sdk.Context {
 				histInfo := &stakingtypes.HistoricalInfo{
 					Header: header,
 				}
 				s.Require().NoError(s.Network.App.GetStakingKeeper().SetHistoricalInfo(s.Network.GetContext(), 1, histInfo))
-				return s.Network.GetContext().WithBlockHeight(10)
+				return s.Network.GetContext().WithBlockHeight(8200)
 			},
 			common.BytesToHash(hash),
 		},
@@ -125,6 +131,44 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 	}
 }
 
+func (s *KeeperTestSuite) TestBlockHashHistory() {
+	s.SetupTest()
+
+	// the hash of each block is its app hash in the test network
+	hashes := make(map[int64]common.Hash)
+	for i := 0; i < 3; i++ {
+		height := s.Network.GetContext().BlockHeight() + 1
+		hashes[height] = common.BytesToHash(s.Network.App.GetBaseApp().LastCommitID().Hash)
+		s.Require().NoError(s.Network.NextBlock())
+	}
+
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	for height, hash := range hashes {
+		if height == ctx.BlockHeight() {
+			// the current block is only written on the next one
+			s.Require().Equal(common.Hash{}, evmKeeper.GetHistoricalBlockHash(ctx, height))
+			continue
+		}
+
+		s.Require().Equal(hash, evmKeeper.GetHistoricalBlockHash(ctx, height))
+		s.Require().Equal(hash, evmKeeper.GetHashFn(ctx)(uint64(height))) //nolint:gosec // G115
+
+		// the history storage contract serves the same hash
+		input := common.BigToHash(big.NewInt(height)).Bytes()
+		args, err := json.Marshal(&types.TransactionArgs{To: &params.HistoryStorageAddress, Data: (*hexutil.Bytes)(&input)})
+		s.Require().NoError(err)
+		res, err := s.Network.GetEvmClient().EthCall(ctx, &types.EthCallRequest{Args: args, GasCap: servercfg.DefaultGasCap})
+		s.Require().NoError(err)
+		s.Require().Empty(res.VmError)
+		s.Require().Equal(hash.Bytes(), res.Ret)
+	}
+
+	// blocks out of the window are not served
+	s.Require().Equal(common.Hash{}, evmKeeper.GetHistoricalBlockHash(ctx, ctx.BlockHeight()+1))
+	s.Require().Equal(common.Hash{}, evmKeeper.GetHistoricalBlockHash(ctx.WithBlockHeight(ctx.BlockHeight()+8192), ctx.BlockHeight()))
+}
+
 func (s *KeeperTestSuite) TestGetCoinbaseAddress() {
 	s.SetupTest()
 	validators := s.Network.GetValidators()
@@ -848,3 +892,70 @@ func (s *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestApplyTransactionWithSetCodeAuthorizations() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	sender := s.Keyring.GetKey(0)
+	authority := s.Keyring.GetKey(1)
+	authorityKey, err := authority.Priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	s.Require().NoError(err)
+
+	// deploy the delegate contract, with runtime code: sstore(0, 1)
+	senderNonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender.Addr)
+	delegate := crypto.CreateAddress(sender.Addr, senderNonce)
+	res, err := s.Factory.ExecuteEthTx(sender.Priv, types.EvmTxArgs{
+		Input:    common.FromHex("0x656001600055006000526006601af3"),
+		GasLimit: 100000,
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().NoError(s.Network.NextBlock())
+
+	authorityNonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), authority.Addr)
+	auth, err := gethtypes.SignSetCode(authorityKey, gethtypes.SetCodeAuthorization{
+		ChainID: *uint256.MustFromBig(s.Network.GetEIP155ChainID()),
+		Address: delegate,
+		Nonce:   authorityNonce,
+	})
+	s.Require().NoError(err)
+
+	recipient := s.Keyring.GetAddr(1)
+	res, err = s.Factory.ExecuteEthTx(sender.Priv, types.EvmTxArgs{
+		To:                &recipient,
+		GasLimit:          100000,
+		AuthorizationList: []gethtypes.SetCodeAuthorization{auth},
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the delegation designator is set and the authority nonce increased
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	acc := evmKeeper.GetAccount(ctx, authority.Addr)
+	s.Require().NotNil(acc)
+	s.Require().Equal(gethtypes.AddressToDelegation(delegate), evmKeeper.GetCode(ctx, common.BytesToHash(acc.CodeHash)))
+	s.Require().Equal(authorityNonce+1, acc.Nonce)
+
+	// calling the authority executes the delegated code on its own storage
+	res, err = s.Factory.ExecuteEthTx(sender.Priv, types.EvmTxArgs{
+		To:       &recipient,
+		GasLimit: 100000,
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().NoError(s.Network.NextBlock())
+
+	value := s.Network.App.GetEVMKeeper().GetState(s.Network.GetContext(), authority.Addr, common.Hash{})
+	s.Require().Equal(common.BigToHash(big.NewInt(1)), value)
+
+	// the delegated account is still able to send transactions
+	_, err = s.Factory.ExecuteEthTx(authority.Priv, types.EvmTxArgs{
+		To:       &delegate,
+		GasLimit: 100000,
+	})
+	s.Require().NoError(err)
+}
@@ -422,6 +422,31 @@ func (s *KeeperTestSuite) TestState() {
 	}
 }
 
+func (s *KeeperTestSuite) TestStorageHash() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+	addr := s.Keyring.GetAddr(0)
+	key1 := common.BytesToHash([]byte("key1"))
+	key2 := common.BytesToHash([]byte("key2"))
+	value1 := common.BytesToHash([]byte("value1"))
+	value2 := common.BytesToHash([]byte("value2"))
+
+	s.Require().Equal(common.Hash{}, k.GetStorageHash(ctx, addr))
+
+	k.SetState(ctx, addr, key1, value1.Bytes())
+	k.SetState(ctx, addr, key2, value1.Bytes())
+	k.SetState(ctx, addr, key2, value2.Bytes())
+
+	expected := types.UpdateStorageHash(common.Hash{}, key1, common.Hash{}, value1)
+	expected = types.UpdateStorageHash(expected, key2, common.Hash{}, value2)
+	s.Require().Equal(expected, k.GetStorageHash(ctx, addr))
+
+	k.DeleteState(ctx, addr, key1)
+	k.DeleteState(ctx, addr, key2)
+	s.Require().Equal(common.Hash{}, k.GetStorageHash(ctx, addr))
+}
+
 func (s *KeeperTestSuite) TestCommittedState() {
 	key := common.BytesToHash([]byte("key"))
 	value1 := common.BytesToHash([]byte("value1"))
@@ -47,6 +47,8 @@ func (tf *IntegrationTxFactory) EstimateGasLimit(from *common.Address, txArgs *e
 		From:       from,
 		To:         txArgs.To,
 		AccessList: txArgs.Accesses,
+
+		AuthorizationList: txArgs.AuthorizationList,
 	})
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "failed to marshal tx args")
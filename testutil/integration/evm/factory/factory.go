@@ -181,7 +181,7 @@ func (tf *IntegrationTxFactory) encodeTx(tx sdktypes.Tx) ([]byte, error) {
 func (tf *IntegrationTxFactory) buildSignedTx(msg evmtypes.MsgEthereumTx) (signing.Tx, error) {
 	txConfig := tf.ec.TxConfig
 	txBuilder := txConfig.NewTxBuilder()
-	return msg.BuildTx(txBuilder, tf.network.GetBaseDenom())
+	return msg.BuildTx(txBuilder, tf.network.GetEVMCoinInfo())
 }
 
 // checkEthTxResponse checks if the response is valid and returns the MsgEthereumTxResponse
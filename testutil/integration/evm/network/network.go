@@ -44,6 +44,7 @@ type Network interface {
 	GetBaseDecimal() evmtypes.Decimals
 	GetEIP155ChainID() *big.Int
 	GetEVMChainConfig() *gethparams.ChainConfig
+	GetEVMCoinInfo() evmtypes.EvmCoinInfo
 
 	// Clients
 	GetERC20Client() erc20types.QueryClient
@@ -286,6 +287,11 @@ func (n *IntegrationNetwork) GetEVMChainConfig() *gethparams.ChainConfig {
 	return evmtypes.GetEthChainConfig()
 }
 
+// GetEVMCoinInfo returns the EVM coin configuration of the network's app
+func (n *IntegrationNetwork) GetEVMCoinInfo() evmtypes.EvmCoinInfo {
+	return n.app.GetEVMKeeper().GetEVMCoinInfo()
+}
+
 // GetBaseDenom returns the network's base denom
 func (n *IntegrationNetwork) GetBaseDenom() string {
 	return n.cfg.chainCoins.baseCoin.Denom
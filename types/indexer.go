@@ -7,6 +7,10 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 )
 
+// BloomBitsBlocks is the number of blocks a single bloom bit section vector
+// contains.
+const BloomBitsBlocks uint64 = 4096
+
 // EVMTxIndexer defines the interface of custom eth tx indexer.
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
@@ -17,4 +21,14 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// BloomBits returns the bit vector of a bloom bit within a section, nil if
+	// the section is not indexed.
+	BloomBits(bit uint, section uint64) ([]byte, error)
+	// BloomSections returns the number of contiguous sections from section 0
+	// with indexed bloom bits.
+	BloomSections() (uint64, error)
+	// ProcessBloomSections converts the complete sections not processed yet to
+	// bloom bits, returns the number of processed sections.
+	ProcessBloomSections() (uint64, error)
 }
@@ -44,13 +44,28 @@ func InitGenesis(
 			panic(fmt.Errorf("error setting allowance %s", err))
 		}
 	}
+
+	for _, nonce := range data.PermitNonces {
+		erc20 := common.HexToAddress(nonce.Erc20Address)
+		owner := common.HexToAddress(nonce.Owner)
+		k.SetPermitNonce(ctx, erc20, owner, nonce.Nonce)
+	}
+
+	for _, authorization := range data.UsedAuthorizations {
+		erc20 := common.HexToAddress(authorization.Erc20Address)
+		authorizer := common.HexToAddress(authorization.Authorizer)
+		nonce := common.HexToHash(authorization.Nonce)
+		k.SetAuthorizationUsed(ctx, erc20, authorizer, nonce)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
-		Allowances: k.GetAllowances(ctx),
+		Params:             k.GetParams(ctx),
+		TokenPairs:         k.GetTokenPairs(ctx),
+		Allowances:         k.GetAllowances(ctx),
+		PermitNonces:       k.GetPermitNonces(ctx),
+		UsedAuthorizations: k.GetUsedAuthorizations(ctx),
 	}
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPermitNonce returns the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) GetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	bz := store.Get(types.PermitNonceKey(erc20, owner))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPermitNonce sets the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) SetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+	nonce uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(types.PermitNonceKey(erc20, owner), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetPermitNonces returns all EIP-2612 permit nonces stored on the erc20 precompiles.
+func (k Keeper) GetPermitNonces(ctx sdk.Context) []types.PermitNonce {
+	nonces := []types.PermitNonce{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		erc20 := common.BytesToAddress(key[:common.AddressLength])
+		owner := common.BytesToAddress(key[common.AddressLength:])
+		nonces = append(nonces, types.NewPermitNonce(erc20, owner, sdk.BigEndianToUint64(iterator.Value())))
+	}
+
+	return nonces
+}
+
+// IsAuthorizationUsed returns true if the EIP-3009 authorization with the given
+// nonce of the authorizer has been used on the given erc20 precompile address.
+func (k Keeper) IsAuthorizationUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedAuthorization)
+	return store.Has(types.UsedAuthorizationKey(erc20, authorizer, nonce))
+}
+
+// SetAuthorizationUsed marks the EIP-3009 authorization with the given nonce of
+// the authorizer as used on the given erc20 precompile address.
+func (k Keeper) SetAuthorizationUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedAuthorization)
+	store.Set(types.UsedAuthorizationKey(erc20, authorizer, nonce), []byte{1})
+}
+
+// GetUsedAuthorizations returns all EIP-3009 authorizations used on the erc20 precompiles.
+func (k Keeper) GetUsedAuthorizations(ctx sdk.Context) []types.UsedAuthorization {
+	authorizations := []types.UsedAuthorization{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedAuthorization)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		erc20 := common.BytesToAddress(key[:common.AddressLength])
+		authorizer := common.BytesToAddress(key[common.AddressLength : 2*common.AddressLength])
+		nonce := common.BytesToHash(key[2*common.AddressLength:])
+		authorizations = append(authorizations, types.NewUsedAuthorization(erc20, authorizer, nonce))
+	}
+
+	return authorizations
+}
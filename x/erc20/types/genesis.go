@@ -17,9 +17,11 @@ func NewGenesisState(params Params, pairs []TokenPair, allowances []Allowance) G
 // default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		TokenPairs: []TokenPair{},
-		Allowances: []Allowance{},
+		Params:             DefaultParams(),
+		TokenPairs:         []TokenPair{},
+		Allowances:         []Allowance{},
+		PermitNonces:       []PermitNonce{},
+		UsedAuthorizations: []UsedAuthorization{},
 	}
 }
 
@@ -78,6 +80,34 @@ func (gs GenesisState) Validate() error {
 		seenAllowance[a.Erc20Address+a.Owner+a.Spender] = true
 	}
 
+	// Check if permit nonces are valid
+	seenNonce := make(map[string]bool)
+	for _, n := range gs.PermitNonces {
+		if seenNonce[n.Erc20Address+n.Owner] {
+			return fmt.Errorf("duplicated permit nonce on genesis: %s", n.Erc20Address+n.Owner)
+		}
+
+		if err := n.Validate(); err != nil {
+			return fmt.Errorf("invalid permit nonce on genesis: %w", err)
+		}
+
+		seenNonce[n.Erc20Address+n.Owner] = true
+	}
+
+	// Check if used authorizations are valid
+	seenAuthorization := make(map[string]bool)
+	for _, a := range gs.UsedAuthorizations {
+		if seenAuthorization[a.Erc20Address+a.Authorizer+a.Nonce] {
+			return fmt.Errorf("duplicated used authorization on genesis: %s", a.Erc20Address+a.Authorizer+a.Nonce)
+		}
+
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid used authorization on genesis: %w", err)
+		}
+
+		seenAuthorization[a.Erc20Address+a.Authorizer+a.Nonce] = true
+	}
+
 	return nil
 }
 
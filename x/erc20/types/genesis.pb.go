@@ -32,6 +32,11 @@ type GenesisState struct {
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
 	// allowances is a slice of the registered allowances at genesis
 	Allowances []Allowance `protobuf:"bytes,3,rep,name=allowances,proto3" json:"allowances"`
+	// permit_nonces is a slice of the EIP-2612 permit nonces at genesis
+	PermitNonces []PermitNonce `protobuf:"bytes,4,rep,name=permit_nonces,json=permitNonces,proto3" json:"permit_nonces"`
+	// used_authorizations is a slice of the used EIP-3009 authorizations at
+	// genesis
+	UsedAuthorizations []UsedAuthorization `protobuf:"bytes,5,rep,name=used_authorizations,json=usedAuthorizations,proto3" json:"used_authorizations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +93,20 @@ func (m *GenesisState) GetAllowances() []Allowance {
 	return nil
 }
 
+func (m *GenesisState) GetPermitNonces() []PermitNonce {
+	if m != nil {
+		return m.PermitNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsedAuthorizations() []UsedAuthorization {
+	if m != nil {
+		return m.UsedAuthorizations
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -174,32 +193,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0x86, 0x33, 0xdb, 0xb5, 0xec, 0x4e, 0xf6, 0xe0, 0xce, 0x7a, 0x08, 0x5d, 0xc8, 0x6e, 0xeb,
-	0xa5, 0x08, 0x66, 0x6c, 0x3d, 0xab, 0x58, 0x10, 0xb1, 0xa7, 0x12, 0x3d, 0x79, 0x09, 0xd3, 0xf8,
-	0x11, 0x07, 0x33, 0x33, 0x61, 0x66, 0x8c, 0xf6, 0x5f, 0xf8, 0x33, 0x3c, 0xfa, 0x33, 0x7a, 0x2c,
-	0x9e, 0x3c, 0x89, 0xb4, 0x88, 0x7f, 0x43, 0x3a, 0x93, 0xd2, 0x14, 0xca, 0x5e, 0xc2, 0xc7, 0xfb,
-	0x3d, 0xef, 0x9b, 0x77, 0xe0, 0xc3, 0xfd, 0x5c, 0x19, 0xa1, 0x0c, 0x85, 0x5a, 0x50, 0xd0, 0xf9,
-	0xf8, 0x09, 0xad, 0x47, 0xb4, 0x00, 0x09, 0x86, 0x9b, 0xa4, 0xd2, 0xca, 0x2a, 0x72, 0xe5, 0x91,
-	0x04, 0x6a, 0x91, 0x38, 0x24, 0xa9, 0x47, 0xbd, 0x4b, 0x26, 0xb8, 0x54, 0xd4, 0x7d, 0x3d, 0xd7,
-	0xbb, 0x39, 0x16, 0xe5, 0x0d, 0x1e, 0x78, 0x50, 0xa8, 0x42, 0xb9, 0x91, 0x6e, 0x27, 0xaf, 0x0e,
-	0xfe, 0x22, 0x7c, 0xf1, 0xda, 0xff, 0xf0, 0xad, 0x65, 0x16, 0xc8, 0x73, 0xdc, 0xad, 0x98, 0x66,
-	0xc2, 0x44, 0xe8, 0x16, 0x0d, 0xc3, 0xf1, 0x75, 0x72, 0xa4, 0x40, 0x32, 0x73, 0xc8, 0xe4, 0x7c,
-	0xf9, 0xfb, 0x26, 0xf8, 0xfe, 0xef, 0xc7, 0x23, 0x94, 0x36, 0x2e, 0x32, 0xc5, 0xa1, 0x55, 0x9f,
-	0x40, 0x66, 0x15, 0xe3, 0xda, 0x44, 0x27, 0xb7, 0x9d, 0x61, 0x38, 0x8e, 0x8f, 0x86, 0xbc, 0xdb,
-	0x72, 0x33, 0xc6, 0x75, 0x3b, 0x07, 0xdb, 0x9d, 0x6a, 0xc8, 0x1b, 0x8c, 0x59, 0x59, 0xaa, 0x2f,
-	0x4c, 0xe6, 0x60, 0xa2, 0xce, 0x1d, 0x51, 0x2f, 0x77, 0xd8, 0x41, 0xd4, 0xde, 0x3c, 0xf8, 0x89,
-	0x70, 0xd7, 0x97, 0x26, 0x7d, 0x7c, 0x01, 0x92, 0xcd, 0x4b, 0xc8, 0x9c, 0xdd, 0xbd, 0xf3, 0x2c,
-	0x0d, 0xbd, 0xf6, 0x6a, 0x2b, 0x91, 0xc7, 0x98, 0x48, 0x66, 0x79, 0x0d, 0x59, 0xa5, 0x21, 0x57,
-	0xa2, 0xe2, 0x65, 0x53, 0xe0, 0x3c, 0xbd, 0xf4, 0x9b, 0xd9, 0x7e, 0x41, 0x28, 0xbe, 0xfa, 0xb0,
-	0x90, 0x4c, 0xf0, 0xfc, 0x80, 0x3f, 0x75, 0x3c, 0x69, 0x56, 0x6d, 0xc3, 0x0b, 0x7c, 0x5d, 0x81,
-	0x16, 0xdc, 0x18, 0xae, 0x64, 0x09, 0xc6, 0x64, 0x1a, 0x0a, 0x6e, 0xac, 0x66, 0x96, 0x2b, 0x19,
-	0xdd, 0x73, 0x8d, 0x7a, 0x87, 0x48, 0xda, 0x22, 0xa6, 0xa7, 0x67, 0x27, 0xf7, 0x3b, 0x93, 0x67,
-	0xcb, 0x75, 0x8c, 0x56, 0xeb, 0x18, 0xfd, 0x59, 0xc7, 0xe8, 0xdb, 0x26, 0x0e, 0x56, 0x9b, 0x38,
-	0xf8, 0xb5, 0x89, 0x83, 0xf7, 0x0f, 0x0b, 0x6e, 0x3f, 0x7e, 0x9e, 0x27, 0xb9, 0x12, 0xb4, 0x75,
-	0x18, 0x5f, 0x9b, 0xd3, 0xb0, 0x8b, 0x0a, 0xcc, 0xbc, 0xeb, 0x4e, 0xe0, 0xe9, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x7f, 0x42, 0x06, 0x94, 0x86, 0x02, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x4d, 0x1a, 0xda, 0x49, 0x04, 0x3b, 0xf1, 0xb0, 0xa4, 0xb0, 0x4d, 0x2b, 0x48,
+	0x11, 0xdc, 0xb5, 0xf1, 0xac, 0xd2, 0x82, 0x88, 0x3d, 0x48, 0x88, 0x7a, 0xf1, 0xb2, 0x4c, 0x36,
+	0x8f, 0xed, 0xe0, 0xce, 0x0f, 0xe6, 0x4d, 0x56, 0xeb, 0x5f, 0xe1, 0xdd, 0x7f, 0xc0, 0xa3, 0x7f,
+	0x46, 0x8f, 0xc5, 0x93, 0x27, 0x91, 0xe4, 0xe0, 0xbf, 0x21, 0x99, 0x49, 0xc9, 0xa6, 0x2c, 0xbd,
+	0x2c, 0xc3, 0xf7, 0x7d, 0xde, 0x67, 0x67, 0xdf, 0x3e, 0x72, 0x98, 0x29, 0x14, 0x0a, 0x13, 0x28,
+	0x45, 0x02, 0x26, 0x1b, 0x3e, 0x4d, 0xca, 0x93, 0x24, 0x07, 0x09, 0xc8, 0x31, 0xd6, 0x46, 0x59,
+	0x45, 0x7b, 0x1e, 0x89, 0xa1, 0x14, 0xb1, 0x43, 0xe2, 0xf2, 0xa4, 0xbf, 0xc7, 0x04, 0x97, 0x2a,
+	0x71, 0x4f, 0xcf, 0xf5, 0x0f, 0xea, 0x54, 0xbe, 0xc1, 0x03, 0x0f, 0x72, 0x95, 0x2b, 0x77, 0x4c,
+	0x96, 0x27, 0x9f, 0x1e, 0x7d, 0x6f, 0x92, 0xee, 0x6b, 0xff, 0xc2, 0x77, 0x96, 0x59, 0xa0, 0x2f,
+	0x48, 0x5b, 0x33, 0xc3, 0x04, 0x86, 0xc1, 0x20, 0x38, 0xee, 0x0c, 0xf7, 0xe3, 0x9a, 0x0b, 0xc4,
+	0x23, 0x87, 0x9c, 0xed, 0x5e, 0xfd, 0x39, 0x68, 0xfc, 0xf8, 0xf7, 0xf3, 0x71, 0x30, 0x5e, 0x75,
+	0xd1, 0x73, 0xd2, 0xb1, 0xea, 0x13, 0xc8, 0x54, 0x33, 0x6e, 0x30, 0xdc, 0x1a, 0x34, 0x8f, 0x3b,
+	0xc3, 0xa8, 0x56, 0xf2, 0x7e, 0xc9, 0x8d, 0x18, 0x37, 0x55, 0x0f, 0xb1, 0x37, 0x29, 0xd2, 0x37,
+	0x84, 0xb0, 0xa2, 0x50, 0x9f, 0x99, 0xcc, 0x00, 0xc3, 0xe6, 0x1d, 0xaa, 0xd3, 0x1b, 0x6c, 0x43,
+	0xb5, 0x6e, 0xa6, 0x23, 0x72, 0x4f, 0x83, 0x11, 0xdc, 0xa6, 0x52, 0x39, 0x5b, 0xcb, 0xd9, 0x06,
+	0xf5, 0x5f, 0xe7, 0xc8, 0xb7, 0xea, 0x96, 0xaf, 0xab, 0xd7, 0x39, 0xd2, 0x09, 0xe9, 0xcd, 0x10,
+	0xa6, 0x29, 0x9b, 0xd9, 0x0b, 0x65, 0xf8, 0x57, 0x66, 0xb9, 0x92, 0x18, 0x6e, 0x3b, 0xef, 0xa3,
+	0x5a, 0xef, 0x07, 0x84, 0xe9, 0x69, 0x15, 0xaf, 0xda, 0xe9, 0xec, 0x76, 0x15, 0x8f, 0x7e, 0x05,
+	0xa4, 0xed, 0x47, 0x4d, 0x0f, 0x49, 0x17, 0x24, 0x9b, 0x14, 0x90, 0x3a, 0x9d, 0xfb, 0x3b, 0x3b,
+	0xe3, 0x8e, 0xcf, 0x5e, 0x2d, 0x23, 0xfa, 0x84, 0x50, 0xc9, 0x2c, 0x2f, 0x21, 0xd5, 0x06, 0x32,
+	0x25, 0x34, 0x2f, 0x56, 0x63, 0xdb, 0x1d, 0xef, 0xf9, 0xca, 0x68, 0x5d, 0xa0, 0x09, 0xe9, 0x4d,
+	0x2f, 0x25, 0x13, 0x3c, 0xdb, 0xe0, 0x5b, 0x8e, 0xa7, 0xab, 0x52, 0xb5, 0xe1, 0x25, 0xd9, 0x77,
+	0x13, 0x40, 0xe4, 0x4a, 0x16, 0x80, 0x98, 0x1a, 0xc8, 0x39, 0x5a, 0xe3, 0x6e, 0x1b, 0x6e, 0xbb,
+	0x1b, 0xf5, 0x37, 0x91, 0x71, 0x85, 0x38, 0x6f, 0xed, 0x6c, 0xdd, 0x6f, 0x9e, 0x3d, 0xbf, 0x9a,
+	0x47, 0xc1, 0xf5, 0x3c, 0x0a, 0xfe, 0xce, 0xa3, 0xe0, 0xdb, 0x22, 0x6a, 0x5c, 0x2f, 0xa2, 0xc6,
+	0xef, 0x45, 0xd4, 0xf8, 0xf8, 0x30, 0xe7, 0xf6, 0x62, 0x36, 0x89, 0x33, 0x25, 0x92, 0xca, 0x3a,
+	0x7f, 0x59, 0x2d, 0xb4, 0xbd, 0xd4, 0x80, 0x93, 0xb6, 0x5b, 0xdc, 0x67, 0xff, 0x07, 0x00, 0xd9,
+	0x14, 0x7e, 0x6e, 0x3c, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -222,6 +245,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UsedAuthorizations) > 0 {
+		for iNdEx := len(m.UsedAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PermitNonces) > 0 {
+		for iNdEx := len(m.PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Allowances) > 0 {
 		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -355,6 +406,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PermitNonces) > 0 {
+		for _, e := range m.PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedAuthorizations) > 0 {
+		for _, e := range m.UsedAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -521,6 +584,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermitNonces = append(m.PermitNonces, PermitNonce{})
+			if err := m.PermitNonces[len(m.PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedAuthorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedAuthorizations = append(m.UsedAuthorizations, UsedAuthorization{})
+			if err := m.UsedAuthorizations[len(m.UsedAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -311,6 +312,97 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with permit nonces and used authorizations",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        1,
+					},
+				},
+				UsedAuthorizations: []types.UsedAuthorization{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        common.Hash{1}.Hex(),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated permit nonces",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        1,
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        2,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero permit nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated used authorizations",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				UsedAuthorizations: []types.UsedAuthorization{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        common.Hash{1}.Hex(),
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        common.Hash{1}.Hex(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid used authorization nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				UsedAuthorizations: []types.UsedAuthorization{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        "0x01",
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
@@ -32,15 +32,19 @@ const (
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
 	prefixAllowance
+	prefixPermitNonce
+	prefixUsedAuthorization
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair        = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
-	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
-	KeyPrefixAllowance        = []byte{prefixAllowance}
+	KeyPrefixTokenPair         = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20  = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom  = []byte{prefixTokenPairByDenom}
+	KeyPrefixSTRv2Addresses    = []byte{prefixSTRv2Addresses}
+	KeyPrefixAllowance         = []byte{prefixAllowance}
+	KeyPrefixPermitNonce       = []byte{prefixPermitNonce}
+	KeyPrefixUsedAuthorization = []byte{prefixUsedAuthorization}
 )
 
 func AllowanceKey(
@@ -50,3 +54,15 @@ func AllowanceKey(
 ) []byte {
 	return append(append(erc20.Bytes(), owner.Bytes()...), spender.Bytes()...)
 }
+
+// PermitNonceKey returns the key of the EIP-2612 permit nonce of the owner on
+// the erc20 precompile.
+func PermitNonceKey(erc20 common.Address, owner common.Address) []byte {
+	return append(erc20.Bytes(), owner.Bytes()...)
+}
+
+// UsedAuthorizationKey returns the key of an EIP-3009 authorization nonce of
+// the authorizer on the erc20 precompile.
+func UsedAuthorizationKey(erc20 common.Address, authorizer common.Address, nonce common.Hash) []byte {
+	return append(append(erc20.Bytes(), authorizer.Bytes()...), nonce.Bytes()...)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewPermitNonce(erc20 common.Address, owner common.Address, nonce uint64) PermitNonce {
+	return PermitNonce{
+		Erc20Address: erc20.Hex(),
+		Owner:        owner.Hex(),
+		Nonce:        nonce,
+	}
+}
+
+func (n PermitNonce) Validate() error {
+	if !common.IsHexAddress(n.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid erc20 hex address %s", n.Erc20Address)
+	}
+
+	if !common.IsHexAddress(n.Owner) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid owner hex address %s", n.Owner)
+	}
+
+	if n.Nonce == 0 {
+		return errorsmod.Wrap(ErrInvalidNonce, "permit nonce cannot be zero")
+	}
+
+	return nil
+}
+
+func NewUsedAuthorization(erc20 common.Address, authorizer common.Address, nonce common.Hash) UsedAuthorization {
+	return UsedAuthorization{
+		Erc20Address: erc20.Hex(),
+		Authorizer:   authorizer.Hex(),
+		Nonce:        nonce.Hex(),
+	}
+}
+
+func (a UsedAuthorization) Validate() error {
+	if !common.IsHexAddress(a.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid erc20 hex address %s", a.Erc20Address)
+	}
+
+	if !common.IsHexAddress(a.Authorizer) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid authorizer hex address %s", a.Authorizer)
+	}
+
+	nonce, err := hexutil.Decode(a.Nonce)
+	if err != nil || len(nonce) != common.HashLength {
+		return errorsmod.Wrapf(ErrInvalidNonce, "invalid authorization nonce %s", a.Nonce)
+	}
+
+	return nil
+}
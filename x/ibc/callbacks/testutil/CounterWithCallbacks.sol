@@ -104,6 +104,16 @@ contract CounterWithCallbacks is ICallbacks {
         counter -= 1; // Decrement counter on timeout
     }
 
+    /**
+     * @dev Implementation of ICallbacks interface
+     * Called when an unbonding delegation of the contract is completed,
+     * which isn't used by the counter
+     */
+    function onUnbondingCompleted(
+        string memory,
+        uint256
+    ) external override {}
+
     /**
      * @dev Reset the counter
      */
@@ -20,7 +20,7 @@ func InitGenesis(
 	gs *types.GenesisState,
 ) {
 	// Ensure the genesis state is valid
-	if err := gs.Validate(); err != nil {
+	if err := gs.ValidateWith(keeper.ConversionFactor()); err != nil {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
 	}
 
@@ -35,16 +35,16 @@ func InitGenesis(
 	totalAmt := gs.TotalAmountWithRemainder()
 
 	moduleAddr := ak.GetModuleAddress(types.ModuleName)
-	moduleBal := bk.GetBalance(ctx, moduleAddr, types.IntegerCoinDenom())
-	moduleBalExtended := moduleBal.Amount.Mul(types.ConversionFactor())
+	moduleBal := bk.GetBalance(ctx, moduleAddr, keeper.IntegerCoinDenom())
+	moduleBalExtended := moduleBal.Amount.Mul(keeper.ConversionFactor())
 
 	// Compare balances in full precise extended amounts
 	if !totalAmt.Equal(moduleBalExtended) {
 		panic(fmt.Sprintf(
 			"module account balance does not match sum of fractional balances and remainder, balance is %s but expected %v%s (%v%s)",
 			moduleBal,
-			totalAmt, types.ExtendedCoinDenom(),
-			totalAmt.Quo(types.ConversionFactor()), types.IntegerCoinDenom(),
+			totalAmt, keeper.ExtendedCoinDenom(),
+			totalAmt.Quo(keeper.ConversionFactor()), keeper.IntegerCoinDenom(),
 		))
 	}
 
@@ -46,7 +46,7 @@ func (k *Keeper) SetFractionalBalance(
 
 	// Ensure the fractional balance is valid before setting it. Use the
 	// NewFractionalAmountFromInt wrapper to use its Validate() method.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	if err := types.ValidateFractionalAmountWith(amount, k.ConversionFactor()); err != nil {
 		panic(fmt.Errorf("amount is invalid: %w", err))
 	}
 
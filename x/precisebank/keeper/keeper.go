@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,6 +23,10 @@ type Keeper struct {
 
 	bk types.BankKeeper
 	ak types.AccountKeeper
+
+	// coinInfo is the EVM coin of the app. The one set on startup through the
+	// EVMConfigurator is used when it is nil.
+	coinInfo *evmtypes.EvmCoinInfo
 }
 
 // NewKeeper creates a new keeper
@@ -39,6 +44,38 @@ func NewKeeper(
 	}
 }
 
+// WithEVMCoinInfo returns a copy of the keeper using the given EVM coin of the
+// app instead of the process globals set by the EVMConfigurator.
+func (k Keeper) WithEVMCoinInfo(coinInfo evmtypes.EvmCoinInfo) Keeper {
+	k.coinInfo = &coinInfo
+	return k
+}
+
+// EVMCoinInfo returns the EVM coin of the app.
+func (k Keeper) EVMCoinInfo() evmtypes.EvmCoinInfo {
+	if k.coinInfo == nil {
+		return evmtypes.GetEVMCoinInfo()
+	}
+	return *k.coinInfo
+}
+
+// ConversionFactor returns a copy of the conversion factor between the
+// fractional and integer balances of the EVM coin of the app.
+func (k Keeper) ConversionFactor() sdkmath.Int {
+	return types.CoinConversionFactor(k.EVMCoinInfo())
+}
+
+// IntegerCoinDenom returns the denom of the integer coins of the EVM coin of
+// the app, managed by x/bank.
+func (k Keeper) IntegerCoinDenom() string {
+	return k.EVMCoinInfo().Denom
+}
+
+// ExtendedCoinDenom returns the extended denom of the EVM coin of the app.
+func (k Keeper) ExtendedCoinDenom() string {
+	return k.EVMCoinInfo().ExtendedDenom
+}
+
 func (k Keeper) IterateTotalSupply(ctx context.Context, cb func(coin sdk.Coin) bool) {
 	k.bk.IterateTotalSupply(ctx, cb)
 }
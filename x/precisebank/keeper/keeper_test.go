@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	evmosencoding "github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/testutil/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -61,3 +63,23 @@ func newMockedTestData(t *testing.T) testData {
 func c(denom string, amount int64) sdk.Coin        { return sdk.NewInt64Coin(denom, amount) }
 func ci(denom string, amount sdkmath.Int) sdk.Coin { return sdk.NewCoin(denom, amount) }
 func cs(coins ...sdk.Coin) sdk.Coins               { return sdk.NewCoins(coins...) }
+
+func TestKeeperWithEVMCoinInfo(t *testing.T) {
+	td := newMockedTestData(t)
+	coinInfo := testconstants.ExampleChainCoinInfo[testconstants.TwelveDecimalsChainID]
+	k := td.keeper.WithEVMCoinInfo(coinInfo)
+
+	// the keeper uses the coin of its app instead of the process globals
+	require.Equal(t, coinInfo.Denom, k.IntegerCoinDenom())
+	require.Equal(t, coinInfo.ExtendedDenom, k.ExtendedCoinDenom())
+	require.Equal(t, sdkmath.NewInt(1_000_000), k.ConversionFactor())
+	require.Equal(t, types.ConversionFactor(), td.keeper.ConversionFactor())
+	require.NotEqual(t, types.ConversionFactor(), k.ConversionFactor())
+
+	addr := sdk.AccAddress([]byte("test-address"))
+	k.SetFractionalBalance(td.ctx, addr, sdkmath.NewInt(999_999))
+	require.Equal(t, sdkmath.NewInt(999_999), k.GetFractionalBalance(td.ctx, addr))
+	require.PanicsWithError(t, "amount is invalid: amount 1000000 exceeds max of 999999", func() {
+		k.SetFractionalBalance(td.ctx, addr, sdkmath.NewInt(1_000_000))
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -35,7 +36,7 @@ func TestMintCoins_PanicValidations(t *testing.T) {
 					Return(nil).
 					Once()
 			},
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"module account notamodule does not exist: unknown address",
 		},
 		{
@@ -51,7 +52,7 @@ func TestMintCoins_PanicValidations(t *testing.T) {
 					)).
 					Once()
 			},
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"module account mint does not have permissions to mint tokens: unauthorized",
 		},
 		{
@@ -70,11 +71,11 @@ func TestMintCoins_PanicValidations(t *testing.T) {
 
 				// Will call x/bank MintCoins coins
 				td.bk.EXPECT().
-					MintCoins(td.ctx, minttypes.ModuleName, cs(c(types.IntegerCoinDenom(), 1000))).
+					MintCoins(td.ctx, minttypes.ModuleName, cs(c(evmtypes.GetEVMCoinDenom(), 1000))).
 					Return(nil).
 					Once()
 			},
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"",
 		},
 		{
@@ -84,7 +85,7 @@ func TestMintCoins_PanicValidations(t *testing.T) {
 				// No mock setup needed since this is checked before module
 				// account checks
 			},
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			"module account precisebank cannot be minted to: unauthorized",
 		},
 	}
@@ -135,10 +136,10 @@ func TestMintCoins_Errors(t *testing.T) {
 					Once()
 			},
 			sdk.Coins{sdk.Coin{
-				Denom:  types.IntegerCoinDenom(),
+				Denom:  evmtypes.GetEVMCoinDenom(),
 				Amount: sdkmath.NewInt(-1000),
 			}},
-			fmt.Sprintf("-1000%s: invalid coins", types.IntegerCoinDenom()),
+			fmt.Sprintf("-1000%s: invalid coins", evmtypes.GetEVMCoinDenom()),
 		},
 	}
 
@@ -177,7 +178,7 @@ func TestMintCoins_ExpectedCalls(t *testing.T) {
 		{
 			"passthrough mint - integer denom",
 			sdkmath.ZeroInt(),
-			cs(c(types.IntegerCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinDenom(), 1000)),
 			sdkmath.ZeroInt(),
 		},
 
@@ -190,41 +191,41 @@ func TestMintCoins_ExpectedCalls(t *testing.T) {
 		{
 			"no carry - 0 starting fractional",
 			sdkmath.ZeroInt(),
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			sdkmath.NewInt(1000),
 		},
 		{
 			"no carry - non-zero fractional",
 			sdkmath.NewInt(1_000_000),
-			cs(c(types.ExtendedCoinDenom(), 1000)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1000)),
 			sdkmath.NewInt(1_001_000),
 		},
 		{
 			"fractional carry",
 			// max fractional amount
 			types.ConversionFactor().SubRaw(1),
-			cs(c(types.ExtendedCoinDenom(), 1)), // +1 to carry
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 1)), // +1 to carry
 			sdkmath.ZeroInt(),
 		},
 		{
 			"fractional carry max",
 			// max fractional amount + max fractional amount
 			types.ConversionFactor().SubRaw(1),
-			cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().SubRaw(1))),
+			cs(ci(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().SubRaw(1))),
 			types.ConversionFactor().SubRaw(2),
 		},
 		{
 			"integer with fractional no carry",
 			sdkmath.NewInt(1234),
 			// mint 100 fractional
-			cs(c(types.ExtendedCoinDenom(), 100)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 100)),
 			sdkmath.NewInt(1234 + 100),
 		},
 		{
 			"integer with fractional carry",
 			types.ConversionFactor().SubRaw(100),
 			// mint 105 fractional to carry
-			cs(c(types.ExtendedCoinDenom(), 105)),
+			cs(c(evmtypes.GetEVMCoinExtendedDenom(), 105)),
 			sdkmath.NewInt(5),
 		},
 	}
@@ -264,20 +265,20 @@ func TestMintCoins_ExpectedCalls(t *testing.T) {
 			// Determine how much is passed through to x/bank
 			passthroughCoins := tt.mintAmount
 
-			found, extCoins := tt.mintAmount.Find(types.ExtendedCoinDenom())
+			found, extCoins := tt.mintAmount.Find(evmtypes.GetEVMCoinExtendedDenom())
 			if found {
 				// Remove extended coin from passthrough coins
 				passthroughCoins = passthroughCoins.Sub(extCoins)
 			} else {
-				extCoins = sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.ZeroInt())
+				extCoins = sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.ZeroInt())
 			}
 
 			require.Equalf(
 				t,
 				sdkmath.ZeroInt(),
-				passthroughCoins.AmountOf(types.ExtendedCoinDenom()),
+				passthroughCoins.AmountOf(evmtypes.GetEVMCoinExtendedDenom()),
 				"expected pass through coins should not include %v",
-				types.ExtendedCoinDenom(),
+				evmtypes.GetEVMCoinExtendedDenom(),
 			)
 
 			// ----------------------------------------
@@ -318,7 +319,7 @@ func TestMintCoins_ExpectedCalls(t *testing.T) {
 				mintIntegerAmount := extCoins.Amount.Quo(types.ConversionFactor())
 
 				// Minted coins does NOT include roll-over, simply excludes
-				mintCoins := cs(ci(types.IntegerCoinDenom(), mintIntegerAmount))
+				mintCoins := cs(ci(evmtypes.GetEVMCoinDenom(), mintIntegerAmount))
 
 				// Only expect MintCoins to be called with mint coins with
 				// non-zero amount.
@@ -339,14 +340,14 @@ func TestMintCoins_ExpectedCalls(t *testing.T) {
 						td.ctx,
 						types.ModuleName,
 						minttypes.ModuleName,
-						cs(c(types.IntegerCoinDenom(), 1)),
+						cs(c(evmtypes.GetEVMCoinDenom(), 1)),
 					).
 					Return(nil).
 					Once()
 			}
 
 			if !remainderEnough && !causesIntegerCarry {
-				reserveMintCoins := cs(c(types.IntegerCoinDenom(), 1))
+				reserveMintCoins := cs(c(evmtypes.GetEVMCoinDenom(), 1))
 				td.bk.EXPECT().
 					// Mints to x/precisebank
 					MintCoins(td.ctx, types.ModuleName, reserveMintCoins).
@@ -45,11 +45,11 @@ func (k Keeper) SendCoins(
 	}
 
 	passthroughCoins := amt
-	extendedCoinAmount := amt.AmountOf(types.ExtendedCoinDenom())
+	extendedCoinAmount := amt.AmountOf(k.ExtendedCoinDenom())
 
 	// Remove the extended coin amount from the passthrough coins
 	if extendedCoinAmount.IsPositive() {
-		subCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedCoinAmount)
+		subCoin := sdk.NewCoin(k.ExtendedCoinDenom(), extendedCoinAmount)
 		passthroughCoins = amt.Sub(subCoin)
 	}
 
@@ -69,7 +69,7 @@ func (k Keeper) SendCoins(
 
 	// Get a full extended coin amount (passthrough integer + fractional) ONLY
 	// for event attributes.
-	fullEmissionCoins := sdk.NewCoins(types.SumExtendedCoin(amt))
+	fullEmissionCoins := sdk.NewCoins(types.SumExtendedCoinWith(amt, k.EVMCoinInfo()))
 
 	// If no passthrough integer nor fractional coins, then no event emission.
 	// We also want to emit the event with the whole equivalent extended coin
@@ -151,12 +151,12 @@ func (k Keeper) sendExtendedCoins(
 
 	// -------------------------------------------------------------------------
 	// Pure stateless calculations
-	integerAmt := amt.Quo(types.ConversionFactor())
-	fractionalAmt := amt.Mod(types.ConversionFactor())
+	integerAmt := amt.Quo(k.ConversionFactor())
+	fractionalAmt := amt.Mod(k.ConversionFactor())
 
 	// Account new fractional balances
-	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(senderFracBal, fractionalAmt)
-	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(recipientFracBal, fractionalAmt)
+	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(senderFracBal, fractionalAmt, k.ConversionFactor())
+	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(recipientFracBal, fractionalAmt, k.ConversionFactor())
 
 	// Case #1: Sender borrow, recipient carry
 	if senderNeedsBorrow && recipientNeedsCarry {
@@ -171,7 +171,7 @@ func (k Keeper) sendExtendedCoins(
 	// Full integer amount transfer, including direct transfer of borrow/carry
 	// if any.
 	if integerAmt.IsPositive() {
-		transferCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerAmt)
+		transferCoin := sdk.NewCoin(k.IntegerCoinDenom(), integerAmt)
 		if err := k.bk.SendCoins(ctx, from, to, sdk.NewCoins(transferCoin)); err != nil {
 			return k.updateInsufficientFundsError(ctx, from, amt, err)
 		}
@@ -181,7 +181,7 @@ func (k Keeper) sendExtendedCoins(
 	// Sender borrows by transferring 1 integer amount to reserve to account for
 	// lack of fractional balance.
 	if senderNeedsBorrow && !recipientNeedsCarry {
-		borrowCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))
+		borrowCoin := sdk.NewCoin(k.IntegerCoinDenom(), sdkmath.NewInt(1))
 		if err := k.bk.SendCoinsFromAccountToModule(
 			ctx,
 			from, // sender borrowing
@@ -204,7 +204,7 @@ func (k Keeper) sendExtendedCoins(
 		// a SendCoins operation. Only SendCoinsFromModuleToAccount should check
 		// blocked addrs which is done by the parent SendCoinsFromModuleToAccount
 		// method.
-		carryCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))
+		carryCoin := sdk.NewCoin(k.IntegerCoinDenom(), sdkmath.NewInt(1))
 		if err := k.bk.SendCoins(
 			ctx,
 			reserveAddr,
@@ -236,13 +236,14 @@ func (k Keeper) sendExtendedCoins(
 func subFromFractionalBalance(
 	currentFractionalBalance sdkmath.Int,
 	amountToSub sdkmath.Int,
+	conversionFactor sdkmath.Int,
 ) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToSub.GTE(types.ConversionFactor()) {
+	if amountToSub.GTE(conversionFactor) {
 		panic("amountToSub must be less than ConversionFactor")
 	}
 
@@ -255,7 +256,7 @@ func subFromFractionalBalance(
 		// Borrowing 1 integer equivalent amount of fractional coins. We need to
 		// add 1 integer equivalent amount to the fractional balance otherwise
 		// the new fractional balance will be negative.
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
 	}
 
 	return newFractionalBalance, borrowRequired
@@ -264,13 +265,13 @@ func subFromFractionalBalance(
 // addToFractionalBalance adds a fractional amount to the provided current
 // fractional balance, returning the new fractional balance and true if a carry
 // is required.
-func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sdkmath.Int) (sdkmath.Int, bool) {
+func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sdkmath.Int, conversionFactor sdkmath.Int) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToAdd.GTE(types.ConversionFactor()) {
+	if amountToAdd.GTE(conversionFactor) {
 		panic("amountToAdd must be less than ConversionFactor")
 	}
 
@@ -278,11 +279,11 @@ func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sd
 
 	// New balance exceeds max fractional balance, so we need to carry it over
 	// to the integer balance.
-	carryRequired := newFractionalBalance.GTE(types.ConversionFactor())
+	carryRequired := newFractionalBalance.GTE(conversionFactor)
 
 	if carryRequired {
 		// Carry over to integer amount
-		newFractionalBalance = newFractionalBalance.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Sub(conversionFactor)
 	}
 
 	return newFractionalBalance, carryRequired
@@ -392,8 +393,8 @@ func (k Keeper) updateInsufficientFundsError(
 	}
 
 	// Check balance is sufficient
-	bal := k.GetBalance(ctx, addr, types.ExtendedCoinDenom())
-	coin := sdk.NewCoin(types.ExtendedCoinDenom(), amt)
+	bal := k.GetBalance(ctx, addr, k.ExtendedCoinDenom())
+	coin := sdk.NewCoin(k.ExtendedCoinDenom(), amt)
 
 	// TODO: This checks spendable coins and returns error with spendable
 	// coins, not full balance. If GetBalance() is modified to return the
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -28,50 +29,50 @@ func TestKeeper_GetBalance(t *testing.T) {
 	}{
 		{
 			"extended denom - no fractional balance",
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 			// queried bank balance in uatom when querying for aatom
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.ZeroInt(),
 			// integer + fractional
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(1000_000_000_000_000)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(1000_000_000_000_000)),
 		},
 		{
 			"extended denom - with fractional balance",
-			types.ExtendedCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinExtendedDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.NewInt(100),
 			// integer + fractional
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(1000_000_000_000_100)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(1000_000_000_000_100)),
 		},
 		{
 			"extended denom - only fractional balance",
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 			// no coins in bank, only fractional balance
 			sdk.NewCoins(),
 			sdkmath.NewInt(100),
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(100)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(100)),
 		},
 		{
 			"extended denom - max fractional balance",
-			types.ExtendedCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinExtendedDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			types.ConversionFactor().SubRaw(1),
 			// integer + fractional
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(1000_999_999_999_999)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(1000_999_999_999_999)),
 		},
 		{
 			"non-extended denom - uatom returns uatom",
-			types.IntegerCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.ZeroInt(),
-			sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000)),
+			sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000)),
 		},
 		{
 			"non-extended denom - unaffected by fractional balance",
-			types.IntegerCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.NewInt(100),
-			sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000)),
+			sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000)),
 		},
 		{
 			"unrelated denom - no fractional",
@@ -97,19 +98,19 @@ func TestKeeper_GetBalance(t *testing.T) {
 			tk.keeper.SetFractionalBalance(tk.ctx, addr, tt.giveFractionalBal)
 
 			// Checks address if its a reserve denom
-			if tt.giveDenom == types.ExtendedCoinDenom() {
+			if tt.giveDenom == evmtypes.GetEVMCoinExtendedDenom() {
 				tk.ak.EXPECT().GetModuleAddress(types.ModuleName).
 					Return(authtypes.NewModuleAddress(types.ModuleName)).
 					Once()
 			}
 
-			if tt.giveDenom == types.ExtendedCoinDenom() {
+			if tt.giveDenom == evmtypes.GetEVMCoinExtendedDenom() {
 				// No balance pass through
 				tk.bk.EXPECT().
-					GetBalance(tk.ctx, addr, types.IntegerCoinDenom()).
+					GetBalance(tk.ctx, addr, evmtypes.GetEVMCoinDenom()).
 					RunAndReturn(func(_ context.Context, _ sdk.AccAddress, _ string) sdk.Coin {
-						amt := tt.giveBankBal.AmountOf(types.IntegerCoinDenom())
-						return sdk.NewCoin(types.IntegerCoinDenom(), amt)
+						amt := tt.giveBankBal.AmountOf(evmtypes.GetEVMCoinDenom())
+						return sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amt)
 					}).
 					Once()
 			} else {
@@ -142,50 +143,50 @@ func TestKeeper_SpendableCoin(t *testing.T) {
 	}{
 		{
 			"extended denom - no fractional balance",
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 			// queried bank balance in uatom when querying for aatom
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.ZeroInt(),
 			// integer + fractional
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(1000_000_000_000_000)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(1000_000_000_000_000)),
 		},
 		{
 			"extended denom - with fractional balance",
-			types.ExtendedCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinExtendedDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.NewInt(100),
 			// integer + fractional
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(1000_000_000_000_100)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(1000_000_000_000_100)),
 		},
 		{
 			"extended denom - only fractional balance",
-			types.ExtendedCoinDenom(),
+			evmtypes.GetEVMCoinExtendedDenom(),
 			// no coins in bank, only fractional balance
 			sdk.NewCoins(),
 			sdkmath.NewInt(100),
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(100)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(100)),
 		},
 		{
 			"extended denom - max fractional balance",
-			types.ExtendedCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinExtendedDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			types.ConversionFactor().SubRaw(1),
 			// integer + fractional
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(1000_999_999_999_999)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(1000_999_999_999_999)),
 		},
 		{
 			"non-extended denom - uatom returns uatom",
-			types.IntegerCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.ZeroInt(),
-			sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000)),
+			sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000)),
 		},
 		{
 			"non-extended denom - unaffected by fractional balance",
-			types.IntegerCoinDenom(),
-			sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000))),
+			evmtypes.GetEVMCoinDenom(),
+			sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000))),
 			sdkmath.NewInt(100),
-			sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1000)),
+			sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1000)),
 		},
 		{
 			"unrelated denom - no fractional",
@@ -212,19 +213,19 @@ func TestKeeper_SpendableCoin(t *testing.T) {
 			tk.keeper.SetFractionalBalance(tk.ctx, addr, tt.giveFractionalBal)
 
 			// If its a reserve denom, module address is checked
-			if tt.giveDenom == types.ExtendedCoinDenom() {
+			if tt.giveDenom == evmtypes.GetEVMCoinExtendedDenom() {
 				tk.ak.EXPECT().GetModuleAddress(types.ModuleName).
 					Return(authtypes.NewModuleAddress(types.ModuleName)).
 					Once()
 			}
 
-			if tt.giveDenom == types.ExtendedCoinDenom() {
+			if tt.giveDenom == evmtypes.GetEVMCoinExtendedDenom() {
 				// No balance pass through
 				tk.bk.EXPECT().
-					SpendableCoin(tk.ctx, addr, types.IntegerCoinDenom()).
+					SpendableCoin(tk.ctx, addr, evmtypes.GetEVMCoinDenom()).
 					RunAndReturn(func(_ context.Context, _ sdk.AccAddress, _ string) sdk.Coin {
-						amt := tt.giveBankBal.AmountOf(types.IntegerCoinDenom())
-						return sdk.NewCoin(types.IntegerCoinDenom(), amt)
+						amt := tt.giveBankBal.AmountOf(evmtypes.GetEVMCoinDenom())
+						return sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amt)
 					}).
 					Once()
 			} else {
@@ -264,13 +265,13 @@ func TestHiddenReserve(t *testing.T) {
 	}{
 		{
 			"aatom",
-			types.ExtendedCoinDenom(),
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.ZeroInt()),
+			evmtypes.GetEVMCoinExtendedDenom(),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.ZeroInt()),
 		},
 		{
 			"uatom",
-			types.IntegerCoinDenom(),
-			sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1)),
+			evmtypes.GetEVMCoinDenom(),
+			sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1)),
 		},
 		{
 			"unrelated denom",
@@ -282,7 +283,7 @@ func TestHiddenReserve(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 2 calls for GetBalance and SpendableCoin, only for reserve coins
-			if tt.denom == types.ExtendedCoinDenom() {
+			if tt.denom == evmtypes.GetEVMCoinExtendedDenom() {
 				tk.ak.EXPECT().GetModuleAddress(types.ModuleName).
 					Return(moduleAddr).
 					Twice()
@@ -1,6 +1,8 @@
 package types
 
 import (
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,16 +12,22 @@ import (
 // amount in extended coins. This is intended to get the full value to emit in
 // events.
 func SumExtendedCoin(amt sdk.Coins) sdk.Coin {
+	return SumExtendedCoinWith(amt, evmtypes.GetEVMCoinInfo())
+}
+
+// SumExtendedCoinWith returns a sdk.Coin of the extended denom of the given
+// EVM coin with all integer and fractional amounts combined.
+func SumExtendedCoinWith(amt sdk.Coins, coinInfo evmtypes.EvmCoinInfo) sdk.Coin {
 	// uatom converted to aatom
-	integerAmount := amt.AmountOf(IntegerCoinDenom()).Mul(ConversionFactor())
+	integerAmount := amt.AmountOf(coinInfo.Denom).Mul(CoinConversionFactor(coinInfo))
 	// aatom as is
-	extendedAmount := amt.AmountOf(ExtendedCoinDenom())
+	extendedAmount := amt.AmountOf(coinInfo.ExtendedDenom)
 
 	// total of uatom and aatom amounts
 	fullEmissionAmount := integerAmount.Add(extendedAmount)
 
 	return sdk.NewCoin(
-		ExtendedCoinDenom(),
+		coinInfo.ExtendedDenom,
 		fullEmissionAmount,
 	)
 }
@@ -30,25 +30,25 @@ func TestSumExtendedCoin(t *testing.T) {
 		{
 			"empty",
 			sdk.NewCoins(),
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.ZeroInt()),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.ZeroInt()),
 		},
 		{
 			"only integer",
-			sdk.NewCoins(sdk.NewInt64Coin(types.IntegerCoinDenom(), 100)),
-			sdk.NewCoin(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(100)),
+			sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 100)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(100)),
 		},
 		{
 			"only extended",
-			sdk.NewCoins(sdk.NewInt64Coin(types.ExtendedCoinDenom(), 100)),
-			sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.NewInt(100)),
+			sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinExtendedDenom(), 100)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewInt(100)),
 		},
 		{
 			"integer and extended",
 			sdk.NewCoins(
-				sdk.NewInt64Coin(types.IntegerCoinDenom(), 100),
-				sdk.NewInt64Coin(types.ExtendedCoinDenom(), 100),
+				sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 100),
+				sdk.NewInt64Coin(evmtypes.GetEVMCoinExtendedDenom(), 100),
 			),
-			sdk.NewCoin(types.ExtendedCoinDenom(), types.ConversionFactor().MulRaw(100).AddRaw(100)),
+			sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), types.ConversionFactor().MulRaw(100).AddRaw(100)),
 		},
 	}
 
@@ -14,22 +14,17 @@ import (
 // fractional balance to integer balances. This is also 1 greater than the max
 // valid fractional amount (999_999_999_999):
 // 0 < FractionalBalance < conversionFactor
+//
+// It is the one of the EVM coin set on startup through the EVMConfigurator.
+// The keeper uses the one of the EVM coin of its app instead.
 func ConversionFactor() sdkmath.Int {
-	return sdkmath.NewIntFromBigInt(evmtypes.GetEVMCoinDecimals().ConversionFactor().BigInt())
+	return CoinConversionFactor(evmtypes.GetEVMCoinInfo())
 }
 
-// IntegerCoinDenom is the denomination for integer coins that are managed by
-// x/bank. This is the "true" denomination of the coin, and is also used for
-// the reserve to back all fractional coins.
-func IntegerCoinDenom() string {
-	return evmtypes.GetEVMCoinDenom()
-}
-
-// ExtendedCoinDenom is the denomination for the extended IntegerCoinDenom. This
-// not only represents the fractional balance, but the total balance of
-// integer + fractional balances.
-func ExtendedCoinDenom() string {
-	return evmtypes.GetEVMCoinExtendedDenom()
+// CoinConversionFactor returns a copy of the conversionFactor of the given EVM
+// coin.
+func CoinConversionFactor(coinInfo evmtypes.EvmCoinInfo) sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(coinInfo.Decimals.ConversionFactor().BigInt())
 }
 
 // FractionalBalance returns a new FractionalBalance with the given address and
@@ -44,18 +39,30 @@ func NewFractionalBalance(address string, amount sdkmath.Int) FractionalBalance
 // Validate returns an error if the FractionalBalance has an invalid address or
 // negative amount.
 func (fb FractionalBalance) Validate() error {
+	return fb.ValidateWith(ConversionFactor())
+}
+
+// ValidateWith returns an error if the FractionalBalance has an invalid address
+// or an amount out of range for the given conversion factor.
+func (fb FractionalBalance) ValidateWith(conversionFactor sdkmath.Int) error {
 	if _, err := sdk.AccAddressFromBech32(fb.Address); err != nil {
 		return err
 	}
 
 	// Validate the amount with the FractionalAmount wrapper
-	return ValidateFractionalAmount(fb.Amount)
+	return ValidateFractionalAmountWith(fb.Amount, conversionFactor)
 }
 
 // ValidateFractionalAmount checks if an sdkmath.Int is a valid fractional
 // amount, ensuring it is positive and less than or equal to the maximum
 // fractional amount.
 func ValidateFractionalAmount(amt sdkmath.Int) error {
+	return ValidateFractionalAmountWith(amt, ConversionFactor())
+}
+
+// ValidateFractionalAmountWith checks if an sdkmath.Int is a valid fractional
+// amount for the given conversion factor.
+func ValidateFractionalAmountWith(amt sdkmath.Int, conversionFactor sdkmath.Int) error {
 	if amt.IsNil() {
 		return fmt.Errorf("nil amount")
 	}
@@ -64,8 +71,8 @@ func ValidateFractionalAmount(amt sdkmath.Int) error {
 		return fmt.Errorf("non-positive amount %v", amt)
 	}
 
-	if amt.GTE(ConversionFactor()) {
-		return fmt.Errorf("amount %v exceeds max of %v", amt, ConversionFactor().SubRaw(1))
+	if amt.GTE(conversionFactor) {
+		return fmt.Errorf("amount %v exceeds max of %v", amt, conversionFactor.SubRaw(1))
 	}
 
 	return nil
@@ -12,11 +12,17 @@ type FractionalBalances []FractionalBalance
 
 // Validate returns an error if any FractionalBalance in the slice is invalid.
 func (fbs FractionalBalances) Validate() error {
+	return fbs.ValidateWith(ConversionFactor())
+}
+
+// ValidateWith returns an error if any FractionalBalance in the slice is
+// invalid for the given conversion factor.
+func (fbs FractionalBalances) ValidateWith(conversionFactor sdkmath.Int) error {
 	seenAddresses := make(map[string]struct{})
 
 	for _, fb := range fbs {
 		// Individual FractionalBalance validation
-		if err := fb.Validate(); err != nil {
+		if err := fb.ValidateWith(conversionFactor); err != nil {
 			return fmt.Errorf("invalid fractional balance for %s: %w", fb.Address, err)
 		}
 
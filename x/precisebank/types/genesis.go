@@ -25,8 +25,14 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic validation of genesis data returning an  error for
 // any failed validation criteria.
 func (gs *GenesisState) Validate() error {
+	return gs.ValidateWith(ConversionFactor())
+}
+
+// ValidateWith performs basic validation of genesis data for the given
+// conversion factor.
+func (gs *GenesisState) ValidateWith(conversionFactor sdkmath.Int) error {
 	// Validate all FractionalBalances
-	if err := gs.Balances.Validate(); err != nil {
+	if err := gs.Balances.ValidateWith(conversionFactor); err != nil {
 		return fmt.Errorf("invalid balances: %w", err)
 	}
 
@@ -39,8 +45,8 @@ func (gs *GenesisState) Validate() error {
 		return fmt.Errorf("negative remainder amount %s", gs.Remainder)
 	}
 
-	if gs.Remainder.GTE(ConversionFactor()) {
-		return fmt.Errorf("remainder %v exceeds max of %v", gs.Remainder, ConversionFactor().SubRaw(1))
+	if gs.Remainder.GTE(conversionFactor) {
+		return fmt.Errorf("remainder %v exceeds max of %v", gs.Remainder, conversionFactor.SubRaw(1))
 	}
 
 	// Determine if sum(fractionalBalances) + remainder = whole integer value
@@ -48,14 +54,14 @@ func (gs *GenesisState) Validate() error {
 	sum := gs.Balances.SumAmount()
 	sumWithRemainder := sum.Add(gs.Remainder)
 
-	offBy := sumWithRemainder.Mod(ConversionFactor())
+	offBy := sumWithRemainder.Mod(conversionFactor)
 
 	if !offBy.IsZero() {
 		return fmt.Errorf(
 			"sum of fractional balances %v + remainder %v is not a multiple of %v",
 			sum,
 			gs.Remainder,
-			ConversionFactor(),
+			conversionFactor,
 		)
 	}
 
@@ -74,9 +74,18 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			baseDenom := types.GetEVMCoinDenom()
+			// the fees are paid in the EVM coin of the chain
+			configRes, err := types.NewQueryClient(clientCtx).Config(cmd.Context(), &types.QueryConfigRequest{})
+			if err != nil {
+				return errors.Wrap(err, "failed to query the EVM coin denom")
+			}
+			coinInfo := types.EvmCoinInfo{
+				Denom:         configRes.Config.Denom,
+				ExtendedDenom: configRes.ExtendedDenom,
+				Decimals:      types.Decimals(configRes.Config.Decimals), //#nosec G115 -- validated by the chain config
+			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), baseDenom)
+			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), coinInfo)
 			if err != nil {
 				return err
 			}
@@ -61,6 +61,22 @@ func InitGenesis(
 		panic(fmt.Errorf("error adding preinstalls: %s", err))
 	}
 
+	for _, contract := range data.UnbondingCallbacks {
+		k.SetUnbondingCallback(ctx, common.HexToAddress(contract), true)
+	}
+
+	// the pending callbacks are keyed by the height 0 to be called before the
+	// ones queued by the next blocks
+	for i, callback := range data.PendingUnbondingCallbacks {
+		k.SetPendingUnbondingCallback(ctx, 0, uint64(i), callback)
+	}
+
+	if data.ChainConfig != nil {
+		if err := k.SetChainConfig(ctx, *data.ChainConfig); err != nil {
+			panic(fmt.Errorf("error setting chain config: %s", err))
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -80,8 +96,21 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	var chainConfig *types.ChainConfig
+	if k.HasChainConfig(ctx) {
+		chainConfig = k.GetChainConfig(ctx)
+	}
+
+	var unbondingCallbacks []string
+	for _, contract := range k.GetUnbondingCallbacks(ctx) {
+		unbondingCallbacks = append(unbondingCallbacks, contract.Hex())
+	}
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:                  ethGenAccounts,
+		Params:                    k.GetParams(ctx),
+		ChainConfig:               chainConfig,
+		UnbondingCallbacks:        unbondingCallbacks,
+		PendingUnbondingCallbacks: k.GetPendingUnbondingCallbacks(ctx),
 	}
 }
@@ -10,10 +10,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock writes the parent block hash into the EIP-2935 history storage
+// and emits a base fee event which will be adjusted to the evm decimals. The
+// PREVRANDAO value of the block is mixed by the PreBlocker of the app, see
+// UpdatePrevRandao.
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
+	k.ProcessParentBlockHash(ctx)
+
 	// Base fee is already set on FeeMarket BeginBlock
 	// that runs before this one
 	// We emit this event on the EVM and FeeMarket modules
@@ -34,10 +39,13 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	return nil
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
+// EndBlock calls the onUnbondingCompleted callbacks of the registered contracts, then
+// retrieves the bloom filter value from the transient store and commits it to the
 // KVStore. The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
+	k.ProcessUnbondingCallbacks(ctx)
+
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 
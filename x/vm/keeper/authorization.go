@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// applyAuthorizations applies the EIP-7702 code delegations of the message to the
+// state. Invalid authorizations are skipped without invalidating the transaction,
+// as defined in the specification.
+func (k *Keeper) applyAuthorizations(ctx sdk.Context, stateDB vm.StateDB, chainID *big.Int, authList []ethtypes.SetCodeAuthorization) {
+	for i := range authList {
+		if err := applyAuthorization(stateDB, chainID, &authList[i]); err != nil {
+			k.Logger(ctx).Debug("skipping invalid set code authorization", "index", i, "error", err.Error())
+		}
+	}
+}
+
+// validateAuthorization validates an EIP-7702 authorization and its authority
+// account against the state and returns the authority address that signed it.
+func validateAuthorization(stateDB vm.StateDB, chainID *big.Int, auth *ethtypes.SetCodeAuthorization) (authority common.Address, err error) {
+	authority, err = types.ValidateAuthorization(chainID, *auth)
+	if err != nil {
+		return authority, err
+	}
+	// Check the authority account
+	//  1) doesn't have code or has existing delegation
+	//  2) matches the auth's nonce
+	//
+	// Note it is added to the access list even if the authorization is invalid.
+	stateDB.AddAddressToAccessList(authority)
+	code := stateDB.GetCode(authority)
+	if _, ok := ethtypes.ParseDelegation(code); len(code) != 0 && !ok {
+		return authority, core.ErrAuthorizationDestinationHasCode
+	}
+	if have := stateDB.GetNonce(authority); have != auth.Nonce {
+		return authority, core.ErrAuthorizationNonceMismatch
+	}
+	return authority, nil
+}
+
+// applyAuthorization applies an EIP-7702 code delegation to the state.
+func applyAuthorization(stateDB vm.StateDB, chainID *big.Int, auth *ethtypes.SetCodeAuthorization) error {
+	authority, err := validateAuthorization(stateDB, chainID, auth)
+	if err != nil {
+		return err
+	}
+
+	// If the account already exists in state, refund the new account cost
+	// charged in the intrinsic calculation.
+	if stateDB.Exist(authority) {
+		stateDB.AddRefund(params.CallNewAccountGas - params.TxAuthTupleGas)
+	}
+
+	// Update nonce and account code.
+	stateDB.SetNonce(authority, auth.Nonce+1, tracing.NonceChangeAuthorization)
+	if auth.Address == (common.Address{}) {
+		// Delegation to zero address means clear.
+		stateDB.SetCode(authority, nil)
+		return nil
+	}
+
+	// Otherwise install delegation to auth.Address.
+	stateDB.SetCode(authority, ethtypes.AddressToDelegation(auth.Address))
+	return nil
+}
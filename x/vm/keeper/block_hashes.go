@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockHashHistoryWindow is the size of the ring buffer of the EIP-2935
+// history storage contract, i.e. the number of past block hashes it serves.
+const blockHashHistoryWindow = 8191
+
+// ProcessParentBlockHash writes the parent block hash into the EIP-2935
+// history storage contract, as the system call of the EIP does before the
+// transactions of a block. It is a no-op until the contract is installed.
+//
+// The block header of the context doesn't carry the parent block id, so the
+// hash of each block is recorded on its BeginBlock and written to the contract
+// on the next one.
+func (k *Keeper) ProcessParentBlockHash(ctx sdk.Context) {
+	headerHash := ctx.HeaderHash()
+	if len(headerHash) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	parentHash := store.Get(types.KeyPrefixHeaderHash)
+	store.Set(types.KeyPrefixHeaderHash, headerHash)
+
+	if len(parentHash) == 0 || !k.IsContract(ctx, params.HistoryStorageAddress) {
+		return
+	}
+
+	number := uint64(ctx.BlockHeight() - 1) //#nosec G115 -- a parent hash is only recorded past the first block
+	slot := common.BigToHash(new(big.Int).SetUint64(number % blockHashHistoryWindow))
+	k.SetState(ctx, params.HistoryStorageAddress, slot, parentHash)
+}
+
+// GetHistoricalBlockHash returns the hash of a past block from the EIP-2935
+// history storage contract. It returns an empty hash if the block is not
+// within the window served by the contract.
+func (k *Keeper) GetHistoricalBlockHash(ctx sdk.Context, height int64) common.Hash {
+	if height <= 0 || height >= ctx.BlockHeight() || ctx.BlockHeight()-height > blockHashHistoryWindow {
+		return common.Hash{}
+	}
+
+	slot := common.BigToHash(big.NewInt(height % blockHashHistoryWindow))
+	return k.GetState(ctx, params.HistoryStorageAddress, slot)
+}
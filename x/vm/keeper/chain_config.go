@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"bytes"
+	"sync"
+
+	gethparams "github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/evm/x/vm/wrappers"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// chainConfigCache holds the chain config stored by governance together with
+// its encoding. The cached config is only returned while the stored bytes are
+// the same, so that it can't be stale after a reverted or uncommitted update.
+type chainConfigCache struct {
+	mu          sync.Mutex
+	bz          []byte
+	chainConfig types.ChainConfig
+}
+
+// get returns a copy of the cached chain config if it was decoded from bz.
+func (c *chainConfigCache) get(bz []byte) (*types.ChainConfig, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.bz == nil || !bytes.Equal(c.bz, bz) {
+		return nil, false
+	}
+	chainConfig := c.chainConfig
+	return &chainConfig, true
+}
+
+// set caches the chain config decoded from bz.
+func (c *chainConfigCache) set(bz []byte, chainConfig types.ChainConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bz = bytes.Clone(bz)
+	c.chainConfig = chainConfig
+}
+
+// WithEVMAppConfig sets the EVM coin and chain configuration of the app, used
+// instead of the process globals set by the EVMConfigurator. It allows several
+// apps with different configurations to run in one process.
+func (k *Keeper) WithEVMAppConfig(config *types.EVMAppConfig) *Keeper {
+	if k.evmAppConfig != nil {
+		panic("evm app config already set")
+	}
+
+	k.evmAppConfig = config
+	if bankWrapper, ok := k.bankWrapper.(*wrappers.BankWrapper); ok {
+		bankWrapper.WithEVMCoinInfo(config.CoinInfo)
+	}
+	k.feeMarketWrapper.WithEVMCoinInfo(config.CoinInfo)
+	return k
+}
+
+// EVMAppConfig returns the EVM coin and chain configuration of the app. It
+// defaults to the one set on startup through the EVMConfigurator.
+func (k Keeper) EVMAppConfig() *types.EVMAppConfig {
+	if k.evmAppConfig == nil {
+		return types.GlobalEVMAppConfig()
+	}
+	return k.evmAppConfig
+}
+
+// GetEVMCoinInfo returns the EVM coin of the app.
+func (k Keeper) GetEVMCoinInfo() types.EvmCoinInfo {
+	return k.EVMAppConfig().CoinInfo
+}
+
+// GetChainConfig returns the chain config of the current block. It is the one
+// stored by governance if any, and otherwise the one of the app. The chain
+// config is read on every EVM call, so the read isn't metered and the decoded
+// config is cached until the stored one changes.
+func (k Keeper) GetChainConfig(ctx sdk.Context) *types.ChainConfig {
+	var chainConfig types.ChainConfig
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixChainConfig)
+	if bz == nil {
+		// copy the app config so that callers can't modify it
+		chainConfig = *k.EVMAppConfig().ChainConfig
+		return &chainConfig
+	}
+
+	if k.chainConfigCache != nil {
+		if cached, ok := k.chainConfigCache.get(bz); ok {
+			return cached
+		}
+	}
+
+	k.cdc.MustUnmarshal(bz, &chainConfig)
+	if k.chainConfigCache != nil {
+		k.chainConfigCache.set(bz, chainConfig)
+	}
+	return &chainConfig
+}
+
+// GetEthChainConfig returns the chain config of the current block as used in
+// the EVM (geth type).
+func (k Keeper) GetEthChainConfig(ctx sdk.Context) *gethparams.ChainConfig {
+	return k.GetChainConfig(ctx).EthereumConfig(nil)
+}
+
+// HasChainConfig returns true if a chain config is stored, overriding the one
+// of the app.
+func (k Keeper) HasChainConfig(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyPrefixChainConfig)
+}
+
+// SetChainConfig validates and stores the chain config, which then overrides
+// the one of the app.
+func (k Keeper) SetChainConfig(ctx sdk.Context, chainConfig types.ChainConfig) error {
+	if err := chainConfig.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&chainConfig)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixChainConfig, bz)
+	if k.chainConfigCache != nil {
+		// invalidate the cache with the new config
+		k.chainConfigCache.set(bz, chainConfig)
+	}
+	return nil
+}
@@ -26,9 +26,10 @@ func (k *Keeper) EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress) (*s
 
 	baseFee := k.GetBaseFee(ctx)
 	return &statedb.EVMConfig{
-		Params:   params,
-		CoinBase: coinbase,
-		BaseFee:  baseFee,
+		Params:                  params,
+		CoinBase:                coinbase,
+		BaseFee:                 baseFee,
+		EnablePreimageRecording: k.preimageDB != nil,
 	}, nil
 }
 
@@ -46,7 +47,7 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 // module parameters. The config generated uses the default JumpTable from the EVM.
 func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig, tracer *tracing.Hooks) vm.Config {
 	noBaseFee := true
-	if types.IsLondon(types.GetEthChainConfig(), ctx.BlockHeight()) {
+	if types.IsLondon(k.GetEthChainConfig(ctx), ctx.BlockHeight()) {
 		noBaseFee = k.feeMarketWrapper.GetParams(ctx).NoBaseFee
 	}
 
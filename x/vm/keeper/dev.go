@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MaxDevStateDumpEntries is the maximum number of accounts and storage slots
+// returned by the DevStateDump query, which backs the evm_snapshot cheat code.
+const MaxDevStateDumpEntries = 100_000
+
+// checkDevMode returns an error if the dev mode is disabled in the params.
+func (k Keeper) checkDevMode(ctx sdk.Context) error {
+	if !k.GetParams(ctx).DevMode {
+		return errorsmod.Wrap(types.ErrDevModeDisabled, "dev operations are only allowed in dev mode")
+	}
+	return nil
+}
+
+// checkDevAuthority returns an error if the dev mode is disabled or if the
+// sender of a dev message is not the dev authority of the params. The dev
+// messages are restricted to the module authority when no dev authority is set.
+func (k Keeper) checkDevAuthority(ctx sdk.Context, sender string) error {
+	params := k.GetParams(ctx)
+	if !params.DevMode {
+		return errorsmod.Wrap(types.ErrDevModeDisabled, "dev operations are only allowed in dev mode")
+	}
+
+	authority := params.DevAuthority
+	if authority == "" {
+		authority = k.authority.String()
+	}
+	if sender != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid dev authority, expected %s, got %s", authority, sender)
+	}
+	return nil
+}
+
+// GetDevTimeOffset returns the offset in seconds added to the block time seen
+// by the EVM in dev mode.
+func (k Keeper) GetDevTimeOffset(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixDevTimeOffset)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetDevTimeOffset sets the offset in seconds added to the block time seen by
+// the EVM in dev mode.
+func (k Keeper) SetDevTimeOffset(ctx sdk.Context, offset uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixDevTimeOffset, sdk.Uint64ToBigEndian(offset))
+}
+
+// DumpState returns the balance, nonce, code and storage of all the accounts
+// as state overrides, which restore the current state once applied. The module
+// accounts are skipped, since their balances are tracked by their modules. It
+// returns an error once more than maxEntries accounts and storage slots are
+// dumped, so that a large state isn't loaded in memory.
+func (k *Keeper) DumpState(ctx sdk.Context, maxEntries int) (types.StateOverride, error) {
+	var (
+		dump    = make(types.StateOverride)
+		entries int
+	)
+	tooLarge := func() bool {
+		entries++
+		return entries > maxEntries
+	}
+
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		if _, ok := account.(sdk.ModuleAccountI); ok {
+			return false
+		}
+		if tooLarge() {
+			return true
+		}
+
+		addr := common.BytesToAddress(account.GetAddress())
+		acct := k.GetAccountOrEmpty(ctx, addr)
+
+		nonce := hexutil.Uint64(acct.Nonce)
+		balance := (*hexutil.Big)(acct.Balance.ToBig())
+		code := hexutil.Bytes(k.GetCode(ctx, common.BytesToHash(acct.CodeHash)))
+		state := make(map[common.Hash]common.Hash)
+		k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+			if tooLarge() {
+				return false
+			}
+			state[key] = value
+			return true
+		})
+
+		dump[addr] = types.OverrideAccount{
+			Nonce:   &nonce,
+			Code:    &code,
+			Balance: &balance,
+			State:   &state,
+		}
+		return entries > maxEntries
+	})
+
+	if entries > maxEntries {
+		return nil, errorsmod.Wrapf(types.ErrDevStateTooLarge, "more than %d accounts and storage slots", maxEntries)
+	}
+	return dump, nil
+}
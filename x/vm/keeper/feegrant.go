@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithFeegrantKeeper sets the x/feegrant keeper used to give back to the fee
+// allowances the fees of the leftover gas of sponsored txs, and to charge them
+// when sponsored txs are re-executed by IntermediateRoots.
+func (k *Keeper) WithFeegrantKeeper(feegrantKeeper types.FeegrantKeeper) *Keeper {
+	if k.feegrantKeeper != nil {
+		panic("feegrant keeper already set")
+	}
+
+	k.feegrantKeeper = feegrantKeeper
+	return k
+}
+
+// restoreAllowance adds the refunded fees back to the allowance granted by the
+// fee payer to the sender of a sponsored tx. An allowance used up by the tx
+// has been removed by x/feegrant and is not granted again.
+func (k Keeper) restoreAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error {
+	if k.feegrantKeeper == nil {
+		return nil
+	}
+
+	allowance, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil || allowance == nil {
+		return nil
+	}
+
+	restored, err := addToAllowance(allowance, refund)
+	if err != nil {
+		return err
+	}
+	return k.feegrantKeeper.UpdateAllowance(ctx, granter, grantee, restored)
+}
+
+// addToAllowance returns the allowance with the given coins added back to its
+// spend limits. The limits of the current period of a periodic allowance are
+// capped to the period spend limit.
+func addToAllowance(allowance feegrant.FeeAllowanceI, coins sdk.Coins) (feegrant.FeeAllowanceI, error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		// an empty spend limit is unlimited
+		if !a.SpendLimit.Empty() {
+			a.SpendLimit = a.SpendLimit.Add(coins...)
+		}
+		return a, nil
+	case *feegrant.PeriodicAllowance:
+		if !a.Basic.SpendLimit.Empty() {
+			a.Basic.SpendLimit = a.Basic.SpendLimit.Add(coins...)
+		}
+		a.PeriodCanSpend = a.PeriodCanSpend.Add(coins...).Min(a.PeriodSpendLimit)
+		return a, nil
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		restored, err := addToAllowance(inner, coins)
+		if err != nil {
+			return nil, err
+		}
+		msg, ok := restored.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot proto marshal %T", restored)
+		}
+		restoredAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		a.Allowance = restoredAny
+		return a, nil
+	default:
+		// the custom allowances are left as charged
+		return allowance, nil
+	}
+}
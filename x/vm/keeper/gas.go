@@ -28,10 +28,11 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 		homestead, istanbul, shanghai)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, capped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
-// returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// RefundGas transfers the leftover gas to the sender of the message, or to the fee payer of a
+// sponsored tx along with the fee allowance it was charged, capped to half of the total gas
+// consumed in the transaction. Additionally, the function sets the total gas consumed to the
+// value returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during
+// in the AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
@@ -44,12 +45,26 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		// refund to sender, or to the fee payer of a sponsored tx, from the fee collector module account,
+		// which is the escrow account in charge of collecting tx fees
+		sender := sdk.AccAddress(msg.From.Bytes())
+		recipient := sender
+		if feePayer := k.GetFeePayerTransient(ctx); feePayer != nil {
+			recipient = feePayer
+		}
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+
+		// the allowance of a sponsored tx was charged with the fees of the
+		// whole gas limit by the ante handler
+		if !recipient.Equals(sender) {
+			if err := k.restoreAllowance(ctx, recipient, sender, k.GetEVMCoinInfo().ConvertCoinsDenomToExtendedDenom(refundedCoins)); err != nil {
+				return errorsmod.Wrapf(err, "failed to restore the fee allowance of %s to %s", recipient, sender)
+			}
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
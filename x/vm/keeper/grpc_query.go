@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
@@ -35,6 +36,12 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+	// defaultPendingBlockTimeout is the default timeout for building the
+	// pending block.
+	defaultPendingBlockTimeout = 5 * time.Second
+	// maxAccessListIterations is the maximum number of executions of the message
+	// done by CreateAccessList for its access list to converge.
+	maxAccessListIterations = 10
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -231,6 +238,11 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ctx, err = k.ApplyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -256,6 +268,236 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api. The call is
+// executed repeatedly with the access list collected on the previous run until
+// the list stops changing.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, err = k.ApplyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the sender, the recipient and the precompiles are always warm, so they
+	// are excluded from the access list
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+	excluded := map[common.Address]struct{}{from: {}, to: {}}
+	rules := k.GetEthChainConfig(ctx).Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	for _, addr := range vm.ActivePrecompiles(rules) {
+		excluded[addr] = struct{}{}
+	}
+	for _, addr := range cfg.Params.GetActiveStaticPrecompilesAddrs() {
+		excluded[addr] = struct{}{}
+	}
+
+	var prevList ethtypes.AccessList
+	if args.AccessList != nil {
+		prevList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(prevList, excluded)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	for i := 0; ; i++ {
+		// stop if the request is canceled or the access list doesn't converge
+		if err := ctx.Context().Err(); err != nil {
+			return nil, status.Error(codes.Canceled, err.Error())
+		}
+		if i == maxAccessListIterations {
+			return nil, status.Errorf(codes.Internal, "access list did not converge after %d iterations", maxAccessListIterations)
+		}
+
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee, false, false)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// pass false to not commit StateDB
+		tracer := logger.NewAccessListTracer(accessList, excluded)
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer.Hooks(), false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
+// SimulateV1 implements eth_simulateV1 rpc api.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var opts types.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the simulated blocks are built on top of the requested block, whose hash
+	// is the parent hash of the first one
+	if req.BlockNumber > 0 {
+		ctx = ctx.
+			WithBlockHeight(req.BlockNumber).
+			WithBlockTime(req.BlockTime).
+			WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	parent := &ethtypes.Header{
+		Number: big.NewInt(ctx.BlockHeight()),
+		Time:   uint64(ctx.BlockTime().Unix()), //#nosec G115 -- int overflow is not a concern here
+	}
+	parentHash := k.GetHashFn(ctx)(parent.Number.Uint64())
+
+	// the simulated blocks share a cached context, so that the state changes
+	// are chained between calls but never written to the parent context
+	cacheCtx, _ := ctx.CacheContext()
+	sim := &simulator{
+		k:            &k,
+		cfg:          cfg,
+		opts:         opts,
+		gasCap:       req.GasCap,
+		gasRemaining: req.GasCap,
+	}
+
+	results, err := sim.run(cacheCtx, parent, parentHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateV1Response{Result: bz}, nil
+}
+
+// PendingBlock builds the pending block by executing the given mempool
+// transactions on top of the latest state, and optionally executes a call on
+// top of it. The state changes are never written to the parent context.
+//
+// The gas used by the pending transactions is capped by the gas cap, if any,
+// and the query fails if it doesn't complete before the timeout.
+func (k Keeper) PendingBlock(c context.Context, req *types.QueryPendingBlockRequest) (*types.QueryPendingBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	timeout := defaultPendingBlockTimeout
+	if req.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(req.Timeout); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
+		}
+	}
+
+	// Handle timeouts and RPC cancellations
+	deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
+	defer cancel()
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithBlockHeight(ctx.BlockHeight() + 1).WithContext(deadlineCtx)
+
+	txResponses, gasUsed, err := k.applyPendingTxs(cacheCtx, cfg, req.Txs, req.GasCap)
+	if err != nil {
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	res := &types.QueryPendingBlockResponse{
+		TxResponses: txResponses,
+		GasUsed:     gasUsed,
+	}
+	if len(req.Args) == 0 {
+		return res, nil
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cacheCtx, err = k.ApplyStateOverrides(cacheCtx, req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(cacheCtx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee, false, false)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.Hash{})
+	txConfig.TxIndex = uint(len(txResponses))
+
+	if err := deadlineCtx.Err(); err != nil {
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	// pass false to not commit StateDB
+	res.Call, err = k.ApplyMessageWithConfig(cacheCtx, msg, nil, false, cfg, txConfig)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	return k.EstimateGasInternal(c, req, types.RPC)
@@ -283,6 +525,11 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ctx, err = k.ApplyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -328,7 +575,7 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 
 	// Recap the highest gas limit with account's available balance.
 	if msg.GasFeeCap.BitLen() != 0 {
-		baseDenom := types.GetEVMCoinDenom()
+		baseDenom := k.GetEVMCoinInfo().Denom
 
 		balance := k.bankWrapper.GetBalance(ctx, sdk.AccAddress(args.From.Bytes()), baseDenom)
 		available := balance.Amount
@@ -465,7 +712,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
@@ -559,7 +806,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
@@ -590,6 +837,78 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// IntermediateRoots implements the Query/IntermediateRoots gRPC method. It re-executes
+// the Ethereum transactions of a block on top of the state of its parent and returns,
+// for each transaction, the hash of the accounts and storage slots touched by it.
+func (k Keeper) IntermediateRoots(c context.Context, req *types.QueryIntermediateRootsRequest) (*types.QueryIntermediateRootsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// get the context of block beginning
+	contextHeight := req.BlockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	// to get the base fee we only need the block max gas in the consensus params
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+
+	// compute and use base fee of height that is being re-executed
+	baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	roots := make([][]byte, 0, len(req.Txs))
+	txErrors := make([]string, 0, len(req.Txs))
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	for i, tx := range req.Txs {
+		txConfig.TxHash = tx.AsTransaction().Hash()
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+
+		var feePayer sdk.AccAddress
+		if i < len(req.FeePayers) && req.FeePayers[i] != "" {
+			if feePayer, err = sdk.AccAddressFromBech32(req.FeePayers[i]); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid fee payer %s: %s", req.FeePayers[i], err)
+			}
+		}
+
+		// a failed transaction leaves the state unchanged, or only charges the
+		// fees and increments the nonce if it failed during the execution
+		touched, logs, err := k.intermediateTx(ctx, tx, feePayer, signer, cfg, txConfig)
+		if err != nil {
+			txErrors = append(txErrors, err.Error())
+		} else {
+			txErrors = append(txErrors, "")
+		}
+
+		txConfig.LogIndex += logs
+		roots = append(roots, k.touchedStateHash(ctx, touched).Bytes())
+	}
+
+	return &types.QueryIntermediateRootsResponse{
+		Roots:  roots,
+		Errors: txErrors,
+	}, nil
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -618,7 +937,7 @@ func (k *Keeper) traceTx(
 	}
 
 	if traceConfig.Overrides != nil {
-		overrides = traceConfig.Overrides.EthereumConfig(types.GetEthChainConfig().ChainID)
+		overrides = traceConfig.Overrides.EthereumConfig(k.GetEthChainConfig(ctx).ChainID)
 	}
 
 	logConfig := logger.Config{
@@ -645,7 +964,7 @@ func (k *Keeper) traceTx(
 
 	if traceConfig.Tracer != "" {
 		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, tracerJSONConfig,
-			types.GetEthChainConfig()); err != nil {
+			k.GetEthChainConfig(ctx)); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -685,6 +1004,47 @@ func (k *Keeper) traceTx(
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
+// Preimage implements the Query/Preimage gRPC method
+func (k Keeper) Preimage(_ context.Context, req *types.QueryPreimageRequest) (*types.QueryPreimageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := hexutil.Decode(req.Hash)
+	if err != nil || len(hash) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hash %s", req.Hash)
+	}
+
+	preimage, err := k.GetPreimage(common.BytesToHash(hash))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPreimageResponse{Preimage: preimage}, nil
+}
+
+// PrevRandao implements the Query/PrevRandao gRPC method. The values are
+// predictable until vote extensions are enabled, see UpdatePrevRandao.
+func (k Keeper) PrevRandao(c context.Context, req *types.QueryPrevRandaoRequest) (*types.QueryPrevRandaoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+	if req.Height == 0 {
+		return &types.QueryPrevRandaoResponse{PrevRandao: k.GetPrevRandao(ctx).Hex()}, nil
+	}
+
+	prevRandao, found := k.GetPrevRandaoAtHeight(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "PREVRANDAO value of height %d not found", req.Height)
+	}
+	return &types.QueryPrevRandaoResponse{PrevRandao: prevRandao.Hex()}, nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -708,10 +1068,34 @@ func (k Keeper) GlobalMinGasPrice(c context.Context, _ *types.QueryGlobalMinGasP
 }
 
 // Config implements the Query/Config gRPC method
-func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
-	config := types.GetChainConfig()
-	config.Denom = types.GetEVMCoinDenom()
-	config.Decimals = uint64(types.GetEVMCoinDecimals())
+func (k Keeper) Config(c context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	config := k.GetChainConfig(ctx)
+	coinInfo := k.GetEVMCoinInfo()
+	config.Denom = coinInfo.Denom
+	config.Decimals = uint64(coinInfo.Decimals)
+
+	return &types.QueryConfigResponse{Config: config, ExtendedDenom: coinInfo.ExtendedDenom}, nil
+}
+
+// DevStateDump implements the Query/DevStateDump gRPC method. It returns the
+// state of all the EVM accounts and is only allowed in dev mode. It fails once
+// the state has more than MaxDevStateDumpEntries accounts and storage slots.
+func (k Keeper) DevStateDump(c context.Context, _ *types.QueryDevStateDumpRequest) (*types.QueryDevStateDumpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.checkDevMode(ctx); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	dump, err := k.DumpState(ctx, MaxDevStateDumpEntries)
+	if err != nil {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	bz, err := json.Marshal(dump)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryConfigResponse{Config: config}, nil
+	return &types.QueryDevStateDumpResponse{State: bz}, nil
 }
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// touchedState collects the accounts and storage slots touched during the
+// execution of a message.
+type touchedState struct {
+	accounts map[common.Address]map[common.Hash]struct{}
+}
+
+// newTouchedState returns a touchedState that already includes the sender and
+// recipient of the message, as well as the authorities of its EIP-7702
+// authorizations, since those are modified outside of the EVM execution.
+func newTouchedState(msg *core.Message) *touchedState {
+	ts := &touchedState{
+		accounts: make(map[common.Address]map[common.Hash]struct{}),
+	}
+
+	ts.touchAccount(msg.From)
+	if msg.To != nil {
+		ts.touchAccount(*msg.To)
+	}
+	for _, auth := range msg.SetCodeAuthorizations {
+		if authority, err := auth.Authority(); err == nil {
+			ts.touchAccount(authority)
+		}
+	}
+
+	return ts
+}
+
+func (ts *touchedState) touchAccount(addr common.Address) {
+	if _, ok := ts.accounts[addr]; !ok {
+		ts.accounts[addr] = make(map[common.Hash]struct{})
+	}
+}
+
+func (ts *touchedState) touchSlot(addr common.Address, key common.Hash) {
+	ts.touchAccount(addr)
+	ts.accounts[addr][key] = struct{}{}
+}
+
+// Hooks returns the tracing hooks that record the accounts entered by every
+// call frame and the storage slots written by the SSTORE opcode.
+func (ts *touchedState) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnEnter: func(_ int, _ byte, from common.Address, to common.Address, _ []byte, _ uint64, _ *big.Int) {
+			ts.touchAccount(from)
+			ts.touchAccount(to)
+		},
+		OnOpcode: func(_ uint64, op byte, _, _ uint64, scope tracing.OpContext, _ []byte, _ int, err error) {
+			if err != nil || vm.OpCode(op) != vm.SSTORE {
+				return
+			}
+			stack := scope.StackData()
+			if len(stack) == 0 {
+				return
+			}
+			ts.touchSlot(scope.Address(), common.Hash(stack[len(stack)-1].Bytes32()))
+		},
+	}
+}
+
+// intermediateTx executes the given transaction of a re-executed block as it is
+// during DeliverTx: the fees are deducted from the sender, or from the fee payer
+// of a sponsored tx, and the nonce of the sender is incremented before the
+// execution, and the leftover gas is refunded after it. It returns the state
+// touched by the transaction and the number of logs it emitted. The state is
+// left unchanged if the transaction is invalid, while the fees and nonce
+// increment are kept if its execution fails.
+func (k *Keeper) intermediateTx(
+	ctx sdk.Context,
+	tx *types.MsgEthereumTx,
+	feePayer sdk.AccAddress,
+	signer ethtypes.Signer,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*touchedState, uint, error) {
+	ethTx := tx.AsTransaction()
+	msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+	if err != nil {
+		return &touchedState{accounts: make(map[common.Address]map[common.Hash]struct{})}, 0, err
+	}
+	touched := newTouchedState(msg)
+
+	// the refund goes to the fee payer as during DeliverTx
+	k.SetFeePayerTransient(ctx, feePayer)
+	defer k.SetFeePayerTransient(ctx, nil)
+
+	evmDenom := k.GetEVMCoinInfo().Denom
+	if err := k.chargeIntermediateTx(ctx, tx, ethTx, msg, feePayer, cfg, evmDenom); err != nil {
+		return touched, 0, err
+	}
+
+	txCtx := evmante.BuildEvmExecutionCtx(ctx).
+		WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(msg.GasLimit))
+	res, err := k.ApplyMessageWithConfig(txCtx, *msg, touched.Hooks(), true, cfg, txConfig)
+	if err != nil {
+		return touched, 0, errorsmod.Wrap(err, "failed to apply ethereum core message")
+	}
+
+	remainingGas := uint64(0)
+	if msg.GasLimit > res.GasUsed {
+		remainingGas = msg.GasLimit - res.GasUsed
+	}
+	if err := k.RefundGas(ctx, *msg, remainingGas, evmDenom); err != nil {
+		return touched, uint(len(res.Logs)), errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
+	}
+
+	if res.Failed() {
+		return touched, uint(len(res.Logs)), errors.New(res.VmError)
+	}
+	return touched, uint(len(res.Logs)), nil
+}
+
+// chargeIntermediateTx deducts the fees of the transaction from the sender, or
+// from the fee payer out of the allowance it granted to the sender, and
+// increments the nonce of the sender, as the ante handler does. Nothing is
+// written if any of the checks fails.
+func (k *Keeper) chargeIntermediateTx(
+	ctx sdk.Context,
+	tx *types.MsgEthereumTx,
+	ethTx *ethtypes.Transaction,
+	msg *core.Message,
+	feePayer sdk.AccAddress,
+	cfg *statedb.EVMConfig,
+	evmDenom string,
+) error {
+	txData, err := types.NewTxDataFromTx(ethTx)
+	if err != nil {
+		return err
+	}
+
+	rules := k.GetEthChainConfig(ctx).Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	fees, err := VerifyFee(txData, evmDenom, cfg.BaseFee, rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai, false)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	payer := msg.From
+	if sender := sdk.AccAddress(msg.From.Bytes()); feePayer != nil && !feePayer.Equals(sender) {
+		if k.feegrantKeeper == nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+		}
+		// the allowances are in the extended denom the fees are deducted in
+		if err := k.feegrantKeeper.UseGrantedFees(
+			cacheCtx,
+			feePayer,
+			sender,
+			k.GetEVMCoinInfo().ConvertCoinsDenomToExtendedDenom(fees),
+			[]sdk.Msg{tx},
+		); err != nil {
+			return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feePayer, sender)
+		}
+		payer = common.BytesToAddress(feePayer)
+	}
+	if err := k.DeductTxCostsFromUserBalance(cacheCtx, fees, payer); err != nil {
+		return err
+	}
+
+	account := k.accountKeeper.GetAccount(cacheCtx, msg.From.Bytes())
+	if account == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", msg.From)
+	}
+	if account.GetSequence() != msg.Nonce {
+		return errorsmod.Wrapf(errortypes.ErrInvalidSequence, "invalid nonce; got %d, expected %d", msg.Nonce, account.GetSequence())
+	}
+	if err := account.SetSequence(msg.Nonce + 1); err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(cacheCtx, account)
+
+	writeFn()
+	return nil
+}
+
+// touchedStateHash returns the keccak256 hash of the touched accounts and storage
+// slots, as read from the store. Accounts are sorted by address and slots by key,
+// so that the result is deterministic.
+func (k *Keeper) touchedStateHash(ctx sdk.Context, ts *touchedState) common.Hash {
+	addrs := make([]common.Address, 0, len(ts.accounts))
+	for addr := range ts.accounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	hasher := crypto.NewKeccakState()
+	for _, addr := range addrs {
+		account := k.GetAccountOrEmpty(ctx, addr)
+		balance := account.Balance.Bytes32()
+		hasher.Write(addr.Bytes())
+		hasher.Write(binary.BigEndian.AppendUint64(nil, account.Nonce))
+		hasher.Write(balance[:])
+		hasher.Write(account.CodeHash)
+
+		keys := make([]common.Hash, 0, len(ts.accounts[addr]))
+		for key := range ts.accounts[addr] {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+		})
+
+		for _, key := range keys {
+			hasher.Write(key.Bytes())
+			hasher.Write(k.GetState(ctx, addr, key).Bytes())
+		}
+	}
+
+	var root common.Hash
+	_, _ = hasher.Read(root[:])
+	return root
+}
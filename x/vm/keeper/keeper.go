@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
@@ -59,6 +60,10 @@ type Keeper struct {
 	feeMarketWrapper *wrappers.FeeMarketWrapper
 	// erc20Keeper interface needed to instantiate erc20 precompiles
 	erc20Keeper types.Erc20Keeper
+	// feegrantKeeper gives back to the fee allowances the refunds of sponsored
+	// txs, and charges them when IntermediateRoots re-executes those txs. The
+	// allowances are left as charged by the ante handler when it is nil.
+	feegrantKeeper types.FeegrantKeeper
 
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
@@ -70,6 +75,18 @@ type Keeper struct {
 	// Some of these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+
+	// preimageDB is the node-local database of the SHA3 preimages seen by the
+	// VM. Preimage recording is disabled when it is nil.
+	preimageDB dbm.DB
+
+	// evmAppConfig is the EVM coin and chain configuration of the app. The one
+	// set on startup through the EVMConfigurator is used when it is nil.
+	evmAppConfig *types.EVMAppConfig
+
+	// chainConfigCache holds the last chain config read from the store, so
+	// that it is only unmarshaled again when it changes.
+	chainConfigCache *chainConfigCache
 }
 
 // NewKeeper generates new evm module keeper
@@ -111,6 +128,7 @@ func NewKeeper(
 		tracer:           tracer,
 		erc20Keeper:      erc20Keeper,
 		storeKeys:        keys,
+		chainConfigCache: &chainConfigCache{},
 	}
 }
 
@@ -175,6 +193,40 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientTxIndex))
 }
 
+// SetTxMsgCountTransient sets the number of ethereum msgs of the current cosmos tx, called in ante handler.
+func (k Keeper) SetTxMsgCountTransient(ctx sdk.Context, count uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientTxMsgCount, sdk.Uint64ToBigEndian(count))
+}
+
+// GetTxMsgCountTransient returns the number of ethereum msgs of the current cosmos tx.
+func (k Keeper) GetTxMsgCountTransient(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientTxMsgCount))
+}
+
+// SetFeePayerTransient sets the account paying the fees of the current cosmos tx instead of the
+// senders of its ethereum msgs, called in ante handler. An empty fee payer clears it.
+func (k Keeper) SetFeePayerTransient(ctx sdk.Context, feePayer sdk.AccAddress) {
+	store := ctx.TransientStore(k.transientKey)
+	if feePayer.Empty() {
+		store.Delete(types.KeyPrefixTransientFeePayer)
+		return
+	}
+	store.Set(types.KeyPrefixTransientFeePayer, feePayer)
+}
+
+// GetFeePayerTransient returns the account paying the fees of the current cosmos tx, or nil if the
+// senders of its ethereum msgs pay their own fees.
+func (k Keeper) GetFeePayerTransient(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientFeePayer)
+	if len(bz) == 0 {
+		return nil
+	}
+	return bz
+}
+
 // ----------------------------------------------------------------------------
 // Hooks
 // ----------------------------------------------------------------------------
@@ -290,7 +342,7 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 
 	// Get the balance via bank wrapper to convert it to 18 decimals if needed.
-	coin := k.bankWrapper.GetBalance(ctx, cosmosAddr, types.GetEVMCoinDenom())
+	coin := k.bankWrapper.GetBalance(ctx, cosmosAddr, k.GetEVMCoinInfo().Denom)
 
 	result, err := utils.Uint256FromBigInt(coin.Amount.BigInt())
 	if err != nil {
@@ -305,7 +357,7 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int {
 // - `0`: london hardfork enabled but feemarket is not enabled.
 // - `n`: both london hardfork and feemarket are enabled.
 func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := k.GetEthChainConfig(ctx)
 	if !types.IsLondon(ethCfg, ctx.BlockHeight()) {
 		return nil
 	}
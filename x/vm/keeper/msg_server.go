@@ -5,12 +5,16 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/hashicorp/go-metrics"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -18,6 +22,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -47,6 +52,14 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
 
+	// the ethereum txs of a cosmos tx made of several ones are committed all
+	// together or not at all, the failure of one of them reverts the others
+	// and the whole gas limit is charged.
+	if response.Failed() && k.GetTxMsgCountTransient(ctx) > 1 {
+		k.ResetGasMeterAndConsumeGas(ctx, ctx.GasMeter().Limit())
+		return nil, errorsmod.Wrapf(types.ErrBatchTxFailed, "tx %s: %s", response.Hash, response.VmError)
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "ethereum_tx", "total"},
@@ -164,3 +177,134 @@ func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegist
 
 	return &types.MsgRegisterPreinstallsResponse{}, nil
 }
+
+// UpdateChainConfig implements the gRPC MsgServer interface. When an
+// UpdateChainConfig proposal passes, it stores the chain config, which allows to
+// schedule a hard fork without a coordinated binary upgrade. Only the forks that
+// are not activated yet can be changed. The update can only be performed if the
+// requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateChainConfig(goCtx context.Context, req *types.MsgUpdateChainConfig) (*types.MsgUpdateChainConfigResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := req.ChainConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	blockTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	if err := k.GetChainConfig(ctx).ValidateUpdate(req.ChainConfig, ctx.BlockHeight(), blockTime); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetChainConfig(ctx, req.ChainConfig); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateChainConfigResponse{}, nil
+}
+
+// DevSetState implements the gRPC MsgServer interface. It overrides the
+// balance, nonce, code and storage of the given EVM accounts, e.g. for the
+// `setBalance` and `setCode` methods of the dev rpc api. It is only allowed in
+// dev mode, for the dev authority.
+func (k *Keeper) DevSetState(goCtx context.Context, req *types.MsgDevSetState) (*types.MsgDevSetStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkDevAuthority(ctx, req.Sender); err != nil {
+		return nil, err
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unmarshal state overrides")
+	}
+	if err := overrides.Validate(); err != nil {
+		return nil, err
+	}
+
+	// a storage replaced by a state override only lasts until the end of the
+	// block, so the replaced storage of the dev overrides is deleted slot by slot
+	for addr, account := range overrides {
+		if account.State == nil {
+			continue
+		}
+		stateDiff := make(map[common.Hash]common.Hash)
+		k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+			stateDiff[key] = common.Hash{}
+			return true
+		})
+		maps.Copy(stateDiff, *account.State)
+		account.State, account.StateDiff = nil, &stateDiff
+		overrides[addr] = account
+	}
+
+	if err := k.applyStateOverrides(ctx, overrides); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDevSetStateResponse{}, nil
+}
+
+// DevIncreaseTime implements the gRPC MsgServer interface. It adds the given
+// number of seconds to the block time seen by the EVM, which can only move
+// forward. It is only allowed in dev mode, for the dev authority.
+func (k *Keeper) DevIncreaseTime(goCtx context.Context, req *types.MsgDevIncreaseTime) (*types.MsgDevIncreaseTimeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkDevAuthority(ctx, req.Sender); err != nil {
+		return nil, err
+	}
+
+	offset := k.GetDevTimeOffset(ctx) + req.Seconds
+	if offset < req.Seconds {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "time offset overflows uint64")
+	}
+	k.SetDevTimeOffset(ctx, offset)
+
+	return &types.MsgDevIncreaseTimeResponse{TimeOffset: offset}, nil
+}
+
+// DevSendTransaction implements the gRPC MsgServer interface. It executes the
+// given Ethereum transaction on behalf of its sender without checking its
+// signature, e.g. for an account impersonated through the dev rpc api. The gas
+// is not charged. It is only allowed in dev mode, for the dev authority.
+func (k *Keeper) DevSendTransaction(goCtx context.Context, req *types.MsgDevSendTransaction) (*types.MsgDevSendTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkDevAuthority(ctx, req.Sender); err != nil {
+		return nil, err
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unmarshal transaction args")
+	}
+	if args.From == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "missing transaction sender")
+	}
+
+	from := *args.From
+	nonce := hexutil.Uint64(k.GetNonce(ctx, from))
+	args.Nonce = &nonce
+
+	msg, err := args.ToMessage(cosmosevmtypes.BlockGasLimit(ctx), nil, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.ApplyMessage(ctx, msg, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// the nonce of contract creations is increased by the EVM, and the one of
+	// calls by the ante handler for signed transactions
+	if msg.To != nil {
+		account := k.GetAccountOrEmpty(ctx, from)
+		account.Nonce++
+		if err := k.SetAccount(ctx, from, account); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgDevSendTransactionResponse{Result: res}, nil
+}
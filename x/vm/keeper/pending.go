@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"container/heap"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// applyPendingTxs executes the pending transactions on the given context, as
+// if they were included in the next block. As in the mempool proposals, the
+// transactions of each sender are applied in nonce order, so that those queued
+// out of order are included as well, and the senders are interleaved by the
+// effective tip of their next transaction, with ties broken by the order of
+// txs. A transaction is skipped if it doesn't fit in the block gas limit, or
+// in the gas cap if any, its nonce doesn't match the account nonce or its
+// sender can't pay for it.
+//
+// It returns the results of the included transactions and the gas they used,
+// or an error if the context is done before all the transactions are applied.
+func (k *Keeper) applyPendingTxs(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txs []*types.MsgEthereumTx,
+	gasCap uint64,
+) ([]*types.MsgEthereumTxResponse, uint64, error) {
+	signer := ethtypes.LatestSignerForChainID(k.GetEthChainConfig(ctx).ChainID)
+
+	var (
+		senders  []common.Address
+		bySender = make(map[common.Address][]pendingTx)
+	)
+	for i, tx := range txs {
+		ethTx := tx.AsTransaction()
+		if ethTx == nil {
+			continue
+		}
+		sender, err := ethtypes.Sender(signer, ethTx)
+		if err != nil {
+			continue
+		}
+		if _, found := bySender[sender]; !found {
+			senders = append(senders, sender)
+		}
+		bySender[sender] = append(bySender[sender], pendingTx{ethTx: ethTx, seq: i})
+	}
+
+	heads := make(pendingTxHeap, 0, len(senders))
+	for _, sender := range senders {
+		senderTxs := bySender[sender]
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].ethTx.Nonce() < senderTxs[j].ethTx.Nonce()
+		})
+		if head := newPendingHead(senderTxs, cfg.BaseFee); head != nil {
+			heads = append(heads, head)
+		}
+	}
+	heap.Init(&heads)
+
+	gasLimit := cosmosevmtypes.BlockGasLimit(ctx)
+	if gasCap > 0 && (gasLimit == 0 || gasCap < gasLimit) {
+		gasLimit = gasCap
+	}
+
+	var (
+		gasUsed   uint64
+		logIndex  uint
+		responses = make([]*types.MsgEthereumTxResponse, 0, len(txs))
+	)
+	for heads.Len() > 0 {
+		if err := ctx.Context().Err(); err != nil {
+			return nil, 0, err
+		}
+
+		// move on to the next transaction of the sender
+		ethTx := heads[0].txs[0].ethTx
+		if next := newPendingHead(heads[0].txs[1:], cfg.BaseFee); next != nil {
+			heads[0] = next
+			heap.Fix(&heads, 0)
+		} else {
+			heap.Pop(&heads)
+		}
+
+		if gasLimit > 0 && ethTx.Gas() > gasLimit-gasUsed {
+			continue
+		}
+
+		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		if msg.Nonce != k.GetNonce(ctx, msg.From) {
+			continue
+		}
+		if err := k.checkMessageFunds(ctx, cfg.BaseFee, *msg); err != nil {
+			continue
+		}
+
+		// a transaction that can't be applied leaves no state changes behind
+		txCtx, commit := ctx.CacheContext()
+		txCtx = evmante.BuildEvmExecutionCtx(txCtx).
+			WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(msg.GasLimit))
+		txConfig := statedb.NewTxConfig(common.Hash{}, ethTx.Hash(), uint(len(responses)), logIndex)
+
+		res, err := k.ApplyMessageWithConfig(txCtx, *msg, nil, true, cfg, txConfig)
+		if err != nil {
+			continue
+		}
+		if err := k.finalizeMessage(txCtx, *msg, res.GasUsed, true); err != nil {
+			continue
+		}
+		commit()
+
+		gasUsed += res.GasUsed
+		logIndex += uint(len(res.Logs))
+		responses = append(responses, res)
+	}
+
+	return responses, gasUsed, nil
+}
+
+// pendingTx is a pending transaction with its position in the pending
+// transactions.
+type pendingTx struct {
+	ethTx *ethtypes.Transaction
+	seq   int
+}
+
+// pendingHead holds the remaining transactions of a sender in nonce order, with
+// the effective tip of the first one.
+type pendingHead struct {
+	txs []pendingTx
+	tip *big.Int
+}
+
+// newPendingHead returns the head of the given transactions of a sender, or nil
+// if there are none left or the fee cap of the first one is below the base fee,
+// in which case neither it nor the next ones are executable.
+func newPendingHead(txs []pendingTx, baseFee *big.Int) *pendingHead {
+	if len(txs) == 0 {
+		return nil
+	}
+	tip, err := txs[0].ethTx.EffectiveGasTip(baseFee)
+	if err != nil {
+		return nil
+	}
+	return &pendingHead{txs: txs, tip: tip}
+}
+
+// pendingTxHeap is a max-heap of the senders' next transactions by effective
+// tip, with ties broken by their position in the pending transactions.
+type pendingTxHeap []*pendingHead
+
+func (h pendingTxHeap) Len() int { return len(h) }
+
+func (h pendingTxHeap) Less(i, j int) bool {
+	if cmp := h[i].tip.Cmp(h[j].tip); cmp != 0 {
+		return cmp > 0
+	}
+	return h[i].txs[0].seq < h[j].txs[0].seq
+}
+
+func (h pendingTxHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *pendingTxHeap) Push(x any) { *h = append(*h, x.(*pendingHead)) }
+
+func (h *pendingTxHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,328 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native" // register the callTracer
+	"github.com/ethereum/go-ethereum/params"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// PrecompileTracerName is the name under which the precompile tracer is registered
+// in the go-ethereum tracers directory.
+const PrecompileTracerName = "precompileTracer"
+
+func init() {
+	tracers.DefaultDirectory.Register(PrecompileTracerName, newPrecompileTracer, false)
+}
+
+// precompileEffects holds the Cosmos side effects of a stateful precompile call.
+type precompileEffects struct {
+	// Method is the name of the ABI method called on the precompile
+	Method string `json:"method,omitempty"`
+	// Args are the decoded ABI arguments of the call
+	Args map[string]interface{} `json:"args,omitempty"`
+	// Events are the Cosmos events emitted during the call
+	Events []sdk.StringEvent `json:"events,omitempty"`
+	// BalanceDeltas are the bank balance changes per address and denom
+	BalanceDeltas map[string]map[string]string `json:"balanceDeltas,omitempty"`
+}
+
+// precompileFrame tracks a precompile call frame while it is being executed.
+type precompileFrame struct {
+	precompile interface {
+		MethodById(sigdata []byte) (*abi.Method, error)
+	}
+	input     []byte
+	eventsLen int
+	effects   *precompileEffects
+}
+
+// precompileTracer wraps the callTracer and records, for each call to a stateful
+// precompile, the decoded method and arguments, the Cosmos events emitted and the
+// resulting bank balance deltas. The result is the callTracer output where the
+// precompile frames have an additional `cosmos` field.
+type precompileTracer struct {
+	callTracer *tracers.Tracer
+	stateDB    *statedb.StateDB
+	keeper     *Keeper
+	// frames holds an entry per call frame, in the order the frames are entered.
+	// Entries for frames that are not precompile calls are nil.
+	frames []*precompileFrame
+	// reverted tells, for each call frame, whether it exited with a revert or
+	// an error, which discards the side effects of its nested calls as well.
+	reverted  []bool
+	callstack []int
+	interrupt atomic.Bool
+}
+
+func newPrecompileTracer(ctx *tracers.Context, cfg json.RawMessage, chainConfig *params.ChainConfig) (*tracers.Tracer, error) {
+	if cfg == nil {
+		cfg = json.RawMessage("{}")
+	}
+
+	callTracer, err := tracers.DefaultDirectory.New("callTracer", ctx, cfg, chainConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &precompileTracer{callTracer: callTracer}
+
+	hooks := *callTracer.Hooks
+	hooks.OnTxStart = t.OnTxStart
+	hooks.OnEnter = t.OnEnter
+	hooks.OnExit = t.OnExit
+
+	return &tracers.Tracer{
+		Hooks:     &hooks,
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+// OnTxStart keeps a reference to the StateDB of the transaction, which gives access
+// to the Cosmos context the precompiles are executed on.
+func (t *precompileTracer) OnTxStart(env *tracing.VMContext, tx *ethtypes.Transaction, from common.Address) {
+	if stateDB, ok := env.StateDB.(*statedb.StateDB); ok {
+		t.stateDB = stateDB
+		t.keeper, _ = stateDB.Keeper().(*Keeper)
+	}
+
+	t.callTracer.OnTxStart(env, tx, from)
+}
+
+// OnEnter records the number of Cosmos events emitted before a precompile call.
+func (t *precompileTracer) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.callTracer.OnEnter(depth, typ, from, to, input, gas, value)
+
+	if t.interrupt.Load() {
+		return
+	}
+
+	frame := t.precompileFrame(to, input)
+	t.callstack = append(t.callstack, len(t.frames))
+	t.frames = append(t.frames, frame)
+	t.reverted = append(t.reverted, false)
+}
+
+// OnExit collects the side effects of a precompile call from the Cosmos events
+// emitted since the call started.
+func (t *precompileTracer) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	t.callTracer.OnExit(depth, output, gasUsed, err, reverted)
+
+	size := len(t.callstack)
+	if t.interrupt.Load() || size == 0 {
+		return
+	}
+
+	index := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+
+	// the state changes of reverted calls, and of the calls nested in them,
+	// are discarded
+	if reverted || err != nil {
+		t.reverted[index] = true
+		return
+	}
+
+	frame := t.frames[index]
+	if frame == nil {
+		return
+	}
+
+	ctx, cacheErr := t.stateDB.GetCacheContext()
+	if cacheErr != nil {
+		return
+	}
+
+	events := ctx.EventManager().Events()
+	if frame.eventsLen > len(events) {
+		return
+	}
+	frame.effects.Events, frame.effects.BalanceDeltas = parsePrecompileEvents(events[frame.eventsLen:])
+}
+
+// GetResult returns the callTracer result with the precompile side effects added
+// to the matching call frames.
+func (t *precompileTracer) GetResult() (json.RawMessage, error) {
+	res, err := t.callTracer.GetResult()
+	if err != nil {
+		return nil, err
+	}
+
+	var root map[string]interface{}
+	if err := json.Unmarshal(res, &root); err != nil {
+		return nil, err
+	}
+
+	index := 0
+	t.annotate(root, &index, false)
+
+	return json.Marshal(root)
+}
+
+// Stop terminates the execution of the tracer at the first opportune moment.
+func (t *precompileTracer) Stop(err error) {
+	t.interrupt.Store(true)
+	t.callTracer.Stop(err)
+}
+
+// annotate walks the call frames in the order they were entered and adds the
+// side effects of the precompile calls to them, unless the calls or one of
+// their parents were reverted.
+func (t *precompileTracer) annotate(frame map[string]interface{}, index *int, reverted bool) {
+	if *index < len(t.frames) {
+		reverted = reverted || t.reverted[*index]
+		if pf := t.frames[*index]; pf != nil && !reverted {
+			frame["cosmos"] = pf.effects
+		}
+	}
+	*index++
+
+	calls, ok := frame["calls"].([]interface{})
+	if !ok {
+		return
+	}
+	for _, call := range calls {
+		if child, ok := call.(map[string]interface{}); ok {
+			t.annotate(child, index, reverted)
+		}
+	}
+}
+
+// precompileFrame returns a new frame if the given address is a stateful precompile,
+// or nil otherwise.
+func (t *precompileTracer) precompileFrame(addr common.Address, input []byte) *precompileFrame {
+	if t.stateDB == nil || t.keeper == nil {
+		return nil
+	}
+
+	// do not charge the lookup to the gas meter of the transaction
+	lookupCtx := t.stateDB.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	precompiles, found, err := t.keeper.GetPrecompileInstance(lookupCtx, addr)
+	if err != nil || !found {
+		return nil
+	}
+
+	precompile, ok := precompiles.Map[addr].(interface {
+		MethodById(sigdata []byte) (*abi.Method, error)
+	})
+	if !ok {
+		return nil
+	}
+
+	ctx, err := t.stateDB.GetCacheContext()
+	if err != nil {
+		return nil
+	}
+
+	frame := &precompileFrame{
+		precompile: precompile,
+		input:      common.CopyBytes(input),
+		eventsLen:  len(ctx.EventManager().Events()),
+		effects:    &precompileEffects{},
+	}
+	frame.effects.Method, frame.effects.Args = frame.decodeInput()
+
+	return frame
+}
+
+// decodeInput returns the ABI method name and arguments of the call input.
+func (f *precompileFrame) decodeInput() (string, map[string]interface{}) {
+	if len(f.input) < 4 {
+		return "", nil
+	}
+
+	method, err := f.precompile.MethodById(f.input[:4])
+	if err != nil {
+		return "", nil
+	}
+
+	args := make(map[string]interface{})
+	if err := method.Inputs.UnpackIntoMap(args, f.input[4:]); err != nil {
+		return method.Name, nil
+	}
+
+	return method.Name, args
+}
+
+// parsePrecompileEvents returns the given Cosmos events in their string form, along
+// with the bank balance deltas derived from the coin spent and received events.
+func parsePrecompileEvents(events sdk.Events) ([]sdk.StringEvent, map[string]map[string]string) {
+	stringEvents := make([]sdk.StringEvent, 0, len(events))
+	deltas := make(map[string]map[string]*big.Int)
+
+	for _, event := range events {
+		stringEvents = append(stringEvents, sdk.StringifyEvent(abci.Event(event)))
+
+		var (
+			addrKey string
+			sign    int64
+		)
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, sign = banktypes.AttributeKeySpender, -1
+		case banktypes.EventTypeCoinReceived:
+			addrKey, sign = banktypes.AttributeKeyReceiver, 1
+		default:
+			continue
+		}
+
+		addrAttr, ok := event.GetAttribute(addrKey)
+		if !ok {
+			continue
+		}
+		amountAttr, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+		if !ok {
+			continue
+		}
+		coins, err := sdk.ParseCoinsNormalized(amountAttr.Value)
+		if err != nil {
+			continue
+		}
+
+		addr := addrAttr.Value
+		if accAddr, err := sdk.AccAddressFromBech32(addr); err == nil {
+			addr = common.BytesToAddress(accAddr).Hex()
+		}
+		if deltas[addr] == nil {
+			deltas[addr] = make(map[string]*big.Int)
+		}
+		for _, coin := range coins {
+			if deltas[addr][coin.Denom] == nil {
+				deltas[addr][coin.Denom] = new(big.Int)
+			}
+			amount := new(big.Int).Mul(coin.Amount.BigInt(), big.NewInt(sign))
+			deltas[addr][coin.Denom].Add(deltas[addr][coin.Denom], amount)
+		}
+	}
+
+	if len(deltas) == 0 {
+		return stringEvents, nil
+	}
+
+	balanceDeltas := make(map[string]map[string]string, len(deltas))
+	for addr, denoms := range deltas {
+		balanceDeltas[addr] = make(map[string]string, len(denoms))
+		for denom, amount := range denoms {
+			balanceDeltas[addr][denom] = amount.String()
+		}
+	}
+
+	return stringEvents, balanceDeltas
+}
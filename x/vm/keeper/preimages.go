@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithPreimageDB enables the recording of the SHA3 preimages seen by the VM
+// into the given node-local database. The preimages are kept out of the
+// consensus state, so nodes may enable it independently.
+func (k *Keeper) WithPreimageDB(db dbm.DB) *Keeper {
+	if k.preimageDB != nil {
+		panic("preimage db already set")
+	}
+
+	k.preimageDB = db
+	return k
+}
+
+// GetPreimage returns the preimage of the given SHA3 hash, or nil if it wasn't
+// recorded.
+func (k Keeper) GetPreimage(hash common.Hash) ([]byte, error) {
+	if k.preimageDB == nil {
+		return nil, nil
+	}
+	return k.preimageDB.Get(hash.Bytes())
+}
+
+// storePreimages writes the preimages recorded during the execution of a
+// transaction. They are only stored for the transactions of a block being
+// finalized, not for queries or simulations. A failure is logged but doesn't
+// fail the transaction, as the preimages are not part of the consensus state.
+func (k Keeper) storePreimages(ctx sdk.Context, preimages map[common.Hash][]byte) {
+	if k.preimageDB == nil || len(preimages) == 0 || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+
+	batch := k.preimageDB.NewBatch()
+	defer batch.Close()
+
+	for hash, preimage := range preimages {
+		if err := batch.Set(hash.Bytes(), preimage); err != nil {
+			k.Logger(ctx).Error("failed to record preimage", "hash", hash.Hex(), "error", err.Error())
+			return
+		}
+	}
+	if err := batch.Write(); err != nil {
+		k.Logger(ctx).Error("failed to record preimages", "error", err.Error())
+	}
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrevRandaoHistory is the number of blocks the PREVRANDAO values are kept for
+// the PrevRandao query.
+const PrevRandaoHistory = 8191
+
+// GetPrevRandao returns the PREVRANDAO value of the current block, returned by
+// the PREVRANDAO opcode. It is empty until the first block is begun.
+func (k *Keeper) GetPrevRandao(ctx sdk.Context) common.Hash {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixPrevRandao)
+	if len(bz) == 0 {
+		return common.Hash{}
+	}
+	return common.BytesToHash(bz)
+}
+
+// GetPrevRandaoAtHeight returns the PREVRANDAO value of the block at the given
+// height, if it is within the last PrevRandaoHistory blocks.
+func (k *Keeper) GetPrevRandaoAtHeight(ctx sdk.Context, height int64) (common.Hash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrevRandaoHistory)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height))) //#nosec G115 -- int overflow is not a concern here
+	if len(bz) == 0 {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
+
+// UpdatePrevRandao sets the PREVRANDAO value of the block being finalized to:
+//
+//	keccak256(previous PREVRANDAO || seed)
+//
+// Once vote extensions are enabled and every validator voted for the previous
+// block, the seed is the concatenation of the vote extension signatures of the
+// previous block, injected as the first transaction of the block by the
+// RandaoProposalHandler. They are deterministic signatures, which no one knows
+// all of in advance unless all the validators collude. The proposer can only
+// leave out votes, which makes the seed fall back to the app hash, so it can
+// only choose between the two values. Otherwise, the seed is the app hash of
+// the block header, which is predictable and which the previous proposer can
+// bias by choosing the transactions of its block.
+//
+// It must be called from the PreBlocker of the app with the transactions of
+// the block. The chaining makes every value depend on the whole history.
+func (k *Keeper) UpdatePrevRandao(ctx sdk.Context, txs [][]byte) {
+	seed := ctx.BlockHeader().AppHash
+	if types.VoteExtensionsEnabled(ctx, ctx.BlockHeight()) && len(txs) > 0 {
+		// the commit transaction is checked by ProcessProposal
+		if commit, ok, err := types.DecodeRandaoCommitTx(txs[0]); ok && err == nil {
+			if sigs, ok := types.RandaoSeed(commit); ok {
+				seed = sigs
+			}
+		}
+	}
+
+	mix := crypto.Keccak256Hash(k.GetPrevRandao(ctx).Bytes(), seed)
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixPrevRandao, mix.Bytes())
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrevRandaoHistory)
+	height := uint64(ctx.BlockHeight()) //#nosec G115 -- int overflow is not a concern here
+	store.Set(sdk.Uint64ToBigEndian(height), mix.Bytes())
+	if height > PrevRandaoHistory {
+		store.Delete(sdk.Uint64ToBigEndian(height - PrevRandaoHistory))
+	}
+}
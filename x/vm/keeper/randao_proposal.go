@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RandaoProposalHandler wraps the PrepareProposal and ProcessProposal handlers
+// of the app to inject the vote extension signatures of the previous block as
+// the first transaction of the block, which UpdatePrevRandao mixes into the
+// PREVRANDAO value. It requires vote extensions to be enabled, the ExtendVote
+// and VerifyVoteExtension handlers being no-ops as only the signatures are
+// used. The app must strip the pseudo transaction before delivering the block,
+// see SplitRandaoCommitTx.
+type RandaoProposalHandler struct {
+	valStore baseapp.ValidatorStore
+	prepare  sdk.PrepareProposalHandler
+	process  sdk.ProcessProposalHandler
+}
+
+// NewRandaoProposalHandler returns a RandaoProposalHandler wrapping the given
+// proposal handlers.
+func NewRandaoProposalHandler(
+	valStore baseapp.ValidatorStore,
+	prepare sdk.PrepareProposalHandler,
+	process sdk.ProcessProposalHandler,
+) *RandaoProposalHandler {
+	return &RandaoProposalHandler{
+		valStore: valStore,
+		prepare:  prepare,
+		process:  process,
+	}
+}
+
+// PrepareProposalHandler returns the PrepareProposal handler prepending the
+// extended commit of the previous block to the proposal.
+func (h *RandaoProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !types.VoteExtensionsEnabled(ctx, req.Height) {
+			return h.prepare(ctx, req)
+		}
+
+		commitTx, err := types.EncodeRandaoCommitTx(req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		inner := *req
+		inner.MaxTxBytes -= int64(len(commitTx))
+		res, err := h.prepare(ctx, &inner)
+		if err != nil {
+			return nil, err
+		}
+		res.Txs = append([][]byte{commitTx}, res.Txs...)
+		return res, nil
+	}
+}
+
+// ProcessProposalHandler returns the ProcessProposal handler rejecting the
+// proposals without a valid extended commit of the previous block as their
+// first transaction, matching the last commit of the proposal.
+func (h *RandaoProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !types.VoteExtensionsEnabled(ctx, req.Height) {
+			return h.process(ctx, req)
+		}
+
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		if len(req.Txs) == 0 {
+			return reject, nil
+		}
+		commit, ok, err := types.DecodeRandaoCommitTx(req.Txs[0])
+		if !ok || err != nil {
+			ctx.Logger().Debug("invalid randao commit transaction", "height", req.Height, "error", err)
+			return reject, nil
+		}
+		// the votes must be exactly the ones of the last commit of the proposal
+		if err := types.ValidateRandaoCommit(commit, req.ProposedLastCommit); err != nil {
+			ctx.Logger().Debug("randao commit doesn't match the last commit", "height", req.Height, "error", err)
+			return reject, nil
+		}
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), commit); err != nil {
+			ctx.Logger().Debug("invalid randao vote extensions", "height", req.Height, "error", err)
+			return reject, nil
+		}
+
+		inner := *req
+		inner.Txs = req.Txs[1:]
+		return h.process(ctx, &inner)
+	}
+}
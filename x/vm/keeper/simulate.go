@@ -0,0 +1,442 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulator executes the blocks of an eth_simulateV1 request on top of a
+// cached context, so that every call observes the state changes of the
+// previous ones.
+type simulator struct {
+	k    *Keeper
+	cfg  *statedb.EVMConfig
+	opts types.SimOpts
+
+	// gasCap is the gas budget shared by all the calls, 0 means unlimited
+	gasCap       uint64
+	gasRemaining uint64
+}
+
+// run simulates all the blocks of the request on top of the parent header and
+// hash, the ones of the block the simulation is executed on.
+//
+// As in geth, the block numbers skipped by a block number override are filled
+// with empty blocks, and the BLOCKHASH opcode serves the hashes of the
+// previous simulated blocks.
+func (s *simulator) run(ctx sdk.Context, parent *ethtypes.Header, parentHash common.Hash) ([]types.SimBlockResult, error) {
+	base := parent.Number.Uint64()
+	hashes := make(map[uint64]common.Hash)
+	getHash := s.k.GetHashFn(ctx)
+	s.cfg.GetHash = func(height uint64) common.Hash {
+		if height > base {
+			return hashes[height]
+		}
+		return getHash(height)
+	}
+
+	results := make([]types.SimBlockResult, 0, len(s.opts.BlockStateCalls))
+	for i, block := range s.opts.BlockStateCalls {
+		number := new(big.Int).Add(parent.Number, common.Big1)
+		if block.BlockOverrides != nil && block.BlockOverrides.Number != nil {
+			number = block.BlockOverrides.Number.ToInt()
+		}
+		if number.Cmp(parent.Number) <= 0 {
+			return nil, fmt.Errorf("block %d: block number %s must be greater than %s", i, number, parent.Number)
+		}
+		if !number.IsInt64() || number.Uint64()-base > types.MaxSimulateBlocks {
+			return nil, fmt.Errorf("block %d: too many blocks: block number %s is more than %d blocks ahead of %d", i, number, types.MaxSimulateBlocks, base)
+		}
+
+		// fill the skipped block numbers with empty blocks
+		for parent.Number.Uint64()+1 < number.Uint64() {
+			header, result, err := s.simulateBlock(ctx, types.SimBlock{}, parent, parentHash)
+			if err != nil {
+				return nil, fmt.Errorf("block %d: %w", i, err)
+			}
+			results = append(results, *result)
+			hashes[header.Number.Uint64()] = result.Hash
+			parent, parentHash = header, result.Hash
+		}
+
+		header, result, err := s.simulateBlock(ctx, block, parent, parentHash)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		results = append(results, *result)
+		hashes[header.Number.Uint64()] = result.Hash
+		parent, parentHash = header, result.Hash
+	}
+	return results, nil
+}
+
+// simulateBlock builds the header of the next simulated block and processes
+// its calls.
+func (s *simulator) simulateBlock(ctx sdk.Context, block types.SimBlock, parent *ethtypes.Header, parentHash common.Hash) (*ethtypes.Header, *types.SimBlockResult, error) {
+	header, err := s.makeHeader(ctx, block.BlockOverrides, parent, parentHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := s.processBlock(ctx, block, header)
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, result, nil
+}
+
+// makeHeader builds the header of the next simulated block from its parent and
+// the block overrides. The block number override must have been checked
+// against the parent.
+func (s *simulator) makeHeader(ctx sdk.Context, overrides *types.BlockOverrides, parent *ethtypes.Header, parentHash common.Hash) (*ethtypes.Header, error) {
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		Time:       parent.Time + types.SimulateTimestampIncrement,
+		GasLimit:   cosmosevmtypes.BlockGasLimit(ctx),
+		Coinbase:   s.cfg.CoinBase,
+		Difficulty: big.NewInt(0),
+	}
+
+	// without validation, the calls are free unless a base fee is explicitly set
+	if s.opts.Validation && s.cfg.BaseFee != nil {
+		header.BaseFee = new(big.Int).Set(s.cfg.BaseFee)
+	} else if s.cfg.BaseFee != nil {
+		header.BaseFee = big.NewInt(0)
+	}
+
+	if overrides == nil {
+		return header, nil
+	}
+
+	if overrides.Number != nil {
+		header.Number = new(big.Int).Set(overrides.Number.ToInt())
+	}
+	if overrides.Time != nil {
+		if uint64(*overrides.Time) <= parent.Time {
+			return nil, fmt.Errorf("block timestamp %d must be greater than %d", uint64(*overrides.Time), parent.Time)
+		}
+		header.Time = uint64(*overrides.Time)
+	}
+	if overrides.GasLimit != nil {
+		header.GasLimit = uint64(*overrides.GasLimit)
+	}
+	if overrides.FeeRecipient != nil {
+		header.Coinbase = *overrides.FeeRecipient
+	}
+	if overrides.BaseFeePerGas != nil {
+		header.BaseFee = new(big.Int).Set(overrides.BaseFeePerGas.ToInt())
+	}
+
+	return header, nil
+}
+
+// processBlock applies the state overrides of the block and executes its calls
+// sequentially. The state changes are written to the given context.
+func (s *simulator) processBlock(ctx sdk.Context, block types.SimBlock, header *ethtypes.Header) (*types.SimBlockResult, error) {
+	// the block max gas is only read by the EVM to serve the GASLIMIT opcode
+	consParams := ctx.ConsensusParams()
+	if consParams.Block != nil {
+		blockParams := *consParams.Block
+		blockParams.MaxGas = int64(header.GasLimit) //#nosec G115 -- overflow results in an unlimited block, which is fine for a simulation
+		consParams.Block = &blockParams
+	}
+
+	blockCtx := ctx.
+		WithBlockHeight(header.Number.Int64()).
+		WithBlockTime(time.Unix(int64(header.Time), 0).UTC()). //#nosec G115 -- int overflow is not a concern here
+		WithConsensusParams(consParams)
+
+	if block.StateOverrides != nil {
+		if err := s.k.applyStateOverrides(blockCtx, *block.StateOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := *s.cfg
+	cfg.CoinBase = header.Coinbase
+	cfg.BaseFee = header.BaseFee
+
+	var (
+		gasUsed  uint64
+		txHashes = make([]common.Hash, 0, len(block.Calls))
+		calls    = make([]types.SimCallResult, 0, len(block.Calls))
+	)
+	for i, args := range block.Calls {
+		txHash, result, err := s.processCall(blockCtx, &cfg, args, header.GasLimit-gasUsed, uint(i)) //#nosec G115 -- int overflow is not a concern here
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		gasUsed += uint64(result.GasUsed)
+		txHashes = append(txHashes, txHash)
+		calls = append(calls, *result)
+	}
+	header.GasUsed = gasUsed
+
+	// the block hash depends on the gas used, so the logs are only completed
+	// once all the calls have been executed
+	blockHash := header.Hash()
+	var logIndex uint
+	for _, call := range calls {
+		for _, log := range call.Logs {
+			log.BlockHash = blockHash
+			log.Index = logIndex
+			logIndex++
+		}
+	}
+
+	var baseFee *hexutil.Big
+	if header.BaseFee != nil {
+		baseFee = (*hexutil.Big)(header.BaseFee)
+	}
+
+	return &types.SimBlockResult{
+		Number:        hexutil.Uint64(header.Number.Uint64()),
+		Hash:          blockHash,
+		ParentHash:    header.ParentHash,
+		Timestamp:     hexutil.Uint64(header.Time),
+		GasLimit:      hexutil.Uint64(header.GasLimit),
+		GasUsed:       hexutil.Uint64(header.GasUsed),
+		Miner:         header.Coinbase,
+		BaseFeePerGas: baseFee,
+		Transactions:  txHashes,
+		Calls:         calls,
+	}, nil
+}
+
+// processCall executes a single call and commits its state changes to the
+// given context. It returns the hash of the transaction built from the call
+// arguments along with the call result.
+func (s *simulator) processCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	args types.TransactionArgs,
+	blockGasRemaining uint64,
+	txIndex uint,
+) (common.Hash, *types.SimCallResult, error) {
+	from := args.GetFrom()
+	nonce := s.k.GetNonce(ctx, from)
+	if args.Nonce != nil && s.opts.Validation {
+		switch {
+		case uint64(*args.Nonce) < nonce:
+			return common.Hash{}, nil, fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, from, uint64(*args.Nonce), nonce)
+		case uint64(*args.Nonce) > nonce:
+			return common.Hash{}, nil, fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, from, uint64(*args.Nonce), nonce)
+		}
+	}
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	gas := blockGasRemaining
+	if args.Gas != nil {
+		if uint64(*args.Gas) > blockGasRemaining {
+			return common.Hash{}, nil, fmt.Errorf("block gas limit reached: %d > %d", uint64(*args.Gas), blockGasRemaining)
+		}
+		gas = uint64(*args.Gas)
+	}
+	if s.gasCap != 0 {
+		if s.gasRemaining == 0 {
+			return common.Hash{}, nil, errors.New("gas cap reached")
+		}
+		gas = min(gas, s.gasRemaining)
+	}
+	args.Gas = (*hexutil.Uint64)(&gas)
+
+	msg, err := args.ToMessage(0, cfg.BaseFee, false, false)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	if s.opts.Validation {
+		if err := s.k.checkMessageFunds(ctx, cfg.BaseFee, msg); err != nil {
+			return common.Hash{}, nil, err
+		}
+	}
+
+	txHash := args.ToTransaction().AsTransaction().Hash()
+	txConfig := statedb.NewTxConfig(common.Hash{}, txHash, txIndex, 0)
+
+	var tracer *simTracer
+	var hooks *tracing.Hooks
+	if s.opts.TraceTransfers {
+		tracer = newSimTracer()
+		hooks = tracer.Hooks()
+	}
+
+	res, err := s.k.ApplyMessageWithConfig(ctx, msg, hooks, true, cfg, txConfig)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	if err := s.k.finalizeMessage(ctx, msg, res.GasUsed, s.opts.Validation); err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	if s.gasCap != 0 {
+		s.gasRemaining -= min(res.GasUsed, s.gasRemaining)
+	}
+
+	logs := types.LogsToEthereum(res.Logs)
+	if tracer != nil {
+		logs = tracer.Logs()
+	}
+	for _, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight()) //#nosec G115 -- int overflow is not a concern here
+		log.TxHash = txHash
+		log.TxIndex = txIndex
+	}
+
+	result := &types.SimCallResult{
+		ReturnData: res.Ret,
+		Logs:       logs,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		result.Logs = []*ethtypes.Log{}
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := types.NewExecErrorWithReason(res.Ret)
+			result.Error = &types.SimCallError{
+				Code:    types.SimErrCodeReverted,
+				Message: revertErr.Error(),
+				Data:    hexutil.Encode(res.Ret),
+			}
+		} else {
+			result.Error = &types.SimCallError{
+				Code:    types.SimErrCodeVMError,
+				Message: res.VmError,
+			}
+		}
+	}
+
+	return txHash, result, nil
+}
+
+// checkMessageFunds checks that the fee cap of a message covers the base fee
+// and that its sender is able to pay for the maximum gas cost and the
+// transferred value.
+func (k *Keeper) checkMessageFunds(ctx sdk.Context, baseFee *big.Int, msg core.Message) error {
+	if baseFee != nil && msg.GasFeeCap.Cmp(baseFee) < 0 {
+		return fmt.Errorf("%w: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, msg.From, msg.GasFeeCap, baseFee)
+	}
+
+	cost := new(big.Int).Mul(msg.GasFeeCap, new(big.Int).SetUint64(msg.GasLimit))
+	cost.Add(cost, msg.Value)
+
+	balance := k.GetAccountOrEmpty(ctx, msg.From).Balance
+	if balance.ToBig().Cmp(cost) < 0 {
+		return fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, msg.From, balance, cost)
+	}
+	return nil
+}
+
+// finalizeMessage increments the sender nonce of an applied message that is
+// not a contract creation, which is otherwise done by the ante handler, and
+// optionally charges the gas fees to the sender.
+func (k *Keeper) finalizeMessage(ctx sdk.Context, msg core.Message, gasUsed uint64, chargeFees bool) error {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.Hash{}))
+	if msg.To != nil {
+		stateDB.SetNonce(msg.From, msg.Nonce+1, tracing.NonceChangeEoACall)
+	}
+
+	if chargeFees {
+		fees := new(big.Int).Mul(msg.GasPrice, new(big.Int).SetUint64(gasUsed))
+		amount, overflow := uint256.FromBig(fees)
+		if overflow {
+			return fmt.Errorf("gas fees %s overflow uint256", fees)
+		}
+		stateDB.SubBalance(msg.From, amount, tracing.BalanceDecreaseGasBuy)
+	}
+
+	return stateDB.Commit()
+}
+
+// simTracer collects the logs emitted during the execution of a message,
+// including the ERC-7528 logs of native value transfers. The logs of the call
+// frames that are reverted are discarded.
+type simTracer struct {
+	// logs is a stack with the logs of every open call frame
+	logs [][]*ethtypes.Log
+}
+
+func newSimTracer() *simTracer {
+	return &simTracer{}
+}
+
+// Hooks returns the tracing hooks that record the logs and value transfers.
+func (t *simTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: func(_ *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {},
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+		OnLog:     t.onLog,
+	}
+}
+
+func (t *simTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.logs = append(t.logs, make([]*ethtypes.Log, 0))
+	if vm.OpCode(typ) != vm.DELEGATECALL && value != nil && value.Sign() > 0 {
+		t.onLog(&ethtypes.Log{
+			Address: types.TransferLogAddress,
+			Topics: []common.Hash{
+				types.TransferTopic,
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BigToHash(value).Bytes(),
+		})
+	}
+}
+
+func (t *simTracer) onExit(depth int, _ []byte, _ uint64, _ error, reverted bool) {
+	if depth == 0 {
+		if reverted && len(t.logs) > 0 {
+			t.logs[0] = nil
+		}
+		return
+	}
+
+	size := len(t.logs)
+	if size <= 1 {
+		return
+	}
+	// pop the call frame and merge its logs into the parent one
+	call := t.logs[size-1]
+	t.logs = t.logs[:size-1]
+	if !reverted {
+		t.logs[size-2] = append(t.logs[size-2], call...)
+	}
+}
+
+func (t *simTracer) onLog(log *ethtypes.Log) {
+	if len(t.logs) == 0 {
+		return
+	}
+	t.logs[len(t.logs)-1] = append(t.logs[len(t.logs)-1], log)
+}
+
+// Logs returns the logs of the top level call frame.
+func (t *simTracer) Logs() []*ethtypes.Log {
+	if len(t.logs) == 0 || t.logs[0] == nil {
+		return []*ethtypes.Log{}
+	}
+	return t.logs[0]
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ApplyStateOverrides returns a cached context with the given JSON encoded state
+// overrides applied on top of the current state. The returned context must only
+// be used for simulations (eg: eth_call and eth_estimateGas) since the changes
+// are never written to the parent context.
+//
+// If no overrides are provided, the original context is returned.
+func (k *Keeper) ApplyStateOverrides(ctx sdk.Context, overridesBz []byte) (sdk.Context, error) {
+	if len(overridesBz) == 0 {
+		return ctx, nil
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(overridesBz, &overrides); err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to unmarshal state overrides")
+	}
+
+	if err := overrides.Validate(); err != nil {
+		return ctx, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.applyStateOverrides(cacheCtx, overrides); err != nil {
+		return ctx, err
+	}
+
+	return cacheCtx, nil
+}
+
+// applyStateOverrides writes the given state overrides into the provided
+// context.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, overrides types.StateOverride) error {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+
+	for addr, account := range overrides {
+		// Override account nonce.
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce), tracing.NonceChangeUnspecified)
+		}
+		// Override account (contract) code.
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		// Override account balance.
+		if account.Balance != nil && *account.Balance != nil {
+			balance, overflow := uint256.FromBig((*account.Balance).ToInt())
+			if overflow {
+				return errorsmod.Wrapf(types.ErrInvalidAmount, "balance override of account %s overflows uint256", addr.Hex())
+			}
+			stateDB.SetBalance(addr, balance)
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			stateDB.SetStorage(addr, *account.State)
+		}
+		// Apply state diff into specified accounts.
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return errorsmod.Wrap(err, "failed to commit state overrides")
+	}
+
+	return nil
+}
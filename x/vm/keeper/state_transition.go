@@ -20,6 +20,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -29,9 +30,7 @@ import (
 // coinbase address to make it available for the COINBASE opcode, even though there is no
 // beneficiary of the coinbase transaction (since we're not mining).
 //
-// NOTE: the RANDOM opcode is currently not supported since it requires
-// RANDAO implementation. See https://github.com/evmos/ethermint/pull/1520#pullrequestreview-1200504697
-// for more information.
+// The PREVRANDAO opcode returns the value mixed by the PreBlocker, see UpdatePrevRandao.
 func (k *Keeper) NewEVM(
 	ctx sdk.Context,
 	msg core.Message,
@@ -39,20 +38,30 @@ func (k *Keeper) NewEVM(
 	tracer *tracing.Hooks,
 	stateDB vm.StateDB,
 ) *vm.EVM {
+	// the lookup is not charged to the transaction
+	random := k.GetPrevRandao(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	blockTime := uint64(ctx.BlockHeader().Time.Unix()) //#nosec G115 -- int overflow is not a concern here
+	if cfg.Params.DevMode {
+		blockTime += k.GetDevTimeOffset(ctx)
+	}
+	getHash := cfg.GetHash
+	if getHash == nil {
+		getHash = k.GetHashFn(ctx)
+	}
 	blockCtx := vm.BlockContext{
 		CanTransfer: core.CanTransfer,
 		Transfer:    core.Transfer,
-		GetHash:     k.GetHashFn(ctx),
+		GetHash:     getHash,
 		Coinbase:    cfg.CoinBase,
 		GasLimit:    cosmosevmtypes.BlockGasLimit(ctx),
 		BlockNumber: big.NewInt(ctx.BlockHeight()),
-		Time:        uint64(ctx.BlockHeader().Time.Unix()), //#nosec G115 -- int overflow is not a concern here
+		Time:        blockTime,
 		Difficulty:  big.NewInt(0),                         // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
-		Random:      &common.MaxHash, // need to be different than nil to signal it is after the merge and pick up the right opcodes
+		Random:      &random, // need to be different than nil to signal it is after the merge and pick up the right opcodes
 	}
 
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := k.GetEthChainConfig(ctx)
 	txCtx := core.NewEVMTxContext(&msg)
 	if tracer == nil {
 		tracer = k.Tracer(ctx, msg, ethCfg)
@@ -76,7 +85,8 @@ func (k *Keeper) NewEVM(
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
 //  1. The requested height matches the current height from context (and thus same epoch number)
-//  2. The requested height is from an previous height from the same chain epoch
+//  2. The requested height is from an previous height from the same chain epoch, served from the
+//     EIP-2935 history storage and falling back to the staking historical info
 //  3. The requested height is from a height greater than the latest one
 func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	return func(height uint64) common.Hash {
@@ -110,6 +120,10 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 		case ctx.BlockHeight() > h:
 			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
 			// current chain epoch. This only applies if the current height is greater than the requested height.
+			if hash := k.GetHistoricalBlockHash(ctx, h); hash != (common.Hash{}) {
+				return hash
+			}
+
 			histInfo, err := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if err != nil {
 				k.Logger(ctx).Debug("error while getting historical info", "height", h, "error", err.Error())
@@ -161,7 +175,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	txConfig := k.TxConfig(ctx, ethTx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
@@ -241,7 +255,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		}
 	}
 
-	evmDenom := types.GetEVMCoinDenom()
+	evmDenom := k.GetEVMCoinInfo().Denom
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
 	remainingGas := uint64(0)
@@ -342,11 +356,14 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
 	vmCfg := evm.Config
 	if vmCfg.Tracer != nil {
-		vmCfg.Tracer.OnTxStart(
-			evm.GetVMContext(),
-			ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
-			msg.From,
-		)
+		stateDB.SetLogHook(vmCfg.Tracer.OnLog)
+		if vmCfg.Tracer.OnTxStart != nil {
+			vmCfg.Tracer.OnTxStart(
+				evm.GetVMContext(),
+				ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
+				msg.From,
+			)
+		}
 		defer func() {
 			if vmCfg.Tracer.OnTxEnd != nil {
 				vmCfg.Tracer.OnTxEnd(&ethtypes.Receipt{GasUsed: msg.GasLimit - leftoverGas}, vmErr)
@@ -354,7 +371,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 		}()
 	}
 
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := evm.ChainConfig()
 
 	sender := vm.AccountRef(msg.From)
 	contractCreation := msg.To == nil
@@ -378,6 +395,28 @@ func (k *Keeper) ApplyMessageWithConfig(
 	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	stateDB.Prepare(rules, msg.From, common.Address{}, msg.To, evm.ActivePrecompiles(), msg.AccessList)
 
+	// apply the EIP-7702 code delegations before the execution, so that the
+	// delegated code is already in place when the destination is called.
+	if msg.SetCodeAuthorizations != nil {
+		if !rules.IsPrague {
+			return nil, errorsmod.Wrap(core.ErrTxTypeNotSupported, "set code transactions require the Prague fork")
+		}
+		if contractCreation {
+			return nil, errorsmod.Wrap(core.ErrSetCodeTxCreate, "apply message")
+		}
+		if len(msg.SetCodeAuthorizations) == 0 {
+			return nil, errorsmod.Wrap(core.ErrEmptyAuthList, "apply message")
+		}
+		k.applyAuthorizations(ctx, stateDB, ethCfg.ChainID, msg.SetCodeAuthorizations)
+	}
+
+	// warm the delegation target of the destination account (EIP-7702)
+	if !contractCreation && rules.IsPrague {
+		if target, ok := ethtypes.ParseDelegation(stateDB.GetCode(*msg.To)); ok {
+			stateDB.AddAddressToAccessList(target)
+		}
+	}
+
 	convertedValue, err := utils.Uint256FromBigInt(msg.Value)
 	if err != nil {
 		return nil, err
@@ -424,6 +463,9 @@ func (k *Keeper) ApplyMessageWithConfig(
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
+		if cfg.EnablePreimageRecording {
+			k.storePreimages(ctx, stateDB.Preimages())
+		}
 	}
 
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
@@ -35,7 +35,7 @@ func (k *Keeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Accou
 
 // GetState loads contract state from database.
 func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	store, _ := k.storageStore(ctx, addr)
 
 	value := store.Get(key.Bytes())
 	if len(value) == 0 {
@@ -45,6 +45,28 @@ func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash)
 	return common.BytesToHash(value)
 }
 
+// GetStorageHash returns the commitment to the storage of the given account,
+// see types.UpdateStorageHash. It is zero for an account without storage.
+func (k *Keeper) GetStorageHash(ctx sdk.Context, addr common.Address) common.Hash {
+	return common.BytesToHash(ctx.KVStore(k.storeKey).Get(types.StorageHashKey(addr)))
+}
+
+// updateStorageHash updates the storage hash of the account for a change of
+// the value of the given slot. A zero storage hash is deleted.
+func (k *Keeper) updateStorageHash(ctx sdk.Context, addr common.Address, key, oldValue, newValue common.Hash) {
+	if oldValue == newValue {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	storageHash := types.UpdateStorageHash(k.GetStorageHash(ctx, addr), key, oldValue, newValue)
+	if storageHash == (common.Hash{}) {
+		store.Delete(types.StorageHashKey(addr))
+		return
+	}
+	store.Set(types.StorageHashKey(addr), storageHash.Bytes())
+}
+
 // GetFastState loads contract state from database.
 func (k *Keeper) GetFastState(ctx sdk.Context, addr common.Address, key common.Hash) []byte {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
@@ -90,10 +112,9 @@ func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 
 // ForEachStorage iterate contract storage, callback return false to break early
 func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
-	store := ctx.KVStore(k.storeKey)
-	prefix := types.AddressStoragePrefix(addr)
+	store, _ := k.storageStore(ctx, addr)
 
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -107,6 +128,38 @@ func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(ke
 	}
 }
 
+// ReplaceStorage replaces the storage of the given account with an empty one
+// for the rest of the block, like a state override of its whole storage. The
+// storage of the account is then read from and written to the transient store
+// while the committed one is left untouched, so that the storage doesn't have
+// to be iterated. It implements the `statedb.Keeper` interface.
+func (k *Keeper) ReplaceStorage(ctx sdk.Context, addr common.Address) {
+	tstore := ctx.TransientStore(k.transientKey)
+	tstore.Set(types.ReplacedStorageKey(addr), []byte{1})
+
+	// clear a storage replaced earlier in the block
+	store := prefix.NewStore(tstore, types.ReplacedStoragePrefix(addr))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// storageStore returns the store of the storage of the given account, which is
+// the transient one if the storage was replaced, see ReplaceStorage.
+func (k *Keeper) storageStore(ctx sdk.Context, addr common.Address) (store prefix.Store, replaced bool) {
+	tstore := ctx.TransientStore(k.transientKey)
+	if tstore.Has(types.ReplacedStorageKey(addr)) {
+		return prefix.NewStore(tstore, types.ReplacedStoragePrefix(addr)), true
+	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr)), false
+}
+
 // SetBalance update account's balance, compare with current balance first, then decide to mint or burn.
 func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *uint256.Int) error {
 	if amount == nil {
@@ -114,7 +167,7 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *uint25
 	}
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 
-	coin := k.bankWrapper.GetBalance(ctx, cosmosAddr, types.GetEVMCoinDenom())
+	coin := k.bankWrapper.GetBalance(ctx, cosmosAddr, k.GetEVMCoinInfo().Denom)
 
 	balance := coin.Amount.BigInt()
 	delta := new(big.Int).Sub(amount.ToBig(), balance)
@@ -170,7 +223,10 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 
 // SetState update contract storage.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	store, replaced := k.storageStore(ctx, addr)
+	if !replaced {
+		k.updateStorageHash(ctx, addr, key, common.BytesToHash(store.Get(key.Bytes())), common.BytesToHash(value))
+	}
 	store.Set(key.Bytes(), value)
 
 	k.Logger(ctx).Debug(
@@ -183,7 +239,10 @@ func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash,
 // DeleteState deletes the entry for the given key in the contract storage
 // at the defined contract address.
 func (k *Keeper) DeleteState(ctx sdk.Context, addr common.Address, key common.Hash) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	store, replaced := k.storageStore(ctx, addr)
+	if !replaced {
+		k.updateStorageHash(ctx, addr, key, common.BytesToHash(store.Get(key.Bytes())), common.Hash{})
+	}
 	store.Delete(key.Bytes())
 
 	k.Logger(ctx).Debug(
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	callbacksabi "github.com/cosmos/evm/precompiles/callbacks"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// UnbondingCallbackGasLimit is the gas limit of an onUnbondingCompleted call.
+	UnbondingCallbackGasLimit uint64 = 300_000
+	// UnbondingCallbacksGasDivisor sets the gas budget of the onUnbondingCompleted
+	// calls of a block, which is paid by no one, to the block gas limit divided
+	// by UnbondingCallbacksGasDivisor, and at least UnbondingCallbackGasLimit.
+	UnbondingCallbacksGasDivisor = 20
+	// MaxUnbondingCallbacksPerBlock is the maximum number of onUnbondingCompleted
+	// calls per block, which bounds them when the block gas is unlimited.
+	MaxUnbondingCallbacksPerBlock = 100
+)
+
+// SetUnbondingCallback registers or unregisters the given contract for the
+// onUnbondingCompleted callback, which is called when its unbonding delegations
+// complete.
+func (k Keeper) SetUnbondingCallback(ctx sdk.Context, contract common.Address, enabled bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingCallback)
+	if !enabled {
+		store.Delete(contract.Bytes())
+		return
+	}
+	store.Set(contract.Bytes(), []byte{1})
+}
+
+// HasUnbondingCallback returns true if the given contract is registered for
+// the onUnbondingCompleted callback.
+func (k Keeper) HasUnbondingCallback(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingCallback)
+	return store.Has(contract.Bytes())
+}
+
+// GetUnbondingCallbacks returns the contracts registered for the
+// onUnbondingCompleted callback.
+func (k Keeper) GetUnbondingCallbacks(ctx sdk.Context) []common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingCallback)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var contracts []common.Address
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, common.BytesToAddress(iterator.Key()))
+	}
+	return contracts
+}
+
+// ProcessUnbondingCallbacks queues an onUnbondingCompleted call for each
+// unbonding delegation of the registered contracts completed by the staking
+// EndBlock, which must run before the EVM one, then calls the queued callbacks
+// in order while the gas budget of the block can cover the gas limit of a
+// call, up to MaxUnbondingCallbacksPerBlock calls. The others are deferred to
+// the next blocks. A failed callback is reverted, uses its whole gas limit and
+// is reported in an event without failing the block.
+func (k *Keeper) ProcessUnbondingCallbacks(ctx sdk.Context) {
+	k.queueUnbondingCallbacks(ctx)
+
+	logger := ctx.Logger().With("end_block", "evm")
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingUnbondingCallback)
+
+	// the keys are collected first, as the store can't be written while iterated
+	var keys [][]byte
+	var callbacks []types.PendingUnbondingCallback
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid() && len(keys) < MaxUnbondingCallbacksPerBlock; iterator.Next() {
+		var callback types.PendingUnbondingCallback
+		k.cdc.MustUnmarshal(iterator.Value(), &callback)
+		keys = append(keys, iterator.Key())
+		callbacks = append(callbacks, callback)
+	}
+	iterator.Close()
+	if len(callbacks) == 0 {
+		return
+	}
+
+	abi, err := callbacksabi.LoadABI()
+	if err != nil {
+		logger.Error("failed to load the callbacks abi", "error", err.Error())
+		return
+	}
+
+	budget := max(cosmosevmtypes.BlockGasLimit(ctx)/UnbondingCallbacksGasDivisor, UnbondingCallbackGasLimit)
+	for i, callback := range callbacks {
+		if budget < UnbondingCallbackGasLimit {
+			break
+		}
+		store.Delete(keys[i])
+
+		contract := common.HexToAddress(callback.ContractAddress)
+		amount := callback.Amount.BigInt()
+		gasUsed, err := k.callOnUnbondingCompleted(ctx, abi, contract, callback.ValidatorAddress, amount)
+		budget -= gasUsed
+		if err != nil {
+			logger.Error("unbonding callback failed", "contract", contract.Hex(), "validator", callback.ValidatorAddress, "error", err.Error())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeUnbondingCallback,
+					sdk.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()),
+					sdk.NewAttribute(types.AttributeKeyValidator, callback.ValidatorAddress),
+					sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnbondingCallback,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()),
+				sdk.NewAttribute(types.AttributeKeyValidator, callback.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+}
+
+// GetPendingUnbondingCallbacks returns the onUnbondingCompleted callbacks
+// waiting to be called, in order.
+func (k Keeper) GetPendingUnbondingCallbacks(ctx sdk.Context) []types.PendingUnbondingCallback {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingUnbondingCallback)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var callbacks []types.PendingUnbondingCallback
+	for ; iterator.Valid(); iterator.Next() {
+		var callback types.PendingUnbondingCallback
+		k.cdc.MustUnmarshal(iterator.Value(), &callback)
+		callbacks = append(callbacks, callback)
+	}
+	return callbacks
+}
+
+// SetPendingUnbondingCallback queues the given callback, keyed by the given
+// height and index which set its order in the queue.
+func (k Keeper) SetPendingUnbondingCallback(ctx sdk.Context, height, index uint64, callback types.PendingUnbondingCallback) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingUnbondingCallback)
+	key := append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(index)...)
+	store.Set(key, k.cdc.MustMarshal(&callback))
+}
+
+// queueUnbondingCallbacks stores a pending callback for each unbonding
+// delegation of the registered contracts completed in the current block,
+// keyed by the block height and the index of the completion event.
+func (k Keeper) queueUnbondingCallbacks(ctx sdk.Context) {
+	logger := ctx.Logger().With("end_block", "evm")
+	height := uint64(ctx.BlockHeight()) //#nosec G115 -- int overflow is not a concern here
+
+	for i, event := range ctx.EventManager().Events() {
+		if event.Type != stakingtypes.EventTypeCompleteUnbonding {
+			continue
+		}
+
+		var delegator, validator, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case stakingtypes.AttributeKeyDelegator:
+				delegator = attr.Value
+			case stakingtypes.AttributeKeyValidator:
+				validator = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(delegator)
+		if err != nil {
+			logger.Error("invalid delegator address in complete unbonding event", "delegator", delegator, "error", err.Error())
+			continue
+		}
+		contract := common.BytesToAddress(delAddr)
+		if !k.HasUnbondingCallback(ctx, contract) {
+			continue
+		}
+
+		// the unbonding delegations only hold the bond denom
+		balance := sdkmath.ZeroInt()
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err == nil && len(coins) > 0 {
+			balance = coins[0].Amount
+		}
+
+		callback := types.PendingUnbondingCallback{
+			ContractAddress:  contract.Hex(),
+			ValidatorAddress: validator,
+			Amount:           balance,
+		}
+		k.SetPendingUnbondingCallback(ctx, height, uint64(i), callback)
+	}
+}
+
+// callOnUnbondingCompleted calls the onUnbondingCompleted function of the given
+// contract from the EVM module account, with at most UnbondingCallbackGasLimit
+// gas, and returns the gas used. The state changes are only committed if the
+// call succeeds, otherwise the whole gas limit is used.
+func (k *Keeper) callOnUnbondingCompleted(ctx sdk.Context, abi *abi.ABI, contract common.Address, validator string, amount *big.Int) (uint64, error) {
+	// Check if the contract address contains code, since the call would
+	// otherwise succeed without executing anything.
+	if !k.GetAccountOrEmpty(ctx, contract).IsContract() {
+		return 0, types.ErrUnbondingCallbackFailed.Wrapf("%s is not a contract", contract)
+	}
+
+	// the module account is created if it doesn't exist yet, as the call
+	// requires the sequence of the sender
+	from := common.BytesToAddress(k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress())
+
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = evmante.BuildEvmExecutionCtx(cachedCtx).
+		WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(UnbondingCallbackGasLimit))
+
+	gasCap := new(big.Int).SetUint64(UnbondingCallbackGasLimit)
+	res, err := k.CallEVM(cachedCtx, *abi, from, contract, true, gasCap, "onUnbondingCompleted", validator, amount)
+	if err != nil {
+		return UnbondingCallbackGasLimit, types.ErrUnbondingCallbackFailed.Wrap(err.Error())
+	}
+
+	writeFn()
+	return min(res.GasUsed, UnbondingCallbackGasLimit), nil
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/cosmos/evm/x/vm/types"
 )
@@ -44,4 +45,8 @@ type EVMConfig struct {
 	CoinBase                common.Address
 	BaseFee                 *big.Int
 	EnablePreimageRecording bool
+	// GetHash overrides the block hashes served by the BLOCKHASH opcode, e.g.
+	// for the simulated blocks of eth_simulateV1. The hashes of the chain are
+	// served if it is nil.
+	GetHash vm.GetHashFunc
 }
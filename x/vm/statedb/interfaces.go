@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	"github.com/cosmos/evm/x/vm/types"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,8 +37,14 @@ type Keeper interface {
 	DeleteCode(ctx sdk.Context, codeHash []byte)
 	SetCode(ctx sdk.Context, codeHash []byte, code []byte)
 	DeleteAccount(ctx sdk.Context, addr common.Address) error
+	// ReplaceStorage empties the storage of the account for the rest of the
+	// block, leaving the committed one untouched
+	ReplaceStorage(ctx sdk.Context, addr common.Address)
 
 	// Getter for injected KVStore keys
 	// It is used for StateDB.snapshotter creation
 	KVStoreKeys() map[string]*storetypes.KVStoreKey
+
+	// EVMAppConfig returns the EVM coin and chain configuration of the app
+	EVMAppConfig() *types.EVMAppConfig
 }
@@ -124,6 +124,11 @@ type (
 		account       *common.Address
 		key, prevalue common.Hash
 	}
+	storageReplaceChange struct {
+		account               *common.Address
+		prevReplaced          bool
+		prevOrigin, prevDirty Storage
+	}
 	codeChange struct {
 		account            *common.Address
 		prevcode, prevhash []byte
@@ -161,6 +166,7 @@ var (
 	_ JournalEntry = nonceChange{}
 	_ JournalEntry = storageChange{}
 	_ JournalEntry = transientStorageChange{}
+	_ JournalEntry = storageReplaceChange{}
 	_ JournalEntry = codeChange{}
 	_ JournalEntry = refundChange{}
 	_ JournalEntry = addLogChange{}
@@ -247,6 +253,17 @@ func (ch storageChange) Dirtied() *common.Address {
 	return ch.account
 }
 
+func (ch storageReplaceChange) Revert(s *StateDB) {
+	obj := s.getStateObject(*ch.account)
+	obj.storageReplaced = ch.prevReplaced
+	obj.originStorage = ch.prevOrigin
+	obj.dirtyStorage = ch.prevDirty
+}
+
+func (ch storageReplaceChange) Dirtied() *common.Address {
+	return ch.account
+}
+
 func (ch transientStorageChange) Revert(s *StateDB) {
 	s.setTransientState(*ch.account, ch.key, ch.prevalue)
 }
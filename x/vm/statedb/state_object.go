@@ -73,6 +73,9 @@ type stateObject struct {
 	dirtyCode      bool
 	selfDestructed bool
 	newContract    bool
+	// storageReplaced is set by a state override of the whole storage, the
+	// keys out of it read as empty instead of being loaded from the keeper
+	storageReplaced bool
 }
 
 // newObject creates a state object.
@@ -231,6 +234,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.storageReplaced {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
@@ -266,3 +272,16 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) common.Hash {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the committed storage of the account with the given one.
+func (s *stateObject) SetStorage(storage Storage) {
+	s.db.journal.append(storageReplaceChange{
+		account:      &s.address,
+		prevReplaced: s.storageReplaced,
+		prevOrigin:   s.originStorage,
+		prevDirty:    s.dirtyStorage,
+	})
+	s.storageReplaced = true
+	s.originStorage = storage.Copy()
+	s.dirtyStorage = make(Storage)
+}
@@ -73,10 +73,15 @@ type StateDB struct {
 
 	// Per-transaction logs
 	logs []*ethtypes.Log
+	// onLog is called with every log added to the StateDB, if set
+	onLog tracing.LogHook
 
 	// Per-transaction access list
 	accessList *accessList
 
+	// SHA3 preimages seen by the VM, only recorded when enabled on the vm.Config
+	preimages map[common.Hash][]byte
+
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
 }
@@ -144,6 +149,7 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		accessList:       newAccessList(),
 		transientStorage: newTransientStorage(),
 		txConfig:         txConfig,
+		preimages:        make(map[common.Hash][]byte),
 	}
 }
 
@@ -152,6 +158,12 @@ func (s *StateDB) Keeper() Keeper {
 	return s.keeper
 }
 
+// EVMAppConfig returns the EVM coin and chain configuration of the app, e.g.
+// for the precompiles to use the EVM coin of the app the state belongs to.
+func (s *StateDB) EVMAppConfig() *types.EVMAppConfig {
+	return s.keeper.EVMAppConfig()
+}
+
 // GetContext returns the transaction Context.
 func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
@@ -214,6 +226,16 @@ func (s *StateDB) AddLog(log *ethtypes.Log) {
 	log.TxIndex = s.txConfig.TxIndex
 	log.Index = s.txConfig.LogIndex + uint(len(s.logs))
 	s.logs = append(s.logs, log)
+
+	if s.onLog != nil {
+		s.onLog(log)
+	}
+}
+
+// SetLogHook sets the hook called with every log added to the StateDB. It's
+// used to forward the logs to the tracer of the EVM.
+func (s *StateDB) SetLogHook(onLog tracing.LogHook) {
+	s.onLog = onLog
 }
 
 // Logs returns the logs of current transaction.
@@ -319,11 +341,20 @@ func (s *StateDB) GetRefund() uint64 {
 	return s.refund
 }
 
-// AddPreimage records a SHA3 preimage seen by the VM.
-// AddPreimage performs a no-op since the EnablePreimageRecording flag is disabled
-// on the vm.Config during state transitions. No store trie preimages are written
-// to the database.
-func (s *StateDB) AddPreimage(_ common.Hash, _ []byte) {}
+// AddPreimage records a SHA3 preimage seen by the VM. It is only called when
+// the EnablePreimageRecording flag is set on the vm.Config. The preimages are
+// not journaled, as a preimage stays valid when the state is reverted.
+func (s *StateDB) AddPreimage(hash common.Hash, preimage []byte) {
+	if _, ok := s.preimages[hash]; !ok {
+		// copy into a non-nil slice, as the VM passes nil for empty memory
+		s.preimages[hash] = append([]byte{}, preimage...)
+	}
+}
+
+// Preimages returns the SHA3 preimages recorded by the VM.
+func (s *StateDB) Preimages() map[common.Hash][]byte {
+	return s.preimages
+}
 
 // getStateObject retrieves a state object given by the address, returning nil if
 // the object is not found.
@@ -393,6 +424,22 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.storageReplaced {
+		// the committed storage is the one of the state override
+		storage := so.originStorage.Copy()
+		for key, value := range so.dirtyStorage {
+			storage[key] = value
+		}
+		for _, key := range storage.SortedKeys() {
+			if storage[key] == (common.Hash{}) {
+				continue
+			}
+			if !cb(key, storage[key]) {
+				break
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -450,6 +497,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *uint256.Int, reason tr
 	return stateObject.SubBalance(amount)
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *uint256.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64, reason tracing.NonceChangeReason) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -477,6 +532,18 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) common.H
 	return common.Hash{}
 }
 
+// SetStorage replaces the entire storage for the specified account with given
+// storage. Like in geth, the storage of the account is marked as replaced
+// instead of being cleared, the keys out of the given storage read as empty.
+// On commit the keeper replaces the storage for the rest of the block only, see
+// Keeper.ReplaceStorage, so this function should only be used for debugging and
+// the mutations must be discarded afterwards.
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	if stateObject := s.getOrNewStateObject(addr); stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // SelfDestruct marks the given account as self-destructed.
 // This clears the account balance.
 //
@@ -692,8 +759,17 @@ func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
 
-			for _, key := range obj.dirtyStorage.SortedKeys() {
-				valueBytes := obj.dirtyStorage[key].Bytes()
+			storage := obj.dirtyStorage
+			if obj.storageReplaced {
+				// the replaced storage starts empty in the keeper
+				s.keeper.ReplaceStorage(ctx, obj.Address())
+				storage = obj.originStorage.Copy()
+				for key, value := range obj.dirtyStorage {
+					storage[key] = value
+				}
+			}
+			for _, key := range storage.SortedKeys() {
+				valueBytes := storage[key].Bytes()
 				if len(valueBytes) == 0 {
 					s.keeper.DeleteState(ctx, obj.Address(), key)
 				} else {
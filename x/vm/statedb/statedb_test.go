@@ -260,6 +260,33 @@ func (suite *StateDBTestSuite) TestState() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestSetStorage() {
+	ctx := sdk.Context{}
+
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	key2 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+
+	keeper := mocks.NewEVMKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	// replace the committed storage
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetStorage(address, statedb.Storage{key2: value2})
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key1))
+	suite.Require().Equal(value2, db.GetState(address, key2))
+	suite.Require().Equal(value2, db.GetCommittedState(address, key2))
+
+	suite.Require().NoError(db.Commit())
+
+	suite.Require().Equal(common.Hash{}, keeper.GetState(ctx, address, key1))
+	suite.Require().Equal(value2, keeper.GetState(ctx, address, key2))
+}
+
 func (suite *StateDBTestSuite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
@@ -538,6 +565,28 @@ func (suite *StateDBTestSuite) TestLog() {
 	suite.Require().Equal(expecedLog, db.Logs()[1])
 }
 
+func (suite *StateDBTestSuite) TestPreimage() {
+	db := statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), emptyTxConfig)
+	preimage := []byte("hello world")
+	hash := crypto.Keccak256Hash(preimage)
+
+	db.AddPreimage(hash, preimage)
+	// the recorded preimage doesn't alias the VM memory
+	preimage[0] = 'j'
+	// the first preimage recorded for a hash is kept
+	db.AddPreimage(hash, []byte("other"))
+
+	snapshot := db.Snapshot()
+	db.AddPreimage(common.Hash{}, nil)
+	// the preimages survive a revert
+	db.RevertToSnapshot(snapshot)
+
+	suite.Require().Equal(map[common.Hash][]byte{
+		hash:          []byte("hello world"),
+		common.Hash{}: {},
+	}, db.Preimages())
+}
+
 func (suite *StateDBTestSuite) TestRefund() {
 	testCases := []struct {
 		name      string
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AuthorizationList is an EIP-7702 authorization list that represents the slice
+// of the protobuf SetCodeAuthorizations.
+type AuthorizationList []SetCodeAuthorization
+
+// NewAuthorizationList creates a new protobuf-compatible AuthorizationList from
+// an ethereum core authorization list
+func NewAuthorizationList(ethAuthList []ethtypes.SetCodeAuthorization) AuthorizationList {
+	if ethAuthList == nil {
+		return nil
+	}
+
+	al := make(AuthorizationList, 0, len(ethAuthList))
+	for _, auth := range ethAuthList {
+		al = append(al, SetCodeAuthorization{
+			ChainID: sdkmath.NewIntFromBigInt(auth.ChainID.ToBig()),
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+			V:       uint32(auth.V),
+			R:       auth.R.Bytes(),
+			S:       auth.S.Bytes(),
+		})
+	}
+
+	return al
+}
+
+// Copy returns a deep copy of the authorization list.
+func (al AuthorizationList) Copy() AuthorizationList {
+	if al == nil {
+		return nil
+	}
+
+	cpy := make(AuthorizationList, 0, len(al))
+	for _, auth := range al {
+		if !auth.ChainID.IsNil() {
+			auth.ChainID = sdkmath.NewIntFromBigInt(auth.ChainID.BigInt())
+		}
+		auth.R = common.CopyBytes(auth.R)
+		auth.S = common.CopyBytes(auth.S)
+		cpy = append(cpy, auth)
+	}
+	return cpy
+}
+
+// ToEthAuthorizationList is an utility function to convert the protobuf compatible
+// AuthorizationList to eth core authorization list from go-ethereum
+func (al AuthorizationList) ToEthAuthorizationList() []ethtypes.SetCodeAuthorization {
+	ethAuthList := make([]ethtypes.SetCodeAuthorization, 0, len(al))
+
+	for _, auth := range al {
+		chainID := new(uint256.Int)
+		if !auth.ChainID.IsNil() {
+			chainID, _ = uint256.FromBig(auth.ChainID.BigInt())
+		}
+
+		ethAuthList = append(ethAuthList, ethtypes.SetCodeAuthorization{
+			ChainID: *chainID,
+			Address: common.HexToAddress(auth.Address),
+			Nonce:   auth.Nonce,
+			V:       uint8(auth.V), //nolint:gosec // G115 // V is validated to fit in an uint8
+			R:       *new(uint256.Int).SetBytes(auth.R),
+			S:       *new(uint256.Int).SetBytes(auth.S),
+		})
+	}
+
+	return ethAuthList
+}
+
+// Validate performs a stateless validation of the authorization list fields.
+// Note that the signatures are only checked when the authorizations are applied,
+// since invalid authorizations are skipped instead of invalidating the transaction.
+func (al AuthorizationList) Validate() error {
+	for i, auth := range al {
+		if auth.ChainID.IsNil() || auth.ChainID.IsNegative() || !types.IsValidInt256(auth.ChainID.BigInt()) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidChainID, "invalid chain id in authorization %d", i)
+		}
+
+		if err := types.ValidateAddress(auth.Address); err != nil {
+			return errorsmod.Wrapf(err, "invalid address in authorization %d", i)
+		}
+
+		if auth.V > math.MaxUint8 || len(auth.R) > 32 || len(auth.S) > 32 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid signature values in authorization %d", i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAuthorization performs the stateless checks of an EIP-7702
+// authorization and returns the authority address that signed it. The checks
+// against the authority account are done when the authorization is applied.
+func ValidateAuthorization(chainID *big.Int, auth ethtypes.SetCodeAuthorization) (common.Address, error) {
+	// the chain ID must be null or equal to the current one
+	if !auth.ChainID.IsZero() && auth.ChainID.CmpBig(chainID) != 0 {
+		return common.Address{}, core.ErrAuthorizationWrongChainID
+	}
+	// the nonce is limited to 2^64-1 per EIP-2681
+	if auth.Nonce+1 < auth.Nonce {
+		return common.Address{}, core.ErrAuthorizationNonceOverflow
+	}
+	authority, err := auth.Authority()
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(core.ErrAuthorizationInvalidSignature, err.Error())
+	}
+	return authority, nil
+}
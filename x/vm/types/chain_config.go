@@ -216,6 +216,100 @@ func (cc ChainConfig) Validate() error {
 	return nil
 }
 
+// ValidateUpdate returns an error if the chain config can't be replaced by the
+// updated one at the given block height and time. The chain ID, denom and
+// decimals can't change, and only the forks that are not activated yet can be
+// changed, either to a later block or time than the current one, or to nil to
+// cancel them.
+func (cc ChainConfig) ValidateUpdate(updated ChainConfig, height int64, time uint64) error {
+	if updated.ChainId != cc.ChainId {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "chain ID cannot change: %d != %d", updated.ChainId, cc.ChainId)
+	}
+	if updated.Denom != cc.Denom {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "denom cannot change: %s != %s", updated.Denom, cc.Denom)
+	}
+	if updated.Decimals != cc.Decimals {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "decimals cannot change: %d != %d", updated.Decimals, cc.Decimals)
+	}
+
+	blockHead := sdkmath.NewInt(height)
+	timeHead := sdkmath.NewIntFromUint64(time)
+
+	current, next := cc.forks(), updated.forks()
+	for i, fork := range current {
+		head := blockHead
+		if fork.timestamp {
+			head = timeHead
+		}
+
+		if equalForkValues(fork.value, next[i].value) {
+			continue
+		}
+		if isForkActivated(fork.value, head) {
+			return errorsmod.Wrapf(ErrInvalidChainConfig, "%s is already activated at %s", fork.name, fork.value)
+		}
+		if next[i].value != nil && next[i].value.LTE(head) {
+			return errorsmod.Wrapf(
+				ErrInvalidChainConfig, "%s must be scheduled after %s, got %s", fork.name, head, next[i].value,
+			)
+		}
+	}
+
+	if updated.DAOForkSupport != cc.DAOForkSupport && isForkActivated(cc.DAOForkBlock, blockHead) {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "daoForkSupport cannot change after daoForkBlock %s", cc.DAOForkBlock)
+	}
+
+	return nil
+}
+
+// chainConfigFork is a fork of the chain config, activated at a block height
+// or at a timestamp.
+type chainConfigFork struct {
+	name      string
+	value     *sdkmath.Int
+	timestamp bool
+}
+
+// forks returns the forks of the chain config, in a fixed order.
+func (cc ChainConfig) forks() []chainConfigFork {
+	return []chainConfigFork{
+		{name: "homesteadBlock", value: cc.HomesteadBlock},
+		{name: "daoForkBlock", value: cc.DAOForkBlock},
+		{name: "eip150Block", value: cc.EIP150Block},
+		{name: "eip155Block", value: cc.EIP155Block},
+		{name: "eip158Block", value: cc.EIP158Block},
+		{name: "byzantiumBlock", value: cc.ByzantiumBlock},
+		{name: "constantinopleBlock", value: cc.ConstantinopleBlock},
+		{name: "petersburgBlock", value: cc.PetersburgBlock},
+		{name: "istanbulBlock", value: cc.IstanbulBlock},
+		{name: "muirGlacierBlock", value: cc.MuirGlacierBlock},
+		{name: "berlinBlock", value: cc.BerlinBlock},
+		{name: "londonBlock", value: cc.LondonBlock},
+		{name: "arrowGlacierBlock", value: cc.ArrowGlacierBlock},
+		{name: "grayGlacierBlock", value: cc.GrayGlacierBlock},
+		{name: "mergeNetsplitBlock", value: cc.MergeNetsplitBlock},
+		{name: "shanghaiTime", value: cc.ShanghaiTime, timestamp: true},
+		{name: "cancunTime", value: cc.CancunTime, timestamp: true},
+		{name: "pragueTime", value: cc.PragueTime, timestamp: true},
+		{name: "verkleTime", value: cc.VerkleTime, timestamp: true},
+		{name: "osakaTime", value: cc.OsakaTime, timestamp: true},
+	}
+}
+
+// isForkActivated returns true if a fork at the given block or timestamp value
+// is activated at the head block or timestamp.
+func isForkActivated(value *sdkmath.Int, head sdkmath.Int) bool {
+	return value != nil && !value.IsNegative() && value.LTE(head)
+}
+
+// equalForkValues returns true if both fork values are nil or equal.
+func equalForkValues(a, b *sdkmath.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
 func validateBlockOrTimestamp(value *sdkmath.Int) error {
 	// nil value means that the fork has not yet been applied
 	if value == nil {
@@ -353,3 +353,101 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestChainConfigValidateUpdate(t *testing.T) {
+	const (
+		height    = int64(10)
+		blockTime = uint64(1000)
+	)
+
+	scheduled := *types.DefaultChainConfig(0)
+	scheduled.OsakaTime = newIntPtr(2000)
+
+	testCases := []struct {
+		name     string
+		current  types.ChainConfig
+		malleate func(cc *types.ChainConfig)
+		expError bool
+	}{
+		{
+			"pass - no change",
+			*types.DefaultChainConfig(0),
+			func(_ *types.ChainConfig) {},
+			false,
+		},
+		{
+			"pass - schedule a fork time",
+			*types.DefaultChainConfig(0),
+			func(cc *types.ChainConfig) { cc.OsakaTime = newIntPtr(2000) },
+			false,
+		},
+		{
+			"pass - reschedule a fork time not activated yet",
+			scheduled,
+			func(cc *types.ChainConfig) { cc.OsakaTime = newIntPtr(3000) },
+			false,
+		},
+		{
+			"pass - cancel a fork not activated yet",
+			scheduled,
+			func(cc *types.ChainConfig) { cc.OsakaTime = nil },
+			false,
+		},
+		{
+			"fail - schedule a fork time at the current block time",
+			*types.DefaultChainConfig(0),
+			func(cc *types.ChainConfig) { cc.OsakaTime = newIntPtr(int64(blockTime)) },
+			true,
+		},
+		{
+			"fail - change an activated fork time",
+			*types.DefaultChainConfig(0),
+			func(cc *types.ChainConfig) { cc.PragueTime = newIntPtr(2000) },
+			true,
+		},
+		{
+			"fail - cancel an activated fork block",
+			*types.DefaultChainConfig(0),
+			func(cc *types.ChainConfig) { cc.LondonBlock = nil },
+			true,
+		},
+		{
+			"fail - change the DAO fork support after the fork",
+			*types.DefaultChainConfig(0),
+			func(cc *types.ChainConfig) { cc.DAOForkSupport = false },
+			true,
+		},
+		{
+			"fail - change the chain ID",
+			*types.DefaultChainConfig(0),
+			func(cc *types.ChainConfig) { cc.ChainId++ },
+			true,
+		},
+		{
+			"fail - change the denom",
+			*types.DefaultChainConfig(0),
+			func(cc *types.ChainConfig) { cc.Denom = "other" },
+			true,
+		},
+		{
+			"fail - change the decimals",
+			*types.DefaultChainConfig(0),
+			func(cc *types.ChainConfig) { cc.Decimals = 6 },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := tc.current
+			tc.malleate(&updated)
+
+			err := tc.current.ValidateUpdate(updated, height, blockTime)
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -25,7 +25,11 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "os/evm/MsgUpdateParams"
+	updateParamsName      = "os/evm/MsgUpdateParams"
+	updateChainConfigName = "os/evm/MsgUpdateChainConfig"
+	devSetStateName       = "os/evm/MsgDevSetState"
+	devIncreaseTimeName   = "os/evm/MsgDevIncreaseTime"
+	devSendTxName         = "os/evm/MsgDevSendTransaction"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,10 +48,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgUpdateChainConfig{},
+		&MsgDevSetState{},
+		&MsgDevIncreaseTime{},
+		&MsgDevSendTransaction{},
 	)
 	registry.RegisterInterface(
 		"os.vm.v1.TxData",
 		(*TxData)(nil),
+		&SetCodeTx{},
 		&DynamicFeeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
@@ -91,4 +100,8 @@ func UnpackTxData(anyTxData *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateChainConfig{}, updateChainConfigName, nil)
+	cdc.RegisterConcrete(&MsgDevSetState{}, devSetStateName, nil)
+	cdc.RegisterConcrete(&MsgDevIncreaseTime{}, devIncreaseTimeName, nil)
+	cdc.RegisterConcrete(&MsgDevSendTransaction{}, devSendTxName, nil)
 }
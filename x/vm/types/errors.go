@@ -32,6 +32,10 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrBatchTxFailed
+	codeErrDevModeDisabled
+	codeErrUnbondingCallbackFailed
+	codeErrDevStateTooLarge
 )
 
 var (
@@ -92,6 +96,19 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrBatchTxFailed returns an error if an ethereum tx of a cosmos tx made of several ones fails,
+	// reverting all of them.
+	ErrBatchTxFailed = errorsmod.Register(ModuleName, codeErrBatchTxFailed, "ethereum tx batch failed")
+
+	// ErrDevModeDisabled returns an error if a dev operation is requested while the dev mode is disabled.
+	ErrDevModeDisabled = errorsmod.Register(ModuleName, codeErrDevModeDisabled, "dev mode is disabled")
+
+	// ErrUnbondingCallbackFailed returns an error if the onUnbondingCompleted callback of a contract fails.
+	ErrUnbondingCallbackFailed = errorsmod.Register(ModuleName, codeErrUnbondingCallbackFailed, "unbonding callback failed")
+
+	// ErrDevStateTooLarge returns an error if the state dumped in dev mode has too many accounts and storage slots.
+	ErrDevStateTooLarge = errorsmod.Register(ModuleName, codeErrDevStateTooLarge, "dev state too large")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
@@ -74,6 +74,14 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// dev_mode enables the dev messages and the `dev` JSON-RPC namespace, that
+	// override the EVM state and the block time for testing. It must only be
+	// enabled on local and test networks.
+	DevMode bool `protobuf:"varint,10,opt,name=dev_mode,json=devMode,proto3" json:"dev_mode,omitempty"`
+	// dev_authority is the address of the only account allowed to submit the dev
+	// messages. The dev messages are restricted to the module authority when it
+	// is empty.
+	DevAuthority string `protobuf:"bytes,11,opt,name=dev_authority,json=devAuthority,proto3" json:"dev_authority,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -151,6 +159,20 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetDevMode() bool {
+	if m != nil {
+		return m.DevMode
+	}
+	return false
+}
+
+func (m *Params) GetDevAuthority() string {
+	if m != nil {
+		return m.DevAuthority
+	}
+	return ""
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -722,6 +744,57 @@ func (m *AccessTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
 
+// SetCodeAuthorization is an authorization from an account to delegate its
+// code execution to the code deployed at another address (EIP-7702).
+type SetCodeAuthorization struct {
+	// chain_id of the chain where the authorization is valid. A zero value means
+	// the authorization is valid on every chain.
+	ChainID cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=cosmossdk.io/math.Int" json:"chainID"`
+	// address is the hex formatted address of the code the authority delegates to
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// nonce is the expected nonce of the authority account
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// v defines the signature y parity
+	V uint32 `protobuf:"varint,4,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the signature value
+	R []byte `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value
+	S []byte `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *SetCodeAuthorization) Reset()         { *m = SetCodeAuthorization{} }
+func (m *SetCodeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SetCodeAuthorization) ProtoMessage()    {}
+func (*SetCodeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
+}
+func (m *SetCodeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCodeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCodeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCodeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCodeAuthorization.Merge(m, src)
+}
+func (m *SetCodeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCodeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCodeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCodeAuthorization proto.InternalMessageInfo
+
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
 	// tracer is a custom javascript tracer
@@ -753,7 +826,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -874,7 +947,7 @@ func (m *Preinstall) Reset()         { *m = Preinstall{} }
 func (m *Preinstall) String() string { return proto.CompactTextString(m) }
 func (*Preinstall) ProtoMessage()    {}
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{10}
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
 }
 func (m *Preinstall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,6 +997,64 @@ func (m *Preinstall) GetCode() string {
 	return ""
 }
 
+// PendingUnbondingCallback defines an onUnbondingCompleted callback waiting to
+// be called, as the number of callbacks called per block is capped
+type PendingUnbondingCallback struct {
+	// contract_address in hex format of the contract to call
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// validator_address of the completed unbonding delegation
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount of the completed unbonding delegation
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *PendingUnbondingCallback) Reset()         { *m = PendingUnbondingCallback{} }
+func (m *PendingUnbondingCallback) String() string { return proto.CompactTextString(m) }
+func (*PendingUnbondingCallback) ProtoMessage()    {}
+func (*PendingUnbondingCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{12}
+}
+func (m *PendingUnbondingCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingUnbondingCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingUnbondingCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingUnbondingCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUnbondingCallback.Merge(m, src)
+}
+func (m *PendingUnbondingCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingUnbondingCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUnbondingCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUnbondingCallback proto.InternalMessageInfo
+
+func (m *PendingUnbondingCallback) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *PendingUnbondingCallback) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
@@ -935,139 +1066,152 @@ func init() {
 	proto.RegisterType((*Log)(nil), "cosmos.evm.vm.v1.Log")
 	proto.RegisterType((*TxResult)(nil), "cosmos.evm.vm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "cosmos.evm.vm.v1.AccessTuple")
+	proto.RegisterType((*SetCodeAuthorization)(nil), "cosmos.evm.vm.v1.SetCodeAuthorization")
 	proto.RegisterType((*TraceConfig)(nil), "cosmos.evm.vm.v1.TraceConfig")
 	proto.RegisterType((*Preinstall)(nil), "cosmos.evm.vm.v1.Preinstall")
+	proto.RegisterType((*PendingUnbondingCallback)(nil), "cosmos.evm.vm.v1.PendingUnbondingCallback")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 1988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x5d, 0x6f, 0x1b, 0xc7,
-	0xd5, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xc9, 0x34, 0xed, 0x68, 0xf9, 0xee,
-	0xdb, 0x0b, 0xd5, 0x48, 0x25, 0x4b, 0x8e, 0x5a, 0xc3, 0xe9, 0x07, 0x44, 0x99, 0x69, 0xa5, 0xda,
-	0x0e, 0x31, 0x54, 0x12, 0xa4, 0x68, 0xb1, 0x18, 0xee, 0x8e, 0x97, 0x1b, 0xee, 0xee, 0x10, 0x3b,
-	0x4b, 0x86, 0xea, 0x2f, 0x08, 0x7c, 0x95, 0xfe, 0x00, 0x03, 0x01, 0x7a, 0x93, 0x4b, 0xff, 0x84,
-	0x5e, 0x06, 0xbd, 0xca, 0x65, 0x51, 0xa0, 0x8b, 0x82, 0xbe, 0x08, 0xa0, 0x4b, 0xfd, 0x82, 0x62,
-	0x3e, 0xf8, 0x29, 0x85, 0x55, 0x01, 0xc1, 0x9e, 0xe7, 0xcc, 0x39, 0xcf, 0x73, 0x66, 0xe6, 0xec,
-	0xec, 0x59, 0x82, 0x8a, 0x43, 0x59, 0x48, 0xd9, 0x3e, 0xe9, 0x87, 0xfb, 0xfc, 0xef, 0x80, 0x8f,
-	0xf6, 0xba, 0x31, 0x4d, 0x28, 0x34, 0xe4, 0xdc, 0x1e, 0xb7, 0xf0, 0xbf, 0x83, 0xca, 0x1d, 0x1c,
-	0xfa, 0x11, 0xdd, 0x17, 0xff, 0x4a, 0xa7, 0x4a, 0xc9, 0xa3, 0x1e, 0x15, 0xc3, 0x7d, 0x3e, 0x92,
-	0x56, 0xeb, 0x6d, 0x16, 0xac, 0x36, 0x70, 0x8c, 0x43, 0x06, 0x0f, 0x40, 0x8e, 0xf4, 0x43, 0xdb,
-	0x25, 0x11, 0x0d, 0xcb, 0x99, 0x6a, 0x66, 0x37, 0x57, 0x2b, 0x5d, 0xa5, 0xa6, 0x71, 0x81, 0xc3,
-	0xe0, 0xa9, 0x35, 0x9e, 0xb2, 0x90, 0x4e, 0xfa, 0xe1, 0x33, 0x3e, 0x84, 0xc7, 0x00, 0x90, 0x41,
-	0x12, 0x63, 0x9b, 0xf8, 0x5d, 0x56, 0xd6, 0xaa, 0xd9, 0xdd, 0x6c, 0xcd, 0x1a, 0xa6, 0x66, 0xae,
-	0xce, 0xad, 0xf5, 0xd3, 0x06, 0xbb, 0x4a, 0xcd, 0x3b, 0x8a, 0x60, 0xec, 0x68, 0xa1, 0x9c, 0x00,
-	0x75, 0xbf, 0xcb, 0xe0, 0x21, 0xd8, 0xc2, 0x41, 0x40, 0xbf, 0xb4, 0x7b, 0x11, 0xcf, 0x88, 0x38,
-	0x09, 0x71, 0xed, 0x64, 0xc0, 0xca, 0x2b, 0xd5, 0xcc, 0xae, 0x8e, 0x36, 0xc5, 0xe4, 0x27, 0x93,
-	0xb9, 0xf3, 0x01, 0x8f, 0x29, 0xf0, 0x74, 0x9c, 0x36, 0x8e, 0x22, 0x12, 0xb0, 0xf2, 0x5a, 0x35,
-	0xbb, 0x9b, 0xab, 0x6d, 0x0c, 0x53, 0x33, 0x5f, 0xff, 0xf4, 0xc5, 0x89, 0x32, 0xa3, 0x3c, 0xe9,
-	0x87, 0x23, 0x00, 0xff, 0x04, 0x8a, 0xd8, 0x71, 0x08, 0x63, 0xb6, 0x43, 0xa3, 0x24, 0xa6, 0x41,
-	0x59, 0xaf, 0x66, 0x76, 0xf3, 0x87, 0xe6, 0xde, 0xfc, 0xe6, 0xed, 0x1d, 0x0b, 0xbf, 0x13, 0xe9,
-	0x56, 0xdb, 0xfa, 0x2e, 0x35, 0x97, 0x86, 0xa9, 0xb9, 0x3e, 0x63, 0x46, 0xeb, 0x78, 0x1a, 0xc2,
-	0xa7, 0xe0, 0x1e, 0x76, 0x12, 0xbf, 0x4f, 0x6c, 0x96, 0xe0, 0xc4, 0x77, 0xec, 0x6e, 0x4c, 0x1c,
-	0x1a, 0x76, 0xfd, 0x80, 0xb0, 0x72, 0x8e, 0xe7, 0x87, 0xee, 0x4a, 0x87, 0xa6, 0x98, 0x6f, 0x4c,
-	0xa6, 0x9f, 0xde, 0x7f, 0xfd, 0xc3, 0xdb, 0x87, 0xdb, 0x53, 0xe7, 0x3b, 0xe0, 0x27, 0x2c, 0x4f,
-	0xe5, 0x4c, 0xd3, 0x97, 0x8d, 0xec, 0x99, 0xa6, 0x67, 0x0d, 0xed, 0x4c, 0xd3, 0x57, 0x8d, 0x35,
-	0xeb, 0x2f, 0x19, 0x30, 0x9b, 0x0b, 0x3c, 0x06, 0xab, 0x4e, 0x4c, 0x70, 0x42, 0xc4, 0xb1, 0xe5,
-	0x0f, 0xff, 0xff, 0xbf, 0xac, 0xe9, 0xfc, 0xa2, 0x4b, 0x6a, 0x1a, 0x5f, 0x17, 0x52, 0x81, 0xf0,
-	0x57, 0x40, 0x73, 0x70, 0x10, 0x94, 0x97, 0xff, 0x57, 0x02, 0x11, 0x66, 0xfd, 0x2b, 0x03, 0xee,
-	0x5c, 0xf3, 0x80, 0x0e, 0xc8, 0xab, 0x3d, 0x4f, 0x2e, 0xba, 0x32, 0xb9, 0xe2, 0xe1, 0x83, 0x1f,
-	0xe3, 0x16, 0xa4, 0x3f, 0x19, 0xa6, 0x26, 0x98, 0xe0, 0xab, 0xd4, 0x84, 0xb2, 0x7c, 0xa6, 0x88,
-	0x2c, 0x04, 0xf0, 0xd8, 0x03, 0x3a, 0x60, 0x73, 0xf6, 0x60, 0xed, 0xc0, 0x67, 0x49, 0x79, 0x59,
-	0xd4, 0xc4, 0xe3, 0x61, 0x6a, 0xce, 0x26, 0xf6, 0xdc, 0x67, 0xc9, 0x55, 0x6a, 0x56, 0x66, 0x58,
-	0xa7, 0x23, 0x2d, 0x74, 0x07, 0xcf, 0x07, 0x58, 0xdf, 0x1a, 0x20, 0x7f, 0xd2, 0xc6, 0x7e, 0x74,
-	0x42, 0xa3, 0x57, 0xbe, 0x07, 0xff, 0x08, 0x36, 0xda, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x5b,
-	0x01, 0x75, 0x3a, 0xea, 0x89, 0x79, 0xfc, 0xcf, 0xd4, 0xdc, 0x92, 0x0b, 0x64, 0x6e, 0x67, 0xcf,
-	0xa7, 0xfb, 0x21, 0x4e, 0xda, 0x7b, 0xa7, 0x11, 0x17, 0xdd, 0x96, 0xa2, 0x73, 0x91, 0x16, 0x2a,
-	0x8e, 0x2d, 0x35, 0x6e, 0x80, 0x6d, 0x50, 0x74, 0x31, 0xb5, 0x5f, 0xd1, 0xb8, 0xa3, 0xc8, 0x97,
-	0x05, 0x79, 0xed, 0x47, 0xc9, 0x87, 0xa9, 0x59, 0x78, 0x76, 0xfc, 0xf1, 0x47, 0x34, 0xee, 0x08,
-	0x8a, 0xab, 0xd4, 0xdc, 0x92, 0x62, 0xb3, 0x44, 0x16, 0x2a, 0xb8, 0x98, 0x8e, 0xdd, 0xe0, 0x67,
-	0xc0, 0x18, 0x3b, 0xb0, 0x5e, 0xb7, 0x4b, 0xe3, 0xa4, 0x9c, 0xe5, 0x0f, 0x5e, 0xed, 0x67, 0xc3,
-	0xd4, 0x2c, 0x2a, 0xca, 0xa6, 0x9c, 0xb9, 0x4a, 0xcd, 0xbb, 0x73, 0xa4, 0x2a, 0xc6, 0x42, 0x45,
-	0x45, 0xab, 0x5c, 0x61, 0x0b, 0x14, 0x88, 0xdf, 0x3d, 0x38, 0x7a, 0xa4, 0x16, 0xa0, 0x89, 0x05,
-	0xfc, 0x66, 0xd1, 0x02, 0xf2, 0xf5, 0xd3, 0xc6, 0xc1, 0xd1, 0xa3, 0x51, 0xfe, 0x9b, 0xea, 0xda,
-	0x98, 0x62, 0xb1, 0x50, 0x5e, 0x42, 0x99, 0xfc, 0x48, 0xe3, 0x48, 0x69, 0xac, 0xde, 0x56, 0xe3,
-	0xe8, 0x26, 0x8d, 0xa3, 0x59, 0x8d, 0xa3, 0x59, 0x8d, 0x27, 0x4a, 0x63, 0xed, 0xb6, 0x1a, 0x4f,
-	0x6e, 0xd2, 0x78, 0x32, 0xab, 0x21, 0x7d, 0x78, 0x31, 0xb5, 0x2e, 0xfe, 0x8c, 0xa3, 0xc4, 0xef,
-	0x85, 0x4a, 0x46, 0xbf, 0x75, 0x31, 0xcd, 0x45, 0x5a, 0xa8, 0x38, 0xb6, 0x48, 0xf6, 0x0e, 0x28,
-	0x39, 0x34, 0x62, 0x09, 0xb7, 0x45, 0xb4, 0x1b, 0x10, 0x25, 0x91, 0x13, 0x12, 0x4f, 0x16, 0x49,
-	0xdc, 0x97, 0x12, 0x37, 0x85, 0x5b, 0x68, 0x73, 0xd6, 0x2c, 0xc5, 0x6c, 0x60, 0x74, 0x49, 0x42,
-	0x62, 0xd6, 0xea, 0xc5, 0x9e, 0x12, 0x02, 0x42, 0xe8, 0x83, 0x45, 0x42, 0xaa, 0xac, 0xe6, 0x43,
-	0x2d, 0xb4, 0x31, 0x31, 0x49, 0x81, 0xcf, 0x41, 0xd1, 0xe7, 0xaa, 0xad, 0x5e, 0xa0, 0xe8, 0xf3,
-	0x82, 0xfe, 0x70, 0x11, 0xbd, 0x7a, 0x14, 0x66, 0x03, 0x2d, 0xb4, 0x3e, 0x32, 0x48, 0x6a, 0x17,
-	0xc0, 0xb0, 0xe7, 0xc7, 0xb6, 0x17, 0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x9f, 0x2f,
-	0xa2, 0xbf, 0x27, 0xe9, 0xaf, 0x07, 0x5b, 0xc8, 0xe0, 0xc6, 0xdf, 0x4a, 0x9b, 0x54, 0x69, 0x82,
-	0x42, 0x8b, 0xc4, 0x81, 0x1f, 0x29, 0xfe, 0x75, 0xc1, 0xff, 0x68, 0x11, 0xbf, 0xaa, 0xa0, 0xe9,
-	0x30, 0x0b, 0xe5, 0x25, 0x1c, 0x93, 0x06, 0x34, 0x72, 0xe9, 0x88, 0xf4, 0xce, 0xad, 0x49, 0xa7,
-	0xc3, 0x2c, 0x94, 0x97, 0x50, 0x92, 0x7a, 0x60, 0x13, 0xc7, 0x31, 0xfd, 0x72, 0x6e, 0x43, 0xa0,
-	0xe0, 0xfe, 0xc5, 0x22, 0xee, 0xd1, 0xe5, 0x7a, 0x3d, 0x9a, 0x5f, 0xae, 0xdc, 0x3a, 0xb3, 0x25,
-	0x2e, 0x80, 0x5e, 0x8c, 0x2f, 0xe6, 0x74, 0x4a, 0xb7, 0xde, 0xf8, 0xeb, 0xc1, 0x16, 0x32, 0xb8,
-	0x71, 0x46, 0xe5, 0x0b, 0x50, 0x0a, 0x49, 0xec, 0x11, 0x3b, 0x22, 0x09, 0xeb, 0x06, 0x7e, 0xa2,
-	0x74, 0xb6, 0x6e, 0xfd, 0x1c, 0xdc, 0x14, 0x6e, 0x21, 0x28, 0xcc, 0x2f, 0x95, 0x55, 0x6a, 0xdd,
-	0x03, 0xba, 0xc3, 0xdf, 0x16, 0xb6, 0xef, 0x96, 0xcb, 0xd5, 0xcc, 0xae, 0x86, 0xd6, 0x04, 0x3e,
-	0x75, 0x61, 0x09, 0xac, 0xc8, 0x0e, 0xeb, 0x1e, 0xd7, 0x45, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2,
-	0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x02, 0xc6, 0x18, 0x7e, 0x0a, 0xd6, 0x59, 0x1b, 0x47, 0x5e,
-	0x1b, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x5f, 0x64, 0x7c, 0xb0, 0x28, 0xe3, 0x92, 0xcc, 0x78,
-	0x26, 0xce, 0x42, 0x85, 0x11, 0x3e, 0xf7, 0x43, 0x02, 0x1b, 0x20, 0xef, 0xe0, 0xc8, 0xe9, 0x45,
-	0x92, 0xf5, 0x81, 0x60, 0xdd, 0x5f, 0xc4, 0xaa, 0x5e, 0xc5, 0x53, 0x51, 0x16, 0x02, 0x12, 0x8d,
-	0x18, 0xbb, 0x31, 0xf6, 0x7a, 0x44, 0x32, 0xbe, 0x77, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x46, 0x8c, 0x7d, 0x12, 0x77, 0x02, 0xc5, 0xb8, 0x73, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x04, 0xe3, 0x0b, 0x00, 0x28, 0xc3, 0x1d, 0x2c, 0x09, 0x4d, 0x41, 0xb8, 0xb7, 0x88, 0x50, 0xb5,
-	0xaf, 0x93, 0x20, 0x0b, 0xe5, 0x04, 0xe0, 0x74, 0x67, 0x9a, 0xbe, 0x62, 0xac, 0x9e, 0x69, 0xfa,
-	0xb6, 0x71, 0xf7, 0x4c, 0xd3, 0xef, 0x1a, 0x65, 0x6b, 0x1f, 0xac, 0xf0, 0x16, 0x8f, 0x40, 0x03,
-	0x64, 0x3b, 0xe4, 0x42, 0xf6, 0x05, 0x88, 0x0f, 0xf9, 0xd9, 0xf7, 0x71, 0xd0, 0x23, 0xf2, 0x75,
-	0x8e, 0x24, 0xb0, 0x1a, 0x60, 0xe3, 0x3c, 0xc6, 0x11, 0xe3, 0xed, 0x21, 0x8d, 0x9e, 0x53, 0x8f,
-	0x41, 0x08, 0xb4, 0x36, 0x66, 0x6d, 0x15, 0x2b, 0xc6, 0xf0, 0xa7, 0x40, 0x0b, 0xa8, 0xc7, 0x44,
-	0x63, 0x93, 0x3f, 0xdc, 0xba, 0xde, 0x45, 0x3d, 0xa7, 0x1e, 0x12, 0x2e, 0xd6, 0xdf, 0x97, 0x41,
-	0xf6, 0x39, 0xf5, 0x60, 0x19, 0xac, 0x61, 0xd7, 0x8d, 0x09, 0x63, 0x8a, 0x69, 0x04, 0xe1, 0x36,
-	0x58, 0x4d, 0x68, 0xd7, 0x77, 0x24, 0x5d, 0x0e, 0x29, 0xc4, 0x85, 0x5d, 0x9c, 0x60, 0xd1, 0x03,
-	0x14, 0x90, 0x18, 0xf3, 0x6e, 0x5b, 0x94, 0xba, 0x1d, 0xf5, 0xc2, 0x16, 0x89, 0xc5, 0xab, 0x5c,
-	0xab, 0x6d, 0x5c, 0xa6, 0x66, 0x5e, 0xd8, 0x5f, 0x0a, 0x33, 0x9a, 0x06, 0xf0, 0x7d, 0xb0, 0x96,
-	0x0c, 0x6c, 0xb1, 0x86, 0x15, 0xb1, 0xc5, 0x9b, 0x97, 0xa9, 0xb9, 0x91, 0x4c, 0x96, 0xf9, 0x3b,
-	0xcc, 0xda, 0x68, 0x35, 0x19, 0xf0, 0xff, 0xe1, 0x3e, 0xd0, 0x93, 0x81, 0xed, 0x47, 0x2e, 0x19,
-	0x88, 0x97, 0xb8, 0x56, 0x2b, 0x5d, 0xa6, 0xa6, 0x31, 0xe5, 0x7e, 0xca, 0xe7, 0xd0, 0x5a, 0x32,
-	0x10, 0x03, 0xf8, 0x3e, 0x00, 0x32, 0x25, 0xa1, 0x20, 0xdf, 0xc9, 0xeb, 0x97, 0xa9, 0x99, 0x13,
-	0x56, 0xc1, 0x3d, 0x19, 0x42, 0x0b, 0xac, 0x48, 0x6e, 0x5d, 0x70, 0x17, 0x2e, 0x53, 0x53, 0x0f,
-	0xa8, 0x27, 0x39, 0xe5, 0x14, 0xdf, 0xaa, 0x98, 0x84, 0xb4, 0x4f, 0x5c, 0xf1, 0x62, 0xd4, 0xd1,
-	0x08, 0x5a, 0x5f, 0x2f, 0x03, 0xfd, 0x7c, 0x80, 0x08, 0xeb, 0x05, 0x09, 0xfc, 0x08, 0x18, 0xa2,
-	0x57, 0xc4, 0x4e, 0x62, 0xcf, 0x6c, 0x6d, 0xed, 0xfe, 0xe4, 0x35, 0x36, 0xef, 0x61, 0xa1, 0x8d,
-	0x91, 0xe9, 0x58, 0xed, 0x7f, 0x09, 0xac, 0xb4, 0x02, 0x4a, 0x43, 0x51, 0x09, 0x05, 0x24, 0x01,
-	0xfc, 0x4c, 0xec, 0x9a, 0x38, 0xe5, 0xac, 0xe8, 0xc3, 0xff, 0xef, 0xfa, 0x29, 0xcf, 0x95, 0x4a,
-	0xed, 0x3e, 0xef, 0xc2, 0xaf, 0x52, 0xb3, 0x28, 0xb5, 0x55, 0xbc, 0xf5, 0xed, 0x0f, 0x6f, 0x1f,
-	0x66, 0xf8, 0x06, 0x8b, 0x7a, 0x32, 0x40, 0x36, 0x26, 0x89, 0x38, 0xb9, 0x02, 0xe2, 0x43, 0x7e,
-	0xe1, 0xc4, 0xa4, 0x4f, 0xe2, 0x84, 0xb8, 0xea, 0x4b, 0x6b, 0x8c, 0xf9, 0xed, 0xe5, 0x61, 0x66,
-	0xf7, 0x18, 0x71, 0xe5, 0x71, 0xa0, 0x35, 0x0f, 0xb3, 0x4f, 0x18, 0x71, 0x9f, 0x6a, 0x5f, 0x7d,
-	0x63, 0x2e, 0x59, 0x18, 0xe4, 0x55, 0x8b, 0xde, 0xeb, 0x06, 0x64, 0x41, 0x99, 0x1d, 0x82, 0x02,
-	0x4b, 0x68, 0x8c, 0x3d, 0x62, 0x77, 0xc8, 0x85, 0x2a, 0x36, 0x59, 0x3a, 0xca, 0xfe, 0x7b, 0x72,
-	0xc1, 0xd0, 0x34, 0x50, 0x12, 0xdf, 0x68, 0x20, 0x7f, 0x1e, 0x63, 0x87, 0xa8, 0x86, 0x9b, 0x17,
-	0x2c, 0x87, 0xb1, 0x92, 0x50, 0x88, 0x6b, 0xf3, 0x67, 0x92, 0xf6, 0x12, 0xf5, 0x50, 0x8d, 0x20,
-	0x8f, 0x88, 0x09, 0x19, 0x10, 0x47, 0xec, 0xa5, 0x86, 0x14, 0x82, 0x47, 0x60, 0xdd, 0xf5, 0x19,
-	0x6e, 0x05, 0xe2, 0x53, 0xcd, 0xe9, 0xc8, 0xe5, 0xd7, 0x8c, 0xcb, 0xd4, 0x2c, 0xa8, 0x89, 0x26,
-	0xb7, 0xa3, 0x19, 0x04, 0x3f, 0x04, 0x1b, 0x93, 0x30, 0x91, 0xad, 0xd8, 0x1b, 0xbd, 0x06, 0x2f,
-	0x53, 0xb3, 0x38, 0x76, 0x15, 0x33, 0x68, 0x0e, 0xcb, 0x4b, 0xbf, 0xd5, 0xf3, 0x44, 0x05, 0xea,
-	0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44, 0xc5, 0xad, 0x20, 0x09, 0xe0, 0x87, 0x20, 0x47,
-	0xfb, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0xd1, 0x3b, 0xe5, 0x0f, 0xdf, 0xbb, 0x5e, 0x06, 0x53, 0x1f,
-	0x23, 0x68, 0xe2, 0xcf, 0x17, 0x47, 0x22, 0x91, 0x64, 0x48, 0x42, 0x1a, 0x5f, 0x88, 0xee, 0x48,
-	0x2d, 0x4e, 0x4e, 0xbc, 0x10, 0x76, 0x34, 0x83, 0x60, 0x0d, 0x40, 0x15, 0x16, 0x93, 0xa4, 0x17,
-	0x47, 0xb6, 0xb8, 0x04, 0x0a, 0x22, 0x56, 0x3c, 0x8a, 0x72, 0x16, 0x89, 0xc9, 0x67, 0x38, 0xc1,
-	0xe8, 0x9a, 0x05, 0xfe, 0x1a, 0x40, 0x79, 0x26, 0xf6, 0x17, 0x8c, 0x46, 0xfc, 0x93, 0xea, 0x95,
-	0xef, 0xa9, 0xf6, 0x46, 0xe8, 0xcb, 0x59, 0x95, 0xb3, 0x21, 0xd1, 0x19, 0xa3, 0x6a, 0x15, 0x67,
-	0x9a, 0xae, 0x19, 0x2b, 0x67, 0x9a, 0xbe, 0x66, 0xe8, 0xe3, 0xfd, 0x53, 0xab, 0x40, 0x9b, 0x23,
-	0x3c, 0x95, 0x9e, 0xf5, 0x12, 0x80, 0x46, 0x4c, 0x7c, 0xde, 0x84, 0x06, 0x01, 0xbf, 0xb9, 0x22,
-	0x1c, 0x92, 0xd1, 0x95, 0xc9, 0xc7, 0xd3, 0x85, 0xb9, 0x3c, 0x5b, 0x98, 0x10, 0x68, 0x0e, 0x75,
-	0x89, 0x28, 0x8d, 0x1c, 0x12, 0xe3, 0x87, 0x7f, 0xcb, 0x80, 0xa9, 0x2f, 0x4f, 0xf8, 0x4b, 0x50,
-	0x39, 0x3e, 0x39, 0xa9, 0x37, 0x9b, 0xf6, 0xf9, 0xe7, 0x8d, 0xba, 0xdd, 0xa8, 0xa3, 0x17, 0xa7,
-	0xcd, 0xe6, 0xe9, 0xc7, 0x2f, 0x9f, 0xd7, 0x9b, 0x4d, 0x63, 0xa9, 0xf2, 0xe0, 0xf5, 0x9b, 0x6a,
-	0x79, 0xe2, 0xdf, 0x20, 0x71, 0xe8, 0x33, 0xe6, 0xd3, 0x28, 0xe0, 0x02, 0x1f, 0x80, 0xed, 0xe9,
-	0x68, 0x54, 0x6f, 0x9e, 0xa3, 0xd3, 0x93, 0xf3, 0xfa, 0x33, 0x23, 0x53, 0x29, 0xbf, 0x7e, 0x53,
-	0x2d, 0x4d, 0x22, 0x11, 0x61, 0x49, 0xec, 0x3b, 0xfc, 0xc9, 0x7b, 0x02, 0xca, 0x37, 0x6b, 0xd6,
-	0x9f, 0x19, 0xcb, 0x95, 0xca, 0xeb, 0x37, 0xd5, 0xed, 0x9b, 0x14, 0x89, 0x5b, 0xd1, 0xbe, 0xfa,
-	0xeb, 0xce, 0x52, 0xed, 0xe9, 0x77, 0xc3, 0x9d, 0xcc, 0xf7, 0xc3, 0x9d, 0xcc, 0xbf, 0x87, 0x3b,
-	0x99, 0xaf, 0xdf, 0xed, 0x2c, 0x7d, 0xff, 0x6e, 0x67, 0xe9, 0x1f, 0xef, 0x76, 0x96, 0xfe, 0x50,
-	0xf5, 0xfc, 0xa4, 0xdd, 0x6b, 0xed, 0x39, 0x34, 0xdc, 0x9f, 0xff, 0xa5, 0x81, 0x7f, 0x53, 0xb3,
-	0xd6, 0xaa, 0xf8, 0x41, 0xe8, 0xf1, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x92, 0xb6, 0x78, 0x1a,
-	0x69, 0x12, 0x00, 0x00,
+	// 2168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0x16, 0xc5, 0x95, 0xb8, 0x1c, 0x52, 0xd2, 0x6a, 0x44, 0xc9, 0x34, 0x6d, 0x6b, 0xd5, 0x4d,
+	0x2f, 0x14, 0x37, 0x95, 0x2c, 0x39, 0x6a, 0x0d, 0xa7, 0x3f, 0x10, 0x25, 0xa6, 0x95, 0x6a, 0x2b,
+	0xc4, 0x50, 0x4e, 0x90, 0xa2, 0xc5, 0x62, 0xb8, 0x3b, 0x5e, 0x6e, 0xb4, 0xbb, 0x43, 0xec, 0x2c,
+	0x19, 0x2a, 0x4f, 0x10, 0xf8, 0x2a, 0x7d, 0x00, 0x03, 0x01, 0x7a, 0x93, 0xde, 0xe5, 0x11, 0x7a,
+	0x55, 0x04, 0xbd, 0xca, 0x65, 0x51, 0xa0, 0x8b, 0x42, 0xbe, 0x08, 0xa0, 0xab, 0x42, 0x4f, 0x50,
+	0xcc, 0x0f, 0x7f, 0x25, 0xb3, 0x2a, 0x20, 0xd8, 0xf3, 0x9d, 0x39, 0xe7, 0xfb, 0xce, 0xcc, 0x9c,
+	0x9d, 0x3d, 0x4b, 0x50, 0x71, 0x28, 0x0b, 0x29, 0xdb, 0x26, 0xdd, 0x70, 0x9b, 0xff, 0xed, 0xf0,
+	0xd1, 0x56, 0x3b, 0xa6, 0x09, 0x85, 0x86, 0x9c, 0xdb, 0xe2, 0x16, 0xfe, 0xb7, 0x53, 0x59, 0xc6,
+	0xa1, 0x1f, 0xd1, 0x6d, 0xf1, 0xaf, 0x74, 0xaa, 0x94, 0x3c, 0xea, 0x51, 0x31, 0xdc, 0xe6, 0x23,
+	0x69, 0xb5, 0xfe, 0x93, 0x05, 0xf3, 0x75, 0x1c, 0xe3, 0x90, 0xc1, 0x1d, 0x90, 0x27, 0xdd, 0xd0,
+	0x76, 0x49, 0x44, 0xc3, 0x72, 0x66, 0x23, 0xb3, 0x99, 0xaf, 0x96, 0xae, 0x52, 0xd3, 0x38, 0xc7,
+	0x61, 0xf0, 0xd4, 0x1a, 0x4c, 0x59, 0x48, 0x27, 0xdd, 0xf0, 0x90, 0x0f, 0xe1, 0x3e, 0x00, 0xa4,
+	0x97, 0xc4, 0xd8, 0x26, 0x7e, 0x9b, 0x95, 0xb5, 0x8d, 0xec, 0x66, 0xb6, 0x6a, 0x5d, 0xa4, 0x66,
+	0xbe, 0xc6, 0xad, 0xb5, 0xa3, 0x3a, 0xbb, 0x4a, 0xcd, 0x65, 0x45, 0x30, 0x70, 0xb4, 0x50, 0x5e,
+	0x80, 0x9a, 0xdf, 0x66, 0x70, 0x17, 0xac, 0xe2, 0x20, 0xa0, 0x9f, 0xdb, 0x9d, 0x88, 0x67, 0x44,
+	0x9c, 0x84, 0xb8, 0x76, 0xd2, 0x63, 0xe5, 0xb9, 0x8d, 0xcc, 0xa6, 0x8e, 0x56, 0xc4, 0xe4, 0x8b,
+	0xe1, 0xdc, 0x69, 0x8f, 0xc7, 0x14, 0x79, 0x3a, 0x4e, 0x0b, 0x47, 0x11, 0x09, 0x58, 0x39, 0xb7,
+	0x91, 0xdd, 0xcc, 0x57, 0x97, 0x2e, 0x52, 0xb3, 0x50, 0xfb, 0xf8, 0xf9, 0x81, 0x32, 0xa3, 0x02,
+	0xe9, 0x86, 0x7d, 0x00, 0xff, 0x08, 0x16, 0xb1, 0xe3, 0x10, 0xc6, 0x6c, 0x87, 0x46, 0x49, 0x4c,
+	0x83, 0xb2, 0xbe, 0x91, 0xd9, 0x2c, 0xec, 0x9a, 0x5b, 0x93, 0x9b, 0xb7, 0xb5, 0x2f, 0xfc, 0x0e,
+	0xa4, 0x5b, 0x75, 0xf5, 0xbb, 0xd4, 0x9c, 0xb9, 0x48, 0xcd, 0x85, 0x31, 0x33, 0x5a, 0xc0, 0xa3,
+	0x10, 0x3e, 0x05, 0x77, 0xb1, 0x93, 0xf8, 0x5d, 0x62, 0xb3, 0x04, 0x27, 0xbe, 0x63, 0xb7, 0x63,
+	0xe2, 0xd0, 0xb0, 0xed, 0x07, 0x84, 0x95, 0xf3, 0x3c, 0x3f, 0x74, 0x47, 0x3a, 0x34, 0xc4, 0x7c,
+	0x7d, 0x38, 0x0d, 0xef, 0x02, 0xdd, 0x25, 0x5d, 0x3b, 0xa4, 0x2e, 0x29, 0x03, 0xb1, 0xea, 0x9c,
+	0x4b, 0xba, 0xcf, 0xa9, 0x4b, 0xe0, 0x3b, 0x60, 0x81, 0x4f, 0xe1, 0x4e, 0xd2, 0xa2, 0xb1, 0x9f,
+	0x9c, 0x97, 0x0b, 0xfc, 0x5c, 0x50, 0xd1, 0x25, 0xdd, 0xfd, 0xbe, 0xed, 0xe9, 0xbd, 0x57, 0x3f,
+	0x7c, 0xfb, 0x70, 0x6d, 0xa4, 0x3e, 0x7a, 0xbc, 0x42, 0xe4, 0xa9, 0x1e, 0x6b, 0xfa, 0xac, 0x91,
+	0x3d, 0xd6, 0xf4, 0xac, 0xa1, 0x1d, 0x6b, 0xfa, 0xbc, 0x91, 0xb3, 0xfe, 0x94, 0x01, 0xe3, 0x6b,
+	0x81, 0xfb, 0x60, 0xde, 0x89, 0x09, 0x4e, 0x88, 0x38, 0xf6, 0xc2, 0xee, 0x3b, 0xff, 0x63, 0x4f,
+	0x4e, 0xcf, 0xdb, 0xa4, 0xaa, 0xf1, 0x7d, 0x41, 0x2a, 0x10, 0xfe, 0x12, 0x68, 0x0e, 0x0e, 0x82,
+	0xf2, 0xec, 0xff, 0x4b, 0x20, 0xc2, 0xac, 0x7f, 0x65, 0xc0, 0xf2, 0x35, 0x0f, 0xe8, 0x80, 0x82,
+	0x3a, 0xb3, 0xe4, 0xbc, 0x2d, 0x93, 0x5b, 0xdc, 0xbd, 0xff, 0x36, 0x6e, 0x41, 0xfa, 0xe3, 0x8b,
+	0xd4, 0x04, 0x43, 0x7c, 0x95, 0x9a, 0x50, 0x96, 0xdf, 0x08, 0x91, 0x85, 0x00, 0x1e, 0x78, 0x40,
+	0x07, 0xac, 0x8c, 0x17, 0x86, 0x1d, 0xf8, 0x2c, 0x29, 0xcf, 0x8a, 0x9a, 0x7a, 0x7c, 0x91, 0x9a,
+	0xe3, 0x89, 0x3d, 0xf3, 0x59, 0x72, 0x95, 0x9a, 0x95, 0x31, 0xd6, 0xd1, 0x48, 0x0b, 0x2d, 0xe3,
+	0xc9, 0x00, 0xeb, 0x1b, 0x03, 0x14, 0x0e, 0x5a, 0xd8, 0x8f, 0x0e, 0x68, 0xf4, 0xd2, 0xf7, 0xe0,
+	0x1f, 0xc0, 0x52, 0x8b, 0x86, 0x84, 0x25, 0x04, 0xbb, 0x76, 0x33, 0xa0, 0xce, 0x99, 0x7a, 0xe2,
+	0x1e, 0xff, 0x33, 0x35, 0x57, 0xe5, 0x02, 0x99, 0x7b, 0xb6, 0xe5, 0xd3, 0xed, 0x10, 0x27, 0xad,
+	0xad, 0xa3, 0x88, 0x8b, 0xae, 0x49, 0xd1, 0x89, 0x48, 0x0b, 0x2d, 0x0e, 0x2c, 0x55, 0x6e, 0x80,
+	0x2d, 0xb0, 0xe8, 0x62, 0x6a, 0xbf, 0xa4, 0xf1, 0x99, 0x22, 0x9f, 0x15, 0xe4, 0xd5, 0xb7, 0x92,
+	0x5f, 0xa4, 0x66, 0xf1, 0x70, 0xff, 0xa3, 0x0f, 0x69, 0x7c, 0x26, 0x28, 0xae, 0x52, 0x73, 0x55,
+	0x8a, 0x8d, 0x13, 0x59, 0xa8, 0xe8, 0x62, 0x3a, 0x70, 0x83, 0x9f, 0x00, 0x63, 0xe0, 0xc0, 0x3a,
+	0xed, 0x36, 0x8d, 0x93, 0x72, 0x96, 0x97, 0x70, 0xf5, 0xa7, 0x17, 0xa9, 0xb9, 0xa8, 0x28, 0x1b,
+	0x72, 0xe6, 0x2a, 0x35, 0xef, 0x4c, 0x90, 0xaa, 0x18, 0x0b, 0x2d, 0x2a, 0x5a, 0xe5, 0x0a, 0x9b,
+	0xa0, 0x48, 0xfc, 0xf6, 0xce, 0xde, 0x23, 0xb5, 0x00, 0x4d, 0x2c, 0xe0, 0xd7, 0xd3, 0x16, 0x50,
+	0xa8, 0x1d, 0xd5, 0x77, 0xf6, 0x1e, 0xf5, 0xf3, 0x5f, 0x91, 0x52, 0xa3, 0x2c, 0x16, 0x2a, 0x48,
+	0x28, 0x93, 0xef, 0x6b, 0xec, 0x29, 0x8d, 0xf9, 0xdb, 0x6a, 0xec, 0xdd, 0xa4, 0xb1, 0x37, 0xae,
+	0xb1, 0x37, 0xae, 0xf1, 0x44, 0x69, 0xe4, 0x6e, 0xab, 0xf1, 0xe4, 0x26, 0x8d, 0x27, 0xe3, 0x1a,
+	0xd2, 0x87, 0x17, 0x53, 0xf3, 0xfc, 0x0b, 0x1c, 0x25, 0x7e, 0x27, 0x54, 0x32, 0xfa, 0xad, 0x8b,
+	0x69, 0x22, 0xd2, 0x42, 0x8b, 0x03, 0x8b, 0x64, 0x3f, 0x03, 0x25, 0x87, 0x46, 0x2c, 0xe1, 0xb6,
+	0x88, 0xb6, 0x03, 0xa2, 0x24, 0xf2, 0x42, 0xe2, 0xc9, 0x34, 0x89, 0x7b, 0x52, 0xe2, 0xa6, 0x70,
+	0x0b, 0xad, 0x8c, 0x9b, 0xa5, 0x98, 0x0d, 0x8c, 0x36, 0x49, 0x48, 0xcc, 0x9a, 0x9d, 0xd8, 0x53,
+	0x42, 0x40, 0x08, 0xbd, 0x3f, 0x4d, 0x48, 0x95, 0xd5, 0x64, 0xa8, 0x85, 0x96, 0x86, 0x26, 0x29,
+	0xf0, 0x29, 0x58, 0xf4, 0xb9, 0x6a, 0xb3, 0x13, 0x28, 0x7a, 0x71, 0xa3, 0x56, 0x77, 0xa7, 0xd1,
+	0xab, 0x47, 0x61, 0x3c, 0xd0, 0x42, 0x0b, 0x7d, 0x83, 0xa4, 0x76, 0x01, 0x0c, 0x3b, 0x7e, 0x6c,
+	0x7b, 0x01, 0x76, 0x7c, 0x12, 0x2b, 0xfa, 0xa2, 0xa0, 0xff, 0xd9, 0x34, 0xfa, 0xbb, 0x92, 0xfe,
+	0x7a, 0xb0, 0x85, 0x0c, 0x6e, 0xfc, 0x8d, 0xb4, 0x49, 0x95, 0x06, 0x28, 0x36, 0x49, 0x1c, 0xf8,
+	0x91, 0xe2, 0x5f, 0x10, 0xfc, 0x8f, 0xa6, 0xf1, 0xab, 0x0a, 0x1a, 0x0d, 0xb3, 0x50, 0x41, 0xc2,
+	0x01, 0x69, 0x40, 0x23, 0x97, 0xf6, 0x49, 0x97, 0x6f, 0x4d, 0x3a, 0x1a, 0x66, 0xa1, 0x82, 0x84,
+	0x92, 0xd4, 0x03, 0x2b, 0x38, 0x8e, 0xe9, 0xe7, 0x13, 0x1b, 0x02, 0x05, 0xf7, 0xcf, 0xa7, 0x71,
+	0xf7, 0x2f, 0xd7, 0xeb, 0xd1, 0xfc, 0x72, 0xe5, 0xd6, 0xb1, 0x2d, 0x71, 0x01, 0xf4, 0x62, 0x7c,
+	0x3e, 0xa1, 0x53, 0xba, 0xf5, 0xc6, 0x5f, 0x0f, 0xb6, 0x90, 0xc1, 0x8d, 0x63, 0x2a, 0x9f, 0x81,
+	0x52, 0x48, 0x62, 0x8f, 0xd8, 0x11, 0x49, 0x58, 0x3b, 0xf0, 0x13, 0xa5, 0xb3, 0x7a, 0xeb, 0xe7,
+	0xe0, 0xa6, 0x70, 0x0b, 0x41, 0x61, 0x3e, 0x51, 0x56, 0xa9, 0x75, 0x17, 0xe8, 0x0e, 0x7f, 0x5b,
+	0xd8, 0xbe, 0x5b, 0x2e, 0x6f, 0x64, 0x36, 0x35, 0x94, 0x13, 0xf8, 0xc8, 0x85, 0x25, 0x30, 0x27,
+	0x3b, 0xb4, 0xbb, 0xa2, 0x13, 0x90, 0x00, 0x56, 0x78, 0x0b, 0xe1, 0xf8, 0x21, 0x0e, 0x58, 0xb9,
+	0x22, 0x02, 0x06, 0x18, 0x7e, 0x0c, 0x16, 0x58, 0x0b, 0x47, 0x5e, 0x0b, 0xfb, 0x76, 0xe2, 0x87,
+	0xa4, 0x7c, 0x4f, 0x64, 0xbc, 0x33, 0x2d, 0xe3, 0x92, 0xcc, 0x78, 0x2c, 0xce, 0x42, 0xc5, 0x3e,
+	0x3e, 0xf5, 0x43, 0x02, 0xeb, 0xa0, 0xe0, 0xe0, 0xc8, 0xe9, 0x44, 0x92, 0xf5, 0xbe, 0x60, 0xdd,
+	0x9e, 0xc6, 0xaa, 0x5e, 0xc5, 0x23, 0x51, 0x16, 0x02, 0x12, 0xf5, 0x19, 0xdb, 0x31, 0xf6, 0x3a,
+	0x44, 0x32, 0x3e, 0xb8, 0x35, 0xe3, 0x48, 0x94, 0x85, 0x80, 0x44, 0x7d, 0xc6, 0x2e, 0x89, 0xcf,
+	0x02, 0xc5, 0xb8, 0x7e, 0x6b, 0xc6, 0x91, 0x28, 0x0b, 0x01, 0x89, 0x04, 0xe3, 0x73, 0x00, 0x28,
+	0xc3, 0x67, 0x58, 0x12, 0x9a, 0x82, 0x70, 0x6b, 0x1a, 0xa1, 0x6a, 0x7f, 0x87, 0x41, 0x16, 0xca,
+	0x0b, 0xc0, 0xe9, 0x8e, 0x35, 0x7d, 0xce, 0x98, 0x3f, 0xd6, 0xf4, 0x35, 0xe3, 0xce, 0xb1, 0xa6,
+	0xdf, 0x31, 0xca, 0xd6, 0x36, 0x98, 0xe3, 0x2d, 0x22, 0x81, 0x06, 0xc8, 0x9e, 0x91, 0x73, 0xd9,
+	0x17, 0x20, 0x3e, 0xe4, 0x67, 0xdf, 0xc5, 0x41, 0x87, 0xc8, 0xd7, 0x39, 0x92, 0xc0, 0xaa, 0x83,
+	0xa5, 0xd3, 0x18, 0x47, 0x8c, 0xb7, 0x97, 0x34, 0x7a, 0x46, 0x3d, 0x06, 0x21, 0xd0, 0x5a, 0x98,
+	0xb5, 0x54, 0xac, 0x18, 0xc3, 0x77, 0x81, 0x16, 0x50, 0x8f, 0x89, 0xc6, 0xa6, 0xb0, 0xbb, 0x7a,
+	0xbd, 0x8b, 0x7a, 0x46, 0x3d, 0x24, 0x5c, 0xac, 0xbf, 0xcf, 0x82, 0xec, 0x33, 0xea, 0xc1, 0x32,
+	0xc8, 0x61, 0xd7, 0x8d, 0x09, 0x63, 0x8a, 0xa9, 0x0f, 0xe1, 0x1a, 0x98, 0x4f, 0x68, 0xdb, 0x77,
+	0x24, 0x5d, 0x1e, 0x29, 0xc4, 0x85, 0x5d, 0x9c, 0x60, 0xd1, 0x03, 0x14, 0x91, 0x18, 0xf3, 0x6e,
+	0x5d, 0x94, 0xba, 0x1d, 0x75, 0xc2, 0x26, 0x89, 0xc5, 0xab, 0x5c, 0xab, 0x2e, 0x5d, 0xa6, 0x66,
+	0x41, 0xd8, 0x4f, 0x84, 0x19, 0x8d, 0x02, 0xf8, 0x1e, 0xc8, 0x25, 0x3d, 0x5b, 0xac, 0x61, 0x4e,
+	0x6c, 0xf1, 0xca, 0x65, 0x6a, 0x2e, 0x25, 0xc3, 0x65, 0xfe, 0x16, 0xb3, 0x16, 0x9a, 0x4f, 0x7a,
+	0xfc, 0x7f, 0xb8, 0x0d, 0xf4, 0xa4, 0x67, 0xfb, 0x91, 0x4b, 0x7a, 0xe2, 0x25, 0xae, 0x55, 0x4b,
+	0x97, 0xa9, 0x69, 0x8c, 0xb8, 0x1f, 0xf1, 0x39, 0x94, 0x4b, 0x7a, 0x62, 0x00, 0xdf, 0x03, 0x40,
+	0xa6, 0x24, 0x14, 0xe4, 0x3b, 0x79, 0xe1, 0x32, 0x35, 0xf3, 0xc2, 0x2a, 0xb8, 0x87, 0x43, 0x68,
+	0x81, 0x39, 0xc9, 0xad, 0x0b, 0xee, 0xe2, 0x65, 0x6a, 0xea, 0x01, 0xf5, 0x24, 0xa7, 0x9c, 0xe2,
+	0x5b, 0x15, 0x93, 0x90, 0x76, 0x89, 0x2b, 0x5e, 0x8c, 0x3a, 0xea, 0x43, 0xeb, 0xab, 0x59, 0xa0,
+	0x9f, 0xf6, 0x10, 0x61, 0x9d, 0x20, 0x81, 0x1f, 0x02, 0x43, 0xf4, 0x8a, 0xd8, 0x49, 0xec, 0xb1,
+	0xad, 0xad, 0xde, 0x1b, 0xbe, 0xc6, 0x26, 0x3d, 0x2c, 0xb4, 0xd4, 0x37, 0xed, 0xab, 0xfd, 0x2f,
+	0x81, 0xb9, 0x66, 0x40, 0x69, 0x28, 0x2a, 0xa1, 0x88, 0x24, 0x80, 0x9f, 0x88, 0x5d, 0x13, 0xa7,
+	0x9c, 0x15, 0x7d, 0xf8, 0x8f, 0xae, 0x9f, 0xf2, 0x44, 0xa9, 0x54, 0xef, 0xf1, 0x2e, 0xfc, 0x2a,
+	0x35, 0x17, 0xa5, 0xb6, 0x8a, 0xb7, 0xbe, 0xf9, 0xe1, 0xdb, 0x87, 0x19, 0xbe, 0xc1, 0xa2, 0x9e,
+	0x0c, 0x90, 0x8d, 0x49, 0x22, 0x4e, 0xae, 0x88, 0xf8, 0x90, 0x5f, 0x38, 0x31, 0xe9, 0x92, 0x38,
+	0x21, 0xae, 0xfa, 0x52, 0x1b, 0x60, 0x7e, 0x7b, 0x79, 0x98, 0xd9, 0x1d, 0x46, 0x5c, 0x79, 0x1c,
+	0x28, 0xe7, 0x61, 0xf6, 0x82, 0x11, 0xf7, 0xa9, 0xf6, 0xe5, 0xd7, 0xe6, 0x8c, 0x85, 0x41, 0x41,
+	0xb5, 0xe8, 0x9d, 0x76, 0x40, 0xa6, 0x94, 0xd9, 0x2e, 0x28, 0xb2, 0x84, 0xc6, 0xd8, 0x23, 0xf6,
+	0x19, 0x39, 0x57, 0xc5, 0x26, 0x4b, 0x47, 0xd9, 0x7f, 0x47, 0xce, 0x19, 0x1a, 0x05, 0x4a, 0xe2,
+	0x6f, 0x19, 0x50, 0x6a, 0x90, 0xe4, 0x80, 0xba, 0x44, 0x7d, 0x28, 0x7d, 0x81, 0xf9, 0x9a, 0xe1,
+	0xc9, 0xc8, 0xd5, 0xaa, 0x5a, 0x6e, 0xbe, 0x03, 0xd3, 0x1a, 0xb2, 0x9c, 0xe8, 0xdc, 0x8f, 0x0e,
+	0x2f, 0x53, 0x53, 0x5d, 0xc3, 0x87, 0xc3, 0xfb, 0x78, 0x24, 0xf9, 0xd9, 0xf1, 0xe4, 0x4b, 0x60,
+	0x2e, 0xa2, 0x91, 0x43, 0xc4, 0x59, 0x68, 0x48, 0x02, 0x58, 0x04, 0x99, 0xae, 0xd8, 0xc8, 0x05,
+	0x94, 0xe9, 0x72, 0x14, 0x8b, 0xfd, 0x2b, 0xa2, 0x4c, 0xcc, 0x11, 0x13, 0x3b, 0x56, 0x44, 0x99,
+	0xfe, 0x42, 0xbe, 0xd6, 0x40, 0xe1, 0x34, 0xc6, 0x0e, 0x51, 0x5f, 0x0e, 0xfc, 0xc9, 0xe3, 0x30,
+	0x56, 0x7b, 0xa5, 0x10, 0xcf, 0x83, 0x5f, 0x2e, 0xb4, 0x93, 0xf4, 0xf3, 0x50, 0x90, 0x47, 0xc4,
+	0x84, 0xf4, 0x88, 0xa3, 0x12, 0x51, 0x08, 0xee, 0x81, 0x05, 0xd7, 0x67, 0xb8, 0x19, 0x88, 0x6f,
+	0x56, 0xe7, 0x4c, 0x9e, 0x63, 0xd5, 0xb8, 0x4c, 0xcd, 0xa2, 0x9a, 0x68, 0x70, 0x3b, 0x1a, 0x43,
+	0xf0, 0x03, 0xb0, 0x34, 0x0c, 0x13, 0xdb, 0x2e, 0x52, 0xd6, 0xab, 0xf0, 0x32, 0x35, 0x17, 0x07,
+	0xae, 0x62, 0x06, 0x4d, 0x60, 0xf9, 0xf6, 0x6a, 0x76, 0x3c, 0xf1, 0x28, 0xe9, 0x48, 0x02, 0x6e,
+	0x0d, 0xfc, 0xd0, 0x4f, 0xc4, 0xa3, 0x33, 0x87, 0x24, 0x80, 0x1f, 0x80, 0x3c, 0xed, 0x92, 0x38,
+	0xf6, 0x5d, 0xc2, 0x44, 0x13, 0x58, 0xd8, 0x7d, 0x70, 0xbd, 0x9e, 0x47, 0xbe, 0xaa, 0xd0, 0xd0,
+	0x9f, 0x2f, 0x8e, 0x44, 0x22, 0xc9, 0x90, 0x84, 0x34, 0x96, 0x1f, 0xce, 0x6a, 0x71, 0x72, 0xe2,
+	0xb9, 0xb0, 0xa3, 0x31, 0x04, 0xab, 0x00, 0xaa, 0xb0, 0x98, 0x24, 0x9d, 0x38, 0xb2, 0xc5, 0x6d,
+	0x56, 0x14, 0xb1, 0xe2, 0x4e, 0x91, 0xb3, 0x48, 0x4c, 0x1e, 0xe2, 0x04, 0xa3, 0x6b, 0x16, 0xf8,
+	0x2b, 0x00, 0xe5, 0x99, 0xd8, 0x9f, 0x31, 0x1a, 0xf1, 0x6f, 0xc3, 0x97, 0xbe, 0xa7, 0xfa, 0x34,
+	0xa1, 0x2f, 0x67, 0x55, 0xce, 0x86, 0x44, 0xc7, 0x8c, 0xaa, 0x55, 0x1c, 0x6b, 0xba, 0x66, 0xcc,
+	0x1d, 0x6b, 0x7a, 0xce, 0xd0, 0x07, 0xfb, 0xa7, 0x56, 0x81, 0x56, 0xfa, 0x78, 0x24, 0x3d, 0xeb,
+	0x04, 0x80, 0x7a, 0x4c, 0x7c, 0xde, 0x4d, 0x07, 0x01, 0xbf, 0x82, 0x23, 0x1c, 0x92, 0xfe, 0xdd,
+	0xcf, 0xc7, 0x53, 0x8a, 0x14, 0x02, 0xcd, 0xe1, 0xbf, 0x3b, 0x64, 0xa5, 0x37, 0x1f, 0x5b, 0x7f,
+	0xc9, 0x80, 0x72, 0x9d, 0x44, 0xae, 0x1f, 0x79, 0x2f, 0xa2, 0x26, 0x15, 0x83, 0x03, 0x1c, 0x04,
+	0x4d, 0x7e, 0xfc, 0xef, 0xbe, 0xed, 0x06, 0xbb, 0x7e, 0x49, 0xfd, 0x04, 0x2c, 0x77, 0x71, 0xe0,
+	0xbb, 0x38, 0xa1, 0xb1, 0x3d, 0xae, 0x6f, 0x0c, 0x26, 0xfa, 0xce, 0x7b, 0x60, 0x1e, 0x87, 0xb4,
+	0x13, 0xc9, 0xef, 0xc7, 0x7c, 0xf5, 0xc1, 0xd4, 0xa7, 0x12, 0x29, 0xe7, 0x87, 0x7f, 0xcd, 0x80,
+	0x91, 0xcf, 0x7d, 0xf8, 0x0b, 0x50, 0xd9, 0x3f, 0x38, 0xa8, 0x35, 0x1a, 0xf6, 0xe9, 0xa7, 0xf5,
+	0x9a, 0x5d, 0xaf, 0xa1, 0xe7, 0x47, 0x8d, 0xc6, 0xd1, 0x47, 0x27, 0xcf, 0x6a, 0x8d, 0x86, 0x31,
+	0x53, 0xb9, 0xff, 0xea, 0xf5, 0x46, 0x79, 0xe8, 0x5f, 0x27, 0x71, 0xe8, 0x33, 0xe6, 0xd3, 0x28,
+	0xe0, 0x39, 0xbc, 0x0f, 0xd6, 0x46, 0xa3, 0x51, 0xad, 0x71, 0x8a, 0x8e, 0x0e, 0x4e, 0x6b, 0x87,
+	0x46, 0xa6, 0x52, 0x7e, 0xf5, 0x7a, 0xa3, 0x34, 0x8c, 0x44, 0x84, 0x25, 0xb1, 0xcf, 0x7f, 0x90,
+	0x82, 0x4f, 0x40, 0xf9, 0x66, 0xcd, 0xda, 0xa1, 0x31, 0x5b, 0xa9, 0xbc, 0x7a, 0xbd, 0xb1, 0x76,
+	0x93, 0x22, 0x71, 0x2b, 0xda, 0x97, 0x7f, 0x5e, 0x9f, 0xa9, 0x3e, 0xfd, 0xee, 0x62, 0x3d, 0xf3,
+	0xfd, 0xc5, 0x7a, 0xe6, 0xdf, 0x17, 0xeb, 0x99, 0xaf, 0xde, 0xac, 0xcf, 0x7c, 0xff, 0x66, 0x7d,
+	0xe6, 0x1f, 0x6f, 0xd6, 0x67, 0x7e, 0xbf, 0xe1, 0xf9, 0x49, 0xab, 0xd3, 0xdc, 0x72, 0x68, 0xb8,
+	0x3d, 0xf9, 0xf3, 0x0e, 0xff, 0x21, 0x83, 0x35, 0xe7, 0xc5, 0xaf, 0x78, 0x8f, 0xff, 0x3b, 0x00,
+	0x93, 0xe7, 0xa4, 0xb4, 0x1e, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1090,6 +1234,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DevAuthority) > 0 {
+		i -= len(m.DevAuthority)
+		copy(dAtA[i:], m.DevAuthority)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.DevAuthority)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.DevMode {
+		i--
+		if m.DevMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1825,6 +1986,70 @@ func (m *AccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetCodeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCodeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCodeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.V != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.V))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ChainID.Size()
+		i -= size
+		if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1985,6 +2210,53 @@ func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingUnbondingCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingUnbondingCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingUnbondingCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -2030,6 +2302,13 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if m.DevMode {
+		n += 2
+	}
+	l = len(m.DevAuthority)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -2293,6 +2572,35 @@ func (m *AccessTuple) Size() (n int) {
 	return n
 }
 
+func (m *SetCodeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainID.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvm(uint64(m.Nonce))
+	}
+	if m.V != 0 {
+		n += 1 + sovEvm(uint64(m.V))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
 func (m *TraceConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2360,6 +2668,25 @@ func (m *Preinstall) Size() (n int) {
 	return n
 }
 
+func (m *PendingUnbondingCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2620,6 +2947,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DevMode = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -4573,7 +4952,7 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TraceConfig) Unmarshal(dAtA []byte) error {
+func (m *SetCodeAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4596,15 +4975,15 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCodeAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCodeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4632,8 +5011,230 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tracer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			m.V = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.V |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
@@ -5039,6 +5640,154 @@ func (m *Preinstall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingUnbondingCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingUnbondingCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingUnbondingCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
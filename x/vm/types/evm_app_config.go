@@ -0,0 +1,140 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EVMAppConfig holds the EVM coin and chain configuration of an app. It is owned
+// by the x/vm keeper of the app, unlike the process globals set by the
+// EVMConfigurator, so that several apps with different configurations can run in
+// one process.
+type EVMAppConfig struct {
+	CoinInfo    EvmCoinInfo
+	ChainConfig *ChainConfig
+}
+
+// NewEVMAppConfig validates and returns the configuration of an app. A nil chain
+// config defaults to DefaultChainConfig. The denom and decimals of the chain
+// config are set to the ones of the EVM coin.
+func NewEVMAppConfig(chainConfig *ChainConfig, coinInfo EvmCoinInfo) (*EVMAppConfig, error) {
+	if err := coinInfo.Validate(); err != nil {
+		return nil, err
+	}
+
+	cc := *DefaultChainConfig(0)
+	if chainConfig != nil {
+		cc = *chainConfig
+	}
+	cc.Denom = coinInfo.Denom
+	cc.Decimals = uint64(coinInfo.Decimals)
+	if err := cc.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &EVMAppConfig{CoinInfo: coinInfo, ChainConfig: &cc}, nil
+}
+
+// GlobalEVMAppConfig returns the configuration set on startup through the
+// EVMConfigurator. It is used by the callers that aren't given the
+// configuration of their app.
+func GlobalEVMAppConfig() *EVMAppConfig {
+	cc := GetChainConfig()
+	if cc == nil {
+		cc = DefaultChainConfig(0)
+	}
+	chainConfig := *cc
+	coinInfo := GetEVMCoinInfo()
+	chainConfig.Denom = coinInfo.Denom
+	chainConfig.Decimals = uint64(coinInfo.Decimals)
+
+	return &EVMAppConfig{CoinInfo: coinInfo, ChainConfig: &chainConfig}
+}
+
+// EthChainConfig returns the chain config used in the EVM (geth type).
+func (c EVMAppConfig) EthChainConfig() *gethparams.ChainConfig {
+	return c.ChainConfig.EthereumConfig(nil)
+}
+
+// GetEVMCoinInfo returns the EVM coin info set on startup through the
+// EVMConfigurator.
+func GetEVMCoinInfo() EvmCoinInfo {
+	return EvmCoinInfo{
+		Denom:         GetEVMCoinDenom(),
+		ExtendedDenom: GetEVMCoinExtendedDenom(),
+		Decimals:      GetEVMCoinDecimals(),
+	}
+}
+
+// Validate returns an error if the denoms or the decimals of the EVM coin are
+// invalid.
+func (eci EvmCoinInfo) Validate() error {
+	if err := sdk.ValidateDenom(eci.Denom); err != nil {
+		return fmt.Errorf("invalid EVM coin denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(eci.ExtendedDenom); err != nil {
+		return fmt.Errorf("invalid EVM coin extended denom: %w", err)
+	}
+	if err := eci.Decimals.Validate(); err != nil {
+		return fmt.Errorf("invalid EVM coin decimals: %w", err)
+	}
+	if eci.Decimals == EighteenDecimals && eci.Denom != eci.ExtendedDenom {
+		return errors.New("EVM coin denom and extended denom must be the same for 18 decimals")
+	}
+	return nil
+}
+
+// ConvertAmountTo18DecimalsLegacy converts the given amount into a 18 decimals
+// representation.
+func (eci EvmCoinInfo) ConvertAmountTo18DecimalsLegacy(amt sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return amt.MulInt(eci.Decimals.ConversionFactor())
+}
+
+// ConvertAmountTo18DecimalsBigInt converts the given amount into a 18 decimals
+// representation.
+func (eci EvmCoinInfo) ConvertAmountTo18DecimalsBigInt(amt *big.Int) *big.Int {
+	return new(big.Int).Mul(amt, eci.Decimals.ConversionFactor().BigInt())
+}
+
+// ConvertAmountTo18Decimals256Int converts the given amount into a 18 decimals
+// representation.
+func (eci EvmCoinInfo) ConvertAmountTo18Decimals256Int(amt *uint256.Int) *uint256.Int {
+	return new(uint256.Int).Mul(amt, uint256.NewInt(eci.Decimals.ConversionFactor().Uint64()))
+}
+
+// ConvertBigIntFrom18DecimalsToLegacyDec converts the given amount into a
+// LegacyDec with the decimals of the EVM coin.
+func (eci EvmCoinInfo) ConvertBigIntFrom18DecimalsToLegacyDec(amt *big.Int) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecFromBigInt(amt).QuoInt(eci.Decimals.ConversionFactor())
+}
+
+// ConvertEvmCoinDenomToExtendedDenom converts the coin's Denom to the extended
+// denom. It returns an error if the coin denom is not the EVM one.
+func (eci EvmCoinInfo) ConvertEvmCoinDenomToExtendedDenom(coin sdk.Coin) (sdk.Coin, error) {
+	if coin.Denom != eci.Denom {
+		return sdk.Coin{}, fmt.Errorf("expected coin denom %s, received %s", eci.Denom, coin.Denom)
+	}
+
+	return sdk.Coin{Denom: eci.ExtendedDenom, Amount: coin.Amount}, nil
+}
+
+// ConvertCoinsDenomToExtendedDenom returns the given coins with the Denom of the
+// EVM coin converted to the extended denom.
+func (eci EvmCoinInfo) ConvertCoinsDenomToExtendedDenom(coins sdk.Coins) sdk.Coins {
+	convertedCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Denom == eci.Denom {
+			coin, _ = eci.ConvertEvmCoinDenomToExtendedDenom(coin)
+		}
+		convertedCoins[i] = coin
+	}
+	return convertedCoins.Sort()
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewEVMAppConfig(t *testing.T) {
+	sixDecimalsCoinInfo := testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID]
+
+	testCases := []struct {
+		name        string
+		chainConfig *evmtypes.ChainConfig
+		coinInfo    evmtypes.EvmCoinInfo
+		expChainID  uint64
+		expErr      bool
+	}{
+		{
+			name:        "pass - default chain config",
+			chainConfig: nil,
+			coinInfo:    sixDecimalsCoinInfo,
+			expChainID:  evmtypes.DefaultChainConfig(0).ChainId,
+		},
+		{
+			name:        "pass - custom chain config",
+			chainConfig: evmtypes.DefaultChainConfig(testconstants.SixDecimalsChainID.EVMChainID),
+			coinInfo:    sixDecimalsCoinInfo,
+			expChainID:  testconstants.SixDecimalsChainID.EVMChainID,
+		},
+		{
+			name:     "fail - invalid denom",
+			coinInfo: evmtypes.EvmCoinInfo{Denom: "", ExtendedDenom: "aevm", Decimals: evmtypes.EighteenDecimals},
+			expErr:   true,
+		},
+		{
+			name:     "fail - invalid decimals",
+			coinInfo: evmtypes.EvmCoinInfo{Denom: "uevm", ExtendedDenom: "aevm", Decimals: 19},
+			expErr:   true,
+		},
+		{
+			name:     "fail - different extended denom with 18 decimals",
+			coinInfo: evmtypes.EvmCoinInfo{Denom: "aevm", ExtendedDenom: "uevm", Decimals: evmtypes.EighteenDecimals},
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := evmtypes.NewEVMAppConfig(tc.chainConfig, tc.coinInfo)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.coinInfo, config.CoinInfo)
+			require.Equal(t, tc.coinInfo.Denom, config.ChainConfig.Denom)
+			require.Equal(t, uint64(tc.coinInfo.Decimals), config.ChainConfig.Decimals)
+			require.Equal(t, tc.expChainID, config.ChainConfig.ChainId)
+			require.Equal(t, new(big.Int).SetUint64(tc.expChainID), config.EthChainConfig().ChainID)
+			if tc.chainConfig != nil {
+				require.NotSame(t, tc.chainConfig, config.ChainConfig, "expected the chain config to be copied")
+			}
+		})
+	}
+}
+
+func TestEvmCoinInfoConversions(t *testing.T) {
+	coinInfo := testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID]
+
+	require.Equal(t, big.NewInt(1e18), coinInfo.ConvertAmountTo18DecimalsBigInt(big.NewInt(1e6)))
+	require.Equal(t, math.LegacyNewDec(1), coinInfo.ConvertBigIntFrom18DecimalsToLegacyDec(big.NewInt(1e12)))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(coinInfo.Denom, 10), sdk.NewInt64Coin("other", 5))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(coinInfo.ExtendedDenom, 10), sdk.NewInt64Coin("other", 5)),
+		coinInfo.ConvertCoinsDenomToExtendedDenom(coins),
+	)
+}
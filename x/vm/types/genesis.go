@@ -14,6 +14,20 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
+// Validate performs a basic validation of a PendingUnbondingCallback fields.
+func (c PendingUnbondingCallback) Validate() error {
+	if err := types.ValidateNonZeroAddress(c.ContractAddress); err != nil {
+		return err
+	}
+	if c.ValidatorAddress == "" {
+		return fmt.Errorf("empty validator address")
+	}
+	if c.Amount.IsNil() || c.Amount.IsNegative() {
+		return fmt.Errorf("invalid amount %s", c.Amount)
+	}
+	return nil
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
@@ -66,5 +80,28 @@ func (gs GenesisState) Validate() error {
 		seenPreinstalls[preinstall.Address] = true
 	}
 
+	seenCallbacks := make(map[string]bool)
+	for _, addr := range gs.UnbondingCallbacks {
+		if err := types.ValidateNonZeroAddress(addr); err != nil {
+			return fmt.Errorf("invalid unbonding callback contract: %w", err)
+		}
+		if seenCallbacks[addr] {
+			return fmt.Errorf("duplicated unbonding callback contract %s", addr)
+		}
+		seenCallbacks[addr] = true
+	}
+
+	for _, callback := range gs.PendingUnbondingCallbacks {
+		if err := callback.Validate(); err != nil {
+			return fmt.Errorf("invalid pending unbonding callback: %w", err)
+		}
+	}
+
+	if gs.ChainConfig != nil {
+		if err := gs.ChainConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid chain config: %w", err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
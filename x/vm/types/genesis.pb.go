@@ -32,6 +32,15 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// preinstalls defines a set of predefined contracts
 	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// chain_config defines the chain config stored in state, which overrides the
+	// one set by the app on startup. It is omitted when none is stored.
+	ChainConfig *ChainConfig `protobuf:"bytes,4,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
+	// unbonding_callbacks defines the hex addresses of the contracts registered
+	// for the onUnbondingCompleted callback of their unbonding delegations.
+	UnbondingCallbacks []string `protobuf:"bytes,5,rep,name=unbonding_callbacks,json=unbondingCallbacks,proto3" json:"unbonding_callbacks,omitempty"`
+	// pending_unbonding_callbacks defines the onUnbondingCompleted callbacks
+	// deferred to the next blocks, in the order they are called.
+	PendingUnbondingCallbacks []PendingUnbondingCallback `protobuf:"bytes,6,rep,name=pending_unbonding_callbacks,json=pendingUnbondingCallbacks,proto3" json:"pending_unbonding_callbacks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +97,27 @@ func (m *GenesisState) GetPreinstalls() []Preinstall {
 	return nil
 }
 
+func (m *GenesisState) GetChainConfig() *ChainConfig {
+	if m != nil {
+		return m.ChainConfig
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingCallbacks() []string {
+	if m != nil {
+		return m.UnbondingCallbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingUnbondingCallbacks() []PendingUnbondingCallback {
+	if m != nil {
+		return m.PendingUnbondingCallbacks
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -162,29 +192,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x29, 0x0c, 0x43, 0x40, 0xca, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xba, 0xcf, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51, 0x81, 0x59,
-	0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd, 0xc5, 0x56,
-	0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81, 0x69, 0x4c,
-	0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x90, 0x27, 0x17, 0x77, 0x41, 0x51, 0x6a, 0x66, 0x5e,
-	0x71, 0x49, 0x62, 0x4e, 0x4e, 0xb1, 0x04, 0x33, 0xd8, 0x21, 0x32, 0x58, 0x4c, 0x80, 0x2b, 0x42,
-	0x36, 0x05, 0x59, 0xaf, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x7b, 0x85, 0x24, 0xb8, 0xd8, 0x13,
-	0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0x5e, 0x64, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x84, 0xb8,
-	0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0x4e, 0xe6, 0x0c, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xd8, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x12, 0xd3, 0x53, 0xa1, 0xee, 0x10, 0xc7, 0x74, 0x07, 0x38, 0xec, 0x9c, 0x44,
-	0x40, 0x4e, 0x58, 0x75, 0x5f, 0x9e, 0x3d, 0x18, 0xa2, 0x1e, 0xe2, 0x1a, 0x98, 0x6e, 0x27, 0xab,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x8a, 0xc2, 0x0a, 0x50, 0x24, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x23, 0x28, 0x5f,
-	0x27, 0x02, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xbd, 0x8e, 0xd3, 0x30,
+	0x1c, 0x8f, 0x49, 0x69, 0x89, 0x73, 0x42, 0x60, 0x4e, 0x22, 0x14, 0xc8, 0x45, 0x37, 0x45, 0x37,
+	0x24, 0xba, 0x63, 0x83, 0x05, 0xd2, 0xa1, 0x62, 0x43, 0x39, 0xb1, 0xb0, 0x9c, 0x1c, 0xc7, 0xe4,
+	0x22, 0x12, 0x3b, 0x8a, 0xdd, 0x0a, 0x9e, 0x80, 0x95, 0xc7, 0x40, 0x4c, 0x3c, 0x04, 0x43, 0xc7,
+	0x8e, 0x4c, 0x80, 0xda, 0x81, 0xd7, 0x40, 0xb6, 0xdb, 0x12, 0x9a, 0x22, 0x59, 0xd1, 0xdf, 0xf9,
+	0x7d, 0xf8, 0xff, 0x05, 0x7d, 0xc2, 0x45, 0xcd, 0x45, 0x4c, 0xe7, 0x75, 0xac, 0xce, 0x79, 0x5c,
+	0x50, 0x46, 0x45, 0x29, 0xa2, 0xa6, 0xe5, 0x92, 0xa3, 0x3b, 0x06, 0x8f, 0xe8, 0xbc, 0x8e, 0xd4,
+	0x39, 0x1f, 0xdf, 0xc5, 0x75, 0xc9, 0x78, 0xac, 0xbf, 0x86, 0x34, 0x1e, 0xf7, 0x4c, 0x14, 0xdd,
+	0x60, 0xc7, 0x05, 0x2f, 0xb8, 0x0e, 0x63, 0x15, 0x99, 0xbf, 0xa7, 0xdf, 0x6c, 0x78, 0x34, 0x35,
+	0x0f, 0x5d, 0x4a, 0x2c, 0x29, 0x9a, 0xc2, 0x5b, 0x98, 0x10, 0x3e, 0x63, 0x52, 0x78, 0x20, 0xb0,
+	0x43, 0xf7, 0x22, 0x88, 0xf6, 0x9f, 0x8e, 0x36, 0x8a, 0x17, 0x86, 0x98, 0x38, 0x8b, 0x1f, 0x27,
+	0xd6, 0xe7, 0xdf, 0x5f, 0xcf, 0x40, 0xba, 0x13, 0xa3, 0x67, 0x70, 0xd8, 0xe0, 0x16, 0xd7, 0xc2,
+	0xbb, 0x11, 0x80, 0xd0, 0xbd, 0xf0, 0xfa, 0x36, 0xaf, 0x34, 0xde, 0x95, 0x6f, 0x24, 0xe8, 0x25,
+	0x74, 0x9b, 0x96, 0x96, 0x4c, 0x48, 0x5c, 0x55, 0xc2, 0xb3, 0x75, 0x22, 0x8f, 0x0e, 0x38, 0xec,
+	0x48, 0x5d, 0x97, 0xae, 0x16, 0x3d, 0x87, 0x47, 0xe4, 0x1a, 0x97, 0xec, 0x8a, 0x70, 0xf6, 0xb6,
+	0x2c, 0xbc, 0x81, 0xce, 0xe6, 0x71, 0xdf, 0x6b, 0xa2, 0x58, 0x13, 0x4d, 0x4a, 0x5d, 0xf2, 0xf7,
+	0x82, 0x62, 0x78, 0x6f, 0xc6, 0x32, 0xce, 0xf2, 0x92, 0x15, 0x57, 0x04, 0x57, 0x55, 0x86, 0xc9,
+	0x3b, 0xe1, 0xdd, 0x0c, 0xec, 0xd0, 0x49, 0xd1, 0x0e, 0x9a, 0x6c, 0x11, 0xd4, 0xc0, 0x87, 0x0d,
+	0x35, 0xf4, 0x43, 0xc2, 0xa1, 0xae, 0xe6, 0xec, 0x40, 0x35, 0x46, 0xf4, 0x7a, 0xdf, 0x31, 0x19,
+	0xa8, 0xda, 0xd2, 0x07, 0xcd, 0x7f, 0x70, 0x71, 0xfa, 0x11, 0xc0, 0xdb, 0xff, 0x0e, 0x05, 0x79,
+	0x70, 0x84, 0xf3, 0xbc, 0xa5, 0x42, 0xcd, 0x11, 0x84, 0x4e, 0xba, 0xbd, 0x22, 0x04, 0x07, 0x84,
+	0xe7, 0x54, 0xcf, 0xc5, 0x49, 0x75, 0x8c, 0xa6, 0x70, 0x24, 0x24, 0x6f, 0x71, 0x41, 0x37, 0xcd,
+	0xbe, 0xdf, 0x4f, 0x4f, 0x2f, 0x48, 0x72, 0xac, 0x72, 0xf9, 0xf2, 0xf3, 0x64, 0x74, 0x69, 0xf8,
+	0xa6, 0xe5, 0x5b, 0x75, 0xf2, 0x74, 0xb1, 0xf2, 0xc1, 0x72, 0xe5, 0x83, 0x5f, 0x2b, 0x1f, 0x7c,
+	0x5a, 0xfb, 0xd6, 0x72, 0xed, 0x5b, 0xdf, 0xd7, 0xbe, 0xf5, 0x26, 0x28, 0x4a, 0x79, 0x3d, 0xcb,
+	0x22, 0xc2, 0xeb, 0xb8, 0xb3, 0xa7, 0xef, 0xd5, 0xa6, 0xca, 0x0f, 0x0d, 0x15, 0xd9, 0x50, 0xef,
+	0xe4, 0x93, 0x3f, 0x03, 0x00, 0x36, 0x62, 0xec, 0x87, 0x0c, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +242,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingUnbondingCallbacks) > 0 {
+		for iNdEx := len(m.PendingUnbondingCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUnbondingCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UnbondingCallbacks) > 0 {
+		for iNdEx := len(m.UnbondingCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnbondingCallbacks[iNdEx])
+			copy(dAtA[i:], m.UnbondingCallbacks[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.UnbondingCallbacks[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ChainConfig != nil {
+		{
+			size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Preinstalls) > 0 {
 		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +400,22 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.ChainConfig != nil {
+		l = m.ChainConfig.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.UnbondingCallbacks) > 0 {
+		for _, s := range m.UnbondingCallbacks {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingUnbondingCallbacks) > 0 {
+		for _, e := range m.PendingUnbondingCallbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -492,6 +578,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainConfig == nil {
+				m.ChainConfig = &ChainConfig{}
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCallbacks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingCallbacks = append(m.UnbondingCallbacks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnbondingCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUnbondingCallbacks = append(m.PendingUnbondingCallbacks, PendingUnbondingCallback{})
+			if err := m.PendingUnbondingCallbacks[len(m.PendingUnbondingCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
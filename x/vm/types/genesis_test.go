@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+
+	sdkmath "cosmossdk.io/math"
 )
 
 type GenesisTestSuite struct {
@@ -163,6 +165,29 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid chain config",
+			genState: &GenesisState{
+				Accounts:    []GenesisAccount{},
+				Params:      DefaultParams(),
+				ChainConfig: DefaultChainConfig(0),
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid chain config",
+			genState: &GenesisState{
+				Accounts: []GenesisAccount{},
+				Params:   DefaultParams(),
+				ChainConfig: func() *ChainConfig {
+					chainConfig := DefaultChainConfig(0)
+					negative := sdkmath.NewInt(-1)
+					chainConfig.OsakaTime = &negative
+					return chainConfig
+				}(),
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid preinstall",
 			genState: &GenesisState{
@@ -217,6 +242,33 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid unbonding callbacks",
+			genState: &GenesisState{
+				Accounts:           []GenesisAccount{},
+				Params:             DefaultParams(),
+				UnbondingCallbacks: []string{suite.address},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid unbonding callback address",
+			genState: &GenesisState{
+				Accounts:           []GenesisAccount{},
+				Params:             DefaultParams(),
+				UnbondingCallbacks: []string{"invalid-address"},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated unbonding callback",
+			genState: &GenesisState{
+				Accounts:           []GenesisAccount{},
+				Params:             DefaultParams(),
+				UnbondingCallbacks: []string{suite.address, suite.address},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -27,6 +27,14 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixHeaderHash
+	prefixPrevRandao
+	prefixChainConfig
+	prefixDevTimeOffset
+	prefixUnbondingCallback
+	prefixPrevRandaoHistory
+	prefixPendingUnbondingCallback
+	prefixStorageHash
 )
 
 // prefix bytes for the EVM transient store
@@ -35,6 +43,10 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientTxMsgCount
+	prefixTransientFeePayer
+	prefixTransientReplacedStorage
+	prefixTransientReplacedStorageSlot
 )
 
 // KVStore key prefixes
@@ -43,14 +55,45 @@ var (
 	KeyPrefixStorage  = []byte{prefixStorage}
 	KeyPrefixParams   = []byte{prefixParams}
 	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	// KeyPrefixHeaderHash holds the hash of the last begun block, written as
+	// the parent hash into the history storage on the next block.
+	KeyPrefixHeaderHash = []byte{prefixHeaderHash}
+	// KeyPrefixPrevRandao holds the PREVRANDAO value of the current block.
+	KeyPrefixPrevRandao = []byte{prefixPrevRandao}
+	// KeyPrefixChainConfig holds the chain config updated by governance, which
+	// overrides the one set by the app on startup.
+	KeyPrefixChainConfig = []byte{prefixChainConfig}
+	// KeyPrefixDevTimeOffset holds the offset in seconds added to the block
+	// time seen by the EVM in dev mode.
+	KeyPrefixDevTimeOffset = []byte{prefixDevTimeOffset}
+	// KeyPrefixUnbondingCallback holds the contracts registered for the
+	// onUnbondingCompleted callback, keyed by address.
+	KeyPrefixUnbondingCallback = []byte{prefixUnbondingCallback}
+	// KeyPrefixPrevRandaoHistory holds the PREVRANDAO values of the last
+	// blocks, keyed by height.
+	KeyPrefixPrevRandaoHistory = []byte{prefixPrevRandaoHistory}
+	// KeyPrefixPendingUnbondingCallback holds the onUnbondingCompleted callbacks
+	// deferred to the next blocks, keyed by completion height and index.
+	KeyPrefixPendingUnbondingCallback = []byte{prefixPendingUnbondingCallback}
+	// KeyPrefixStorageHash holds the commitment to the storage of each account,
+	// keyed by address.
+	KeyPrefixStorageHash = []byte{prefixStorageHash}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom      = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex    = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize    = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed    = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientTxMsgCount = []byte{prefixTransientTxMsgCount}
+	KeyPrefixTransientFeePayer   = []byte{prefixTransientFeePayer}
+	// KeyPrefixTransientReplacedStorage marks the accounts whose storage was
+	// replaced by a state override, keyed by address.
+	KeyPrefixTransientReplacedStorage = []byte{prefixTransientReplacedStorage}
+	// KeyPrefixTransientReplacedStorageSlot holds the replaced storage of the
+	// accounts, keyed by address and slot.
+	KeyPrefixTransientReplacedStorageSlot = []byte{prefixTransientReplacedStorageSlot}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -58,6 +101,29 @@ func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
 
+// ReplacedStorageKey defines the transient key marking the replaced storage of
+// an account.
+func ReplacedStorageKey(address common.Address) []byte {
+	return append(KeyPrefixTransientReplacedStorage, address.Bytes()...)
+}
+
+// ReplacedStoragePrefix returns a prefix to iterate over the replaced storage
+// of an account.
+func ReplacedStoragePrefix(address common.Address) []byte {
+	return append(KeyPrefixTransientReplacedStorageSlot, address.Bytes()...)
+}
+
+// CodeHashKey defines the full key under which an account code hash is stored.
+func CodeHashKey(address common.Address) []byte {
+	return append(KeyPrefixCodeHash, address.Bytes()...)
+}
+
+// StorageHashKey defines the full key under which the commitment to an account
+// storage is stored.
+func StorageHashKey(address common.Address) []byte {
+	return append(KeyPrefixStorageHash, address.Bytes()...)
+}
+
 // StateKey defines the full key under which an account state is stored.
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
@@ -60,6 +60,26 @@ func (_m *AccountKeeper) GetAccount(ctx context.Context, addr cosmos_sdktypes.Ac
 	return r0
 }
 
+// GetModuleAccount provides a mock function with given fields: ctx, moduleName
+func (_m *AccountKeeper) GetModuleAccount(ctx context.Context, moduleName string) cosmos_sdktypes.ModuleAccountI {
+	ret := _m.Called(ctx, moduleName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModuleAccount")
+	}
+
+	var r0 cosmos_sdktypes.ModuleAccountI
+	if rf, ok := ret.Get(0).(func(context.Context, string) cosmos_sdktypes.ModuleAccountI); ok {
+		r0 = rf(ctx, moduleName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(cosmos_sdktypes.ModuleAccountI)
+		}
+	}
+
+	return r0
+}
+
 // GetModuleAddress provides a mock function with given fields: moduleName
 func (_m *AccountKeeper) GetModuleAddress(moduleName string) cosmos_sdktypes.AccAddress {
 	ret := _m.Called(moduleName)
@@ -144,6 +164,11 @@ func (_m *AccountKeeper) HasAccount(ctx context.Context, addr cosmos_sdktypes.Ac
 	return r0
 }
 
+// IterateAccounts provides a mock function with given fields: ctx, cb
+func (_m *AccountKeeper) IterateAccounts(ctx context.Context, cb func(cosmos_sdktypes.AccountI) bool) {
+	_m.Called(ctx, cb)
+}
+
 // NewAccountWithAddress provides a mock function with given fields: ctx, addr
 func (_m *AccountKeeper) NewAccountWithAddress(ctx context.Context, addr cosmos_sdktypes.AccAddress) cosmos_sdktypes.AccountI {
 	ret := _m.Called(ctx, addr)
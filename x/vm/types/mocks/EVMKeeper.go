@@ -94,6 +94,13 @@ func (k EVMKeeper) DeleteState(_ sdk.Context, addr common.Address, key common.Ha
 	}
 }
 
+func (k EVMKeeper) ReplaceStorage(_ sdk.Context, addr common.Address) {
+	if acct, ok := k.accounts[addr]; ok {
+		acct.states = make(statedb.Storage)
+		k.accounts[addr] = acct
+	}
+}
+
 func (k EVMKeeper) SetCode(_ sdk.Context, codeHash []byte, code []byte) {
 	k.codes[common.BytesToHash(codeHash)] = code
 }
@@ -124,3 +131,7 @@ func (k EVMKeeper) Clone() *EVMKeeper {
 func (k EVMKeeper) KVStoreKeys() map[string]*storetypes.KVStoreKey {
 	return k.storeKeys
 }
+
+func (k EVMKeeper) EVMAppConfig() *types.EVMAppConfig {
+	return types.GlobalEVMAppConfig()
+}
@@ -2,13 +2,16 @@ package types
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	protov2 "google.golang.org/protobuf/proto"
 
 	evmapi "github.com/cosmos/evm/api/cosmos/evm/vm/v1"
@@ -33,10 +36,18 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgUpdateChainConfig{}
+	_ sdk.Msg    = &MsgDevSetState{}
+	_ sdk.Msg    = &MsgDevIncreaseTime{}
+	_ sdk.Msg    = &MsgDevSendTransaction{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
 
+// feePayerSignPrefix domain separates the hash signed by the fee payer of a
+// sponsored tx.
+const feePayerSignPrefix = "cosmos/evm/feePayer"
+
 // message type and route constants
 const (
 	// TypeMsgEthereumTx defines the type string of an Ethereum transaction
@@ -84,6 +95,22 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case tx.AuthorizationList != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+
+		txData = &SetCodeTx{
+			ChainID:           cid,
+			Amount:            amt,
+			To:                toAddr,
+			GasTipCap:         &gtc,
+			GasFeeCap:         &gfc,
+			Nonce:             tx.Nonce,
+			GasLimit:          tx.GasLimit,
+			Data:              tx.Input,
+			Accesses:          NewAccessList(tx.Accesses),
+			AuthorizationList: NewAuthorizationList(tx.AuthorizationList),
+		}
 	case tx.GasFeeCap != nil:
 		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
 		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
@@ -398,41 +425,154 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte, signer ethtypes.Signer) erro
 	return msg.FromSignedEthereumTx(tx, signer)
 }
 
-// BuildTx builds the canonical cosmos tx from ethereum msg
-func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+// BuildTx builds the canonical cosmos tx from ethereum msg, paying the fee in
+// the extended denom of the given EVM coin.
+func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, coinInfo EvmCoinInfo) (signing.Tx, error) {
+	return BuildBatchTx(b, coinInfo, msg)
+}
+
+// BuildBatchTx builds a cosmos tx made of several evm txs, executed as an
+// atomic batch. The fee and the gas limit of the tx are the sums of the ones
+// of the evm txs.
+func BuildBatchTx(b client.TxBuilder, coinInfo EvmCoinInfo, msgs ...*MsgEthereumTx) (signing.Tx, error) {
+	return BuildSponsoredTx(b, coinInfo, nil, nil, msgs...)
+}
+
+// BuildSponsoredTx builds a cosmos tx made of evm txs whose fees are paid by
+// feePayer out of the x/feegrant allowances granted to their senders. The fee
+// payer signature must be over the FeePayerSignHash of the evm txs. A nil fee
+// payer leaves the fees to the senders.
+func BuildSponsoredTx(b client.TxBuilder, coinInfo EvmCoinInfo, feePayer sdk.AccAddress, feePayerSig []byte, msgs ...*MsgEthereumTx) (signing.Tx, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("no ethereum tx to build")
+	}
+
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
 	}
 
-	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
+	extOpt := &ExtensionOptionsEthereumTx{}
+	if feePayer != nil {
+		extOpt.FeePayer = feePayer.String()
+		extOpt.FeePayerSig = feePayerSig
+	}
+	option, err := codectypes.NewAnyWithValue(extOpt)
 	if err != nil {
 		return nil, err
 	}
 
-	txData, err := UnpackTxData(msg.Data)
-	if err != nil {
-		return nil, err
+	feeAmt := sdkmath.ZeroInt()
+	gasLimit := uint64(0)
+	sdkMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		txData, err := UnpackTxData(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		feeAmt = feeAmt.Add(sdkmath.NewIntFromBigInt(txData.Fee()))
+		gasLimit += msg.GetGas()
+		sdkMsgs[i] = msg
 	}
+
 	fees := make(sdk.Coins, 0, 1)
-	feeAmt := sdkmath.NewIntFromBigInt(txData.Fee())
 	if feeAmt.Sign() > 0 {
-		fees = append(fees, sdk.NewCoin(evmDenom, feeAmt))
-		fees = ConvertCoinsDenomToExtendedDenom(fees)
+		fees = append(fees, sdk.NewCoin(coinInfo.Denom, feeAmt))
+		fees = coinInfo.ConvertCoinsDenomToExtendedDenom(fees)
 	}
 
 	builder.SetExtensionOptions(option)
 
-	err = builder.SetMsgs(msg)
+	err = builder.SetMsgs(sdkMsgs...)
 	if err != nil {
 		return nil, err
 	}
 	builder.SetFeeAmount(fees)
-	builder.SetGasLimit(msg.GetGas())
+	builder.SetGasLimit(gasLimit)
 	tx := builder.GetTx()
 	return tx, nil
 }
 
+// GetFeePayer returns the fee payer named by the ExtensionOptionsEthereumTx of
+// a cosmos tx made of evm txs, or nil if the senders pay their own fees. It
+// doesn't check the fee payer signature, see VerifyFeePayerSig.
+func GetFeePayer(tx sdk.Tx) (sdk.AccAddress, error) {
+	extOpt := getFeePayerOption(tx)
+	if extOpt == nil {
+		return nil, nil
+	}
+	feePayer, err := sdk.AccAddressFromBech32(extOpt.FeePayer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid fee payer %s: %s", extOpt.FeePayer, err)
+	}
+	return feePayer, nil
+}
+
+// FeePayerSignHash returns the hash the fee payer of a cosmos tx signs to pay
+// the fees of its evm txs on the given chain. It commits to the hashes of the
+// evm txs, so that the signature can't be reused to spend the allowances of
+// the fee payer for other txs.
+func FeePayerSignHash(chainID *big.Int, msgs ...*MsgEthereumTx) common.Hash {
+	data := append([]byte(feePayerSignPrefix), common.BigToHash(chainID).Bytes()...)
+	for _, msg := range msgs {
+		data = append(data, msg.AsTransaction().Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// VerifyFeePayerSig checks that the fee payer signature of a sponsored cosmos
+// tx is a signature of the given fee payer over the FeePayerSignHash of its
+// evm txs.
+func VerifyFeePayerSig(tx sdk.Tx, chainID *big.Int, feePayer sdk.AccAddress) error {
+	extOpt := getFeePayerOption(tx)
+	if extOpt == nil {
+		return errorsmod.Wrap(errortypes.ErrNoSignatures, "tx has no fee payer")
+	}
+
+	sig := bytes.Clone(extOpt.FeePayerSig)
+	if len(sig) != crypto.SignatureLength {
+		return errorsmod.Wrapf(errortypes.ErrNoSignatures, "invalid fee payer signature length %d", len(sig))
+	}
+	if sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	msgs := make([]*MsgEthereumTx, len(tx.GetMsgs()))
+	for i, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*MsgEthereumTx)
+		if !ok {
+			return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*MsgEthereumTx)(nil))
+		}
+		msgs[i] = ethMsg
+	}
+
+	pubKey, err := crypto.SigToPub(FeePayerSignHash(chainID, msgs...).Bytes(), sig)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid fee payer signature: %s", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); !bytes.Equal(signer.Bytes(), feePayer) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "fee payer signature from %s, expected %s", sdk.AccAddress(signer.Bytes()), feePayer)
+	}
+	return nil
+}
+
+// getFeePayerOption returns the ExtensionOptionsEthereumTx of the tx naming a
+// fee payer, if any.
+func getFeePayerOption(tx sdk.Tx) *ExtensionOptionsEthereumTx {
+	txWithExtensions, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	for _, opt := range txWithExtensions.GetExtensionOptions() {
+		extOpt, ok := opt.GetCachedValue().(*ExtensionOptionsEthereumTx)
+		if ok && extOpt.FeePayer != "" {
+			return extOpt
+		}
+	}
+	return nil
+}
+
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
@@ -446,3 +586,72 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateChainConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.ChainConfig.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateChainConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDevSetState) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	var overrides StateOverride
+	if err := json.Unmarshal(m.Overrides, &overrides); err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal state overrides")
+	}
+	return overrides.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDevSetState) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDevIncreaseTime) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if m.Seconds > math.MaxInt64 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "time increase %d overflows int64", m.Seconds)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDevIncreaseTime) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDevSendTransaction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	var args TransactionArgs
+	if err := json.Unmarshal(m.Args, &args); err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal transaction args")
+	}
+	if args.From == nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "missing transaction sender")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDevSendTransaction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
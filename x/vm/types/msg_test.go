@@ -117,17 +117,11 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTx() {
 		testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID],
 	} {
 		for _, tc := range testCases {
-			configurator := types.NewEVMConfigurator()
-			configurator.ResetTestConfig()
-			suite.Require().NoError(configurator.WithEVMCoinInfo(coinInfo).Configure())
 			if strings.Contains(tc.name, "nil data") {
 				tc.msg.Data = nil
 			}
 
-			baseDenom := types.GetEVMCoinDenom()
-			extendedDenom := types.GetEVMCoinExtendedDenom()
-
-			tx, err := tc.msg.BuildTx(suite.clientCtx.TxConfig.NewTxBuilder(), baseDenom)
+			tx, err := tc.msg.BuildTx(suite.clientCtx.TxConfig.NewTxBuilder(), coinInfo)
 			if tc.expError {
 				suite.Require().Error(err)
 			} else {
@@ -138,13 +132,51 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTx() {
 				suite.Require().Equal(uint64(100000), tx.GetGas())
 
 				expFeeAmt := sdkmath.NewIntFromBigInt(evmTx.GasPrice).MulRaw(int64(evmTx.GasLimit)) //#nosec
-				expFee := sdk.NewCoins(sdk.NewCoin(extendedDenom, expFeeAmt))
+				expFee := sdk.NewCoins(sdk.NewCoin(coinInfo.ExtendedDenom, expFeeAmt))
 				suite.Require().Equal(expFee, tx.GetFee())
 			}
 		}
 	}
 }
 
+func (suite *MsgsTestSuite) TestBuildSponsoredTx() {
+	evmTx := &types.EvmTxArgs{
+		Nonce:    0,
+		To:       &suite.to,
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	}
+	feePayerAddr, feePayerKey := utiltx.NewAddrKey()
+	feePayer := sdk.AccAddress(feePayerAddr.Bytes())
+	txConfig := suite.clientCtx.TxConfig
+
+	msg := types.NewTx(evmTx)
+	sig, err := feePayerKey.Sign(types.FeePayerSignHash(suite.chainID, msg).Bytes())
+	suite.Require().NoError(err)
+
+	tx, err := types.BuildSponsoredTx(txConfig.NewTxBuilder(), types.GetEVMCoinInfo(), feePayer, sig, msg)
+	suite.Require().NoError(err)
+
+	payer, err := types.GetFeePayer(tx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(feePayer, payer)
+	suite.Require().NoError(types.VerifyFeePayerSig(tx, suite.chainID, feePayer))
+
+	// the signature is bound to the fee payer, the chain and the evm txs
+	suite.Require().Error(types.VerifyFeePayerSig(tx, suite.chainID, sdk.AccAddress(suite.from.Bytes())))
+	suite.Require().Error(types.VerifyFeePayerSig(tx, big.NewInt(2), feePayer))
+	evmTx.Nonce = 1
+	tx, err = types.BuildSponsoredTx(txConfig.NewTxBuilder(), types.GetEVMCoinInfo(), feePayer, sig, types.NewTx(evmTx))
+	suite.Require().NoError(err)
+	suite.Require().Error(types.VerifyFeePayerSig(tx, suite.chainID, feePayer))
+
+	tx, err = types.NewTx(evmTx).BuildTx(txConfig.NewTxBuilder(), types.GetEVMCoinInfo())
+	suite.Require().NoError(err)
+	payer, err = types.GetFeePayer(tx)
+	suite.Require().NoError(err)
+	suite.Require().Nil(payer)
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 	var (
 		hundredInt   = big.NewInt(100)
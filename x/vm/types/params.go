@@ -14,6 +14,8 @@ import (
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -25,6 +27,8 @@ var (
 	DefaultEVMDecimals uint64 = 18
 	// DefaultAllowUnprotectedTxs rejects all unprotected txs (i.e false)
 	DefaultAllowUnprotectedTxs = false
+	// DefaultDevMode disables the dev messages and queries
+	DefaultDevMode = false
 	// DefaultStaticPrecompiles defines the default active precompiles.
 	DefaultStaticPrecompiles []string
 	// DefaultExtraEIPs defines the default extra EIPs to be included.
@@ -71,6 +75,7 @@ func DefaultParams() Params {
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		DevMode:                 DefaultDevMode,
 	}
 }
 
@@ -110,6 +115,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateDevAuthority(p.DevAuthority); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -180,6 +189,17 @@ func validateAllowlistAddresses(i interface{}) error {
 	return nil
 }
 
+// validateDevAuthority checks the dev authority is empty or a valid address.
+func validateDevAuthority(authority string) error {
+	if authority == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid dev authority %s: %w", authority, err)
+	}
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -49,6 +49,13 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "invalid dev authority",
+			params: Params{
+				DevAuthority: "invalid",
+			},
+			errContains: "invalid dev authority",
+		},
 	}
 
 	for _, tc := range testCases {
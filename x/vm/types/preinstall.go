@@ -30,6 +30,11 @@ var DefaultPreinstalls = []Preinstall{
 		Address: "0x914d7Fec6aaC8cd542e72Bca78B30650d45643d7",
 		Code:    "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3",
 	},
+	{
+		Name:    "EIP-2935 history storage",
+		Address: "0x0000F90827F1C53a10cb7A02335B175320002935",
+		Code:    "0x3373fffffffffffffffffffffffffffffffffffffffe14604657602036036042575f35600143038111604257611fff81430311604257611fff9006545f5260205ff35b5f5ffd5b5f35611fff60014303065500",
+	},
 }
 
 // Validate performs basic validation checks on the Preinstall
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// randaoCommitTxPrefix prefixes the pseudo transaction carrying the extended
+// commit of the previous block, which is not a valid transaction encoding.
+var randaoCommitTxPrefix = []byte("cosmos/evm/randao")
+
+// EncodeRandaoCommitTx returns the pseudo transaction injected by the proposer
+// as the first transaction of a block, carrying the vote extension signatures
+// of the previous block.
+func EncodeRandaoCommitTx(commit abci.ExtendedCommitInfo) ([]byte, error) {
+	bz, err := commit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, randaoCommitTxPrefix...), bz...), nil
+}
+
+// DecodeRandaoCommitTx decodes the extended commit of a pseudo transaction
+// returned by EncodeRandaoCommitTx. It returns false if the transaction is not
+// one.
+func DecodeRandaoCommitTx(tx []byte) (abci.ExtendedCommitInfo, bool, error) {
+	var commit abci.ExtendedCommitInfo
+	if !bytes.HasPrefix(tx, randaoCommitTxPrefix) {
+		return commit, false, nil
+	}
+	if err := commit.Unmarshal(tx[len(randaoCommitTxPrefix):]); err != nil {
+		return commit, true, err
+	}
+	return commit, true, nil
+}
+
+// SplitRandaoCommitTx returns the pseudo transaction returned by
+// EncodeRandaoCommitTx if it is the first of the given transactions, and the
+// other transactions, which are the ones to deliver. It returns a nil commit
+// transaction if the first one is not one.
+func SplitRandaoCommitTx(txs [][]byte) ([]byte, [][]byte) {
+	if len(txs) == 0 || !bytes.HasPrefix(txs[0], randaoCommitTxPrefix) {
+		return nil, txs
+	}
+	return txs[0], txs[1:]
+}
+
+// ValidateRandaoCommit returns an error if the extended commit doesn't carry
+// exactly the votes of the last commit of the proposal, with the same
+// validators, powers and block ID flags in the same order. Otherwise the
+// proposer could leave out commit votes to choose the seed among subsets of
+// the signatures.
+func ValidateRandaoCommit(commit abci.ExtendedCommitInfo, lastCommit abci.CommitInfo) error {
+	if commit.Round != lastCommit.Round {
+		return fmt.Errorf("round mismatch: expected %d, got %d", lastCommit.Round, commit.Round)
+	}
+	if len(commit.Votes) != len(lastCommit.Votes) {
+		return fmt.Errorf("votes count mismatch: expected %d, got %d", len(lastCommit.Votes), len(commit.Votes))
+	}
+	for i, vote := range commit.Votes {
+		expected := lastCommit.Votes[i]
+		if !bytes.Equal(vote.Validator.Address, expected.Validator.Address) || vote.Validator.Power != expected.Validator.Power {
+			return fmt.Errorf("validator mismatch at index %d", i)
+		}
+		if vote.BlockIdFlag != expected.BlockIdFlag {
+			return fmt.Errorf("block ID flag mismatch at index %d: expected %s, got %s", i, expected.BlockIdFlag, vote.BlockIdFlag)
+		}
+	}
+	return nil
+}
+
+// RandaoSeed returns the concatenated vote extension signatures of the commit
+// votes, and false unless every validator of the commit voted for the block.
+// The signatures are deterministic, so the proposer can't grind the seed by
+// leaving out votes: without all of them, there is no seed.
+func RandaoSeed(commit abci.ExtendedCommitInfo) ([]byte, bool) {
+	if len(commit.Votes) == 0 {
+		return nil, false
+	}
+	var seed []byte
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.ExtensionSignature) == 0 {
+			return nil, false
+		}
+		seed = append(seed, vote.ExtensionSignature...)
+	}
+	return seed, true
+}
+
+// VoteExtensionsEnabled returns true if the vote extensions of the previous
+// block are available at the given height.
+func VoteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -0,0 +1,133 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestRandaoCommitTx(t *testing.T) {
+	commit := abci.ExtendedCommitInfo{
+		Round: 1,
+		Votes: []abci.ExtendedVoteInfo{
+			{BlockIdFlag: cmtproto.BlockIDFlagCommit, ExtensionSignature: []byte{1, 2}},
+			{BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+			{BlockIdFlag: cmtproto.BlockIDFlagCommit, ExtensionSignature: []byte{3}},
+		},
+	}
+
+	tx, err := types.EncodeRandaoCommitTx(commit)
+	require.NoError(t, err)
+
+	decoded, ok, err := types.DecodeRandaoCommitTx(tx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, commit, decoded)
+	// there is no seed unless every validator voted
+	_, ok = types.RandaoSeed(decoded)
+	require.False(t, ok)
+	decoded.Votes[1] = abci.ExtendedVoteInfo{BlockIdFlag: cmtproto.BlockIDFlagCommit, ExtensionSignature: []byte{4}}
+	seed, ok := types.RandaoSeed(decoded)
+	require.True(t, ok)
+	require.Equal(t, []byte{1, 2, 4, 3}, seed)
+
+	_, ok, err = types.DecodeRandaoCommitTx([]byte{0x0a, 0x00})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, ok, err = types.DecodeRandaoCommitTx(append(tx[:len(tx)-1:len(tx)-1], 0xff))
+	require.Error(t, err)
+	require.True(t, ok)
+}
+
+func TestSplitRandaoCommitTx(t *testing.T) {
+	commitTx, err := types.EncodeRandaoCommitTx(abci.ExtendedCommitInfo{})
+	require.NoError(t, err)
+	tx := []byte{0x0a, 0x00}
+
+	got, txs := types.SplitRandaoCommitTx([][]byte{commitTx, tx})
+	require.Equal(t, commitTx, got)
+	require.Equal(t, [][]byte{tx}, txs)
+
+	got, txs = types.SplitRandaoCommitTx([][]byte{tx})
+	require.Nil(t, got)
+	require.Equal(t, [][]byte{tx}, txs)
+
+	got, txs = types.SplitRandaoCommitTx(nil)
+	require.Nil(t, got)
+	require.Empty(t, txs)
+}
+
+func TestValidateRandaoCommit(t *testing.T) {
+	val1 := abci.Validator{Address: []byte{1}, Power: 10}
+	val2 := abci.Validator{Address: []byte{2}, Power: 5}
+	lastCommit := abci.CommitInfo{
+		Round: 1,
+		Votes: []abci.VoteInfo{
+			{Validator: val1, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: val2, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		commit abci.ExtendedCommitInfo
+		expErr bool
+	}{
+		{
+			"matching votes",
+			abci.ExtendedCommitInfo{Round: 1, Votes: []abci.ExtendedVoteInfo{
+				{Validator: val1, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+				{Validator: val2, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			}},
+			false,
+		},
+		{
+			"round mismatch",
+			abci.ExtendedCommitInfo{Round: 2, Votes: []abci.ExtendedVoteInfo{
+				{Validator: val1, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+				{Validator: val2, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			}},
+			true,
+		},
+		{
+			"vote left out",
+			abci.ExtendedCommitInfo{Round: 1, Votes: []abci.ExtendedVoteInfo{
+				{Validator: val1, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			}},
+			true,
+		},
+		{
+			"commit vote marked absent",
+			abci.ExtendedCommitInfo{Round: 1, Votes: []abci.ExtendedVoteInfo{
+				{Validator: val1, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+				{Validator: val2, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+			}},
+			true,
+		},
+		{
+			"validators reordered",
+			abci.ExtendedCommitInfo{Round: 1, Votes: []abci.ExtendedVoteInfo{
+				{Validator: val2, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+				{Validator: val1, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateRandaoCommit(tc.commit, lastCommit)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package types_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func (suite *TxDataTestSuite) signedAuthorization() ethtypes.SetCodeAuthorization {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	auth, err := ethtypes.SignSetCode(key, ethtypes.SetCodeAuthorization{
+		ChainID: *uint256.NewInt(9001),
+		Address: suite.addr,
+		Nonce:   1,
+	})
+	suite.Require().NoError(err)
+	return auth
+}
+
+func (suite *TxDataTestSuite) TestNewSetCodeTx() {
+	auth := suite.signedAuthorization()
+	ethTx := ethtypes.NewTx(&ethtypes.SetCodeTx{
+		ChainID:    uint256.NewInt(9001),
+		Nonce:      1,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(2),
+		Gas:        100000,
+		To:         suite.addr,
+		Value:      uint256.NewInt(1),
+		Data:       []byte("data"),
+		AccessList: ethtypes.AccessList{},
+		AuthList:   []ethtypes.SetCodeAuthorization{auth},
+		V:          uint256.NewInt(1),
+		R:          uint256.NewInt(1),
+		S:          uint256.NewInt(1),
+	})
+
+	txData, err := types.NewTxDataFromTx(ethTx)
+	suite.Require().NoError(err)
+	suite.Require().IsType(&types.SetCodeTx{}, txData)
+	suite.Require().Equal(uint8(ethtypes.SetCodeTxType), txData.TxType())
+
+	// the conversion must be lossless
+	roundTrip := ethtypes.NewTx(txData.AsEthereumData())
+	suite.Require().Equal(ethTx.Hash(), roundTrip.Hash())
+	suite.Require().Equal([]ethtypes.SetCodeAuthorization{auth}, roundTrip.SetCodeAuthorizations())
+
+	authority, err := roundTrip.SetCodeAuthorizations()[0].Authority()
+	suite.Require().NoError(err)
+	expAuthority, err := auth.Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(expAuthority, authority)
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxValidate() {
+	authList := types.NewAuthorizationList([]ethtypes.SetCodeAuthorization{suite.signedAuthorization()})
+
+	testCases := []struct {
+		name     string
+		tx       types.SetCodeTx
+		expPass  bool
+		expError error
+	}{
+		{
+			"pass - valid tx",
+			types.SetCodeTx{
+				ChainID:           &suite.sdkInt,
+				GasTipCap:         &suite.sdkInt,
+				GasFeeCap:         &suite.sdkInt,
+				Amount:            &suite.sdkInt,
+				To:                suite.hexAddr,
+				AuthorizationList: authList,
+			},
+			true,
+			nil,
+		},
+		{
+			"fail - contract creation",
+			types.SetCodeTx{
+				ChainID:           &suite.sdkInt,
+				GasTipCap:         &suite.sdkInt,
+				GasFeeCap:         &suite.sdkInt,
+				Amount:            &suite.sdkInt,
+				AuthorizationList: authList,
+			},
+			false,
+			core.ErrSetCodeTxCreate,
+		},
+		{
+			"fail - empty authorization list",
+			types.SetCodeTx{
+				ChainID:   &suite.sdkInt,
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+			},
+			false,
+			core.ErrEmptyAuthList,
+		},
+		{
+			"fail - invalid authorization address",
+			types.SetCodeTx{
+				ChainID:   &suite.sdkInt,
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				AuthorizationList: types.AuthorizationList{{
+					ChainID: sdkmath.ZeroInt(),
+					Address: suite.invalidAddr,
+				}},
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - negative authorization chain id",
+			types.SetCodeTx{
+				ChainID:   &suite.sdkInt,
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				AuthorizationList: types.AuthorizationList{{
+					ChainID: sdkmath.NewIntFromBigInt(big.NewInt(-1)),
+					Address: suite.hexAddr,
+				}},
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.tx.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			continue
+		}
+
+		suite.Require().Error(err, tc.name)
+		if tc.expError != nil {
+			suite.Require().ErrorIs(err, tc.expError, tc.name)
+		}
+	}
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxCopy() {
+	chainID := sdkmath.NewInt(9001)
+	tx := &types.SetCodeTx{
+		ChainID:           &chainID,
+		To:                suite.hexAddr,
+		AuthorizationList: types.NewAuthorizationList([]ethtypes.SetCodeAuthorization{suite.signedAuthorization()}),
+	}
+
+	cpy, ok := tx.Copy().(*types.SetCodeTx)
+	suite.Require().True(ok)
+	suite.Require().Equal(tx, cpy)
+
+	// mutating the original must not change the copy
+	tx.ChainID.BigIntMut().SetInt64(1)
+	tx.AuthorizationList[0].Nonce = 5
+	tx.AuthorizationList[0].R[0] ^= 0xff
+	suite.Require().Equal(int64(9001), cpy.ChainID.Int64())
+	suite.Require().Equal(uint64(1), cpy.AuthorizationList[0].Nonce)
+	suite.Require().NotEqual(tx.AuthorizationList[0].R, cpy.AuthorizationList[0].R)
+}
+
+func (suite *TxDataTestSuite) TestValidateAuthorization() {
+	auth := suite.signedAuthorization()
+	authority, err := auth.Authority()
+	suite.Require().NoError(err)
+
+	overflowNonce := auth
+	overflowNonce.Nonce = ^uint64(0)
+	invalidSig := auth
+	invalidSig.R = *uint256.NewInt(0)
+
+	testCases := []struct {
+		name    string
+		chainID *big.Int
+		auth    ethtypes.SetCodeAuthorization
+		expErr  error
+	}{
+		{"pass", big.NewInt(9001), auth, nil},
+		{"fail - wrong chain ID", big.NewInt(1), auth, core.ErrAuthorizationWrongChainID},
+		{"fail - nonce overflow", big.NewInt(9001), overflowNonce, core.ErrAuthorizationNonceOverflow},
+		{"fail - invalid signature", big.NewInt(9001), invalidSig, core.ErrAuthorizationInvalidSignature},
+	}
+
+	for _, tc := range testCases {
+		res, err := types.ValidateAuthorization(tc.chainID, tc.auth)
+		if tc.expErr != nil {
+			suite.Require().ErrorIs(err, tc.expErr, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(authority, res, tc.name)
+	}
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single eth_simulateV1 request.
+	MaxSimulateBlocks = 256
+	// SimulateTimestampIncrement is the default number of seconds between the
+	// timestamps of two consecutive simulated blocks.
+	SimulateTimestampIncrement = 1
+
+	// SimErrCodeReverted is the error code of a simulated call that reverted.
+	SimErrCodeReverted = 3
+	// SimErrCodeVMError is the error code of a simulated call that failed with
+	// an EVM error other than a revert.
+	SimErrCodeVMError = -32015
+)
+
+var (
+	// TransferLogAddress is the pseudo-address that emits the logs of native
+	// value transfers, as defined by ERC-7528.
+	TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// TransferTopic is the signature of the ERC-20 Transfer event, used for the
+	// logs of native value transfers.
+	TransferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+)
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	TraceTransfers  bool       `json:"traceTransfers"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially in the same block,
+// on top of the optional block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride    `json:"stateOverrides,omitempty"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is the set of header fields to override for a simulated block.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number,omitempty"`
+	Time          *hexutil.Uint64 `json:"time,omitempty"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit,omitempty"`
+	FeeRecipient  *common.Address `json:"feeRecipient,omitempty"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Hash          common.Hash     `json:"hash"`
+	ParentHash    common.Hash     `json:"parentHash"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Miner         common.Address  `json:"miner"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	Transactions  []common.Hash   `json:"transactions"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a simulated call that failed during execution.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// Validate performs a stateless validation of the simulation inputs.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+	for i, block := range opts.BlockStateCalls {
+		if block.StateOverrides != nil {
+			if err := block.StateOverrides.Validate(); err != nil {
+				return fmt.Errorf("block %d: %w", i, err)
+			}
+		}
+		if overrides := block.BlockOverrides; overrides != nil {
+			if overrides.Number != nil && overrides.Number.ToInt().Sign() < 0 {
+				return fmt.Errorf("block %d: negative block number override", i)
+			}
+			if overrides.BaseFeePerGas != nil && overrides.BaseFeePerGas.ToInt().Sign() < 0 {
+				return fmt.Errorf("block %d: negative base fee override", i)
+			}
+		}
+	}
+	return nil
+}
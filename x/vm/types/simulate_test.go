@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestSimOptsValidate(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	storage := map[common.Hash]common.Hash{{1}: {2}}
+	negative := (*hexutil.Big)(big.NewInt(-1))
+
+	testCases := []struct {
+		name   string
+		opts   types.SimOpts
+		expErr string
+	}{
+		{
+			"fail - empty input",
+			types.SimOpts{},
+			"empty input",
+		},
+		{
+			"pass - single block",
+			types.SimOpts{BlockStateCalls: []types.SimBlock{{}}},
+			"",
+		},
+		{
+			"fail - too many blocks",
+			types.SimOpts{BlockStateCalls: make([]types.SimBlock, types.MaxSimulateBlocks+1)},
+			"too many blocks",
+		},
+		{
+			"fail - invalid state overrides",
+			types.SimOpts{BlockStateCalls: []types.SimBlock{
+				{StateOverrides: &types.StateOverride{addr: {State: &storage, StateDiff: &storage}}},
+			}},
+			"has both 'state' and 'stateDiff'",
+		},
+		{
+			"fail - negative block number",
+			types.SimOpts{BlockStateCalls: []types.SimBlock{
+				{BlockOverrides: &types.BlockOverrides{Number: negative}},
+			}},
+			"negative block number",
+		},
+		{
+			"fail - negative base fee",
+			types.SimOpts{BlockStateCalls: []types.SimBlock{
+				{BlockOverrides: &types.BlockOverrides{BaseFeePerGas: negative}},
+			}},
+			"negative base fee",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestSimOptsJSON(t *testing.T) {
+	input := `{"blockStateCalls":[{"blockOverrides":{"number":"0x10","time":"0x20","feeRecipient":"0x1000000000000000000000000000000000000001"},"calls":[{"to":"0x1000000000000000000000000000000000000001","value":"0x1"}]}],"traceTransfers":true,"validation":true}`
+
+	var opts types.SimOpts
+	require.NoError(t, json.Unmarshal([]byte(input), &opts))
+
+	require.True(t, opts.TraceTransfers)
+	require.True(t, opts.Validation)
+	require.Len(t, opts.BlockStateCalls, 1)
+
+	block := opts.BlockStateCalls[0]
+	require.Equal(t, big.NewInt(16), block.BlockOverrides.Number.ToInt())
+	require.Equal(t, hexutil.Uint64(32), *block.BlockOverrides.Time)
+	require.Equal(t, common.HexToAddress("0x1000000000000000000000000000000000000001"), *block.BlockOverrides.FeeRecipient)
+	require.Nil(t, block.StateOverrides)
+	require.Len(t, block.Calls, 1)
+	require.Equal(t, big.NewInt(1), block.Calls[0].Value.ToInt())
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestStateOverrideValidate(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	storage := map[common.Hash]common.Hash{{1}: {2}}
+	negative := (*hexutil.Big)(big.NewInt(-1))
+	nonce := hexutil.Uint64(1)
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expErr    string
+	}{
+		{
+			"pass - empty overrides",
+			types.StateOverride{},
+			"",
+		},
+		{
+			"pass - nonce and state",
+			types.StateOverride{addr: {Nonce: &nonce, State: &storage}},
+			"",
+		},
+		{
+			"fail - state and stateDiff",
+			types.StateOverride{addr: {State: &storage, StateDiff: &storage}},
+			"has both 'state' and 'stateDiff'",
+		},
+		{
+			"fail - negative balance",
+			types.StateOverride{addr: {Balance: &negative}},
+			"negative balance",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.overrides.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestStateOverrideJSON(t *testing.T) {
+	input := `{"0x1000000000000000000000000000000000000001":{"balance":"0x10","nonce":"0x2","code":"0x6000","stateDiff":{"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000000002"}}}`
+
+	var overrides types.StateOverride
+	require.NoError(t, json.Unmarshal([]byte(input), &overrides))
+
+	account, ok := overrides[common.HexToAddress("0x1000000000000000000000000000000000000001")]
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(16), (*account.Balance).ToInt())
+	require.Equal(t, hexutil.Uint64(2), *account.Nonce)
+	require.Equal(t, hexutil.Bytes{0x60, 0x00}, *account.Code)
+	require.Nil(t, account.State)
+	require.Equal(t, common.BigToHash(big.NewInt(2)), (*account.StateDiff)[common.BigToHash(big.NewInt(1))])
+}
@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	errorsmod "cosmossdk.io/errors"
 )
@@ -65,3 +67,30 @@ func NewState(key, value common.Hash) State {
 		Value: value.String(),
 	}
 }
+
+// UpdateStorageHash returns the storage hash of an account after the value of
+// one of its slots changed from oldValue to newValue.
+//
+// The storage hash commits to the set of the non-empty slots of an account as
+// the sum modulo 2^256 of keccak256(key || value) over the slots. Unlike a trie
+// root, it can be updated on each write without iterating over the storage,
+// and it is recomputed from a full storage dump by adding up its slots. An
+// empty storage has a zero storage hash.
+func UpdateStorageHash(storageHash, key, oldValue, newValue common.Hash) common.Hash {
+	sum := storageHash.Big()
+	if oldValue != (common.Hash{}) {
+		sum.Sub(sum, storageSlotHash(key, oldValue))
+	}
+	if newValue != (common.Hash{}) {
+		sum.Add(sum, storageSlotHash(key, newValue))
+	}
+	return common.BigToHash(sum.Mod(sum, storageHashModulus))
+}
+
+// storageHashModulus is the modulus of the storage hash sums, 2^256.
+var storageHashModulus = new(big.Int).Lsh(big.NewInt(1), 256)
+
+// storageSlotHash returns the term of a slot in the storage hash sum.
+func storageSlotHash(key, value common.Hash) *big.Int {
+	return crypto.Keccak256Hash(key.Bytes(), value.Bytes()).Big()
+}
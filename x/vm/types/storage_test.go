@@ -80,3 +80,26 @@ func TestStorageString(t *testing.T) {
 	str := "key:\"0x00000000000000000000000000000000000000000000000000000000006b6579\" value:\"0x00000000000000000000000000000000000000000000000000000076616c7565\" \n"
 	require.Equal(t, str, storage.String())
 }
+
+func TestUpdateStorageHash(t *testing.T) {
+	key1, key2 := common.HexToHash("0x1"), common.HexToHash("0x2")
+	value1, value2 := common.HexToHash("0x2a"), common.HexToHash("0x2b")
+
+	// the storage hash doesn't depend on the order of the writes
+	hash := UpdateStorageHash(common.Hash{}, key1, common.Hash{}, value1)
+	hash = UpdateStorageHash(hash, key2, common.Hash{}, value2)
+	reordered := UpdateStorageHash(common.Hash{}, key2, common.Hash{}, value2)
+	reordered = UpdateStorageHash(reordered, key1, common.Hash{}, value1)
+	require.Equal(t, hash, reordered)
+	require.NotEqual(t, common.Hash{}, hash)
+
+	// it commits to the slot values
+	updated := UpdateStorageHash(hash, key1, value1, value2)
+	require.NotEqual(t, hash, updated)
+	require.Equal(t, hash, UpdateStorageHash(updated, key1, value2, value1))
+
+	// clearing all the slots gives back an empty storage hash
+	hash = UpdateStorageHash(hash, key1, value1, common.Hash{})
+	hash = UpdateStorageHash(hash, key2, value2, common.Hash{})
+	require.Equal(t, common.Hash{}, hash)
+}
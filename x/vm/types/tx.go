@@ -10,7 +10,7 @@ import (
 )
 
 // EvmTxArgs encapsulates all possible params to create all EVM txs types.
-// This includes LegacyTx, DynamicFeeTx and AccessListTx
+// This includes LegacyTx, DynamicFeeTx, AccessListTx and SetCodeTx
 type EvmTxArgs struct {
 	Nonce     uint64
 	GasLimit  uint64
@@ -22,6 +22,8 @@ type EvmTxArgs struct {
 	GasTipCap *big.Int
 	To        *common.Address
 	Accesses  *ethtypes.AccessList
+	// AuthorizationList is only used by SetCodeTx (EIP-7702)
+	AuthorizationList []ethtypes.SetCodeAuthorization
 }
 
 // ToTxData converts the EvmTxArgs to TxData
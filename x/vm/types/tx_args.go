@@ -111,6 +111,24 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 
 	var data TxData
 	switch {
+	case args.AuthorizationList != nil:
+		al := AccessList{}
+		if args.AccessList != nil {
+			al = NewAccessList(args.AccessList)
+		}
+
+		data = &SetCodeTx{
+			To:                to,
+			ChainID:           &chainID,
+			Nonce:             nonce,
+			GasLimit:          gas,
+			GasFeeCap:         &maxFeePerGas,
+			GasTipCap:         &maxPriorityFeePerGas,
+			Amount:            &value,
+			Data:              args.GetData(),
+			Accesses:          al,
+			AuthorizationList: NewAuthorizationList(args.AuthorizationList),
+		}
 	case args.MaxFeePerGas != nil:
 		al := AccessList{}
 		if args.AccessList != nil {
@@ -11,6 +11,7 @@ var (
 	_ TxData = &LegacyTx{}
 	_ TxData = &AccessListTx{}
 	_ TxData = &DynamicFeeTx{}
+	_ TxData = &SetCodeTx{}
 )
 
 // TxData implements the Ethereum transaction tx structure. It is used
@@ -58,6 +59,8 @@ func NewTxDataFromTx(tx *ethtypes.Transaction) (TxData, error) {
 	var txData TxData
 	var err error
 	switch tx.Type() {
+	case ethtypes.SetCodeTxType:
+		txData, err = NewSetCodeTx(tx)
 	case ethtypes.DynamicFeeTxType:
 		txData, err = NewDynamicFeeTx(tx)
 	case ethtypes.AccessListTxType:
@@ -6,6 +6,8 @@ import (
 
 func GetTxTypeName(txType int) string {
 	switch txType {
+	case gethtypes.SetCodeTxType:
+		return "SetCodeTxType"
 	case gethtypes.DynamicFeeTxType:
 		return "DynamicFeeTxType"
 	case gethtypes.LegacyTxType:
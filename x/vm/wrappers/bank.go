@@ -19,6 +19,9 @@ var _ types.BankWrapper = BankWrapper{}
 // that is used to manage an evm denom with a custom decimal representation.
 type BankWrapper struct {
 	types.BankKeeper
+
+	// coinInfo is the EVM coin of the app, the one set on startup if nil
+	coinInfo *types.EvmCoinInfo
 }
 
 // NewBankWrapper creates a new BankWrapper instance.
@@ -26,8 +29,23 @@ func NewBankWrapper(
 	bk types.BankKeeper,
 ) *BankWrapper {
 	return &BankWrapper{
-		bk,
+		BankKeeper: bk,
+	}
+}
+
+// WithEVMCoinInfo sets the EVM coin of the app, which defaults to the one set on
+// startup through the EVMConfigurator.
+func (w *BankWrapper) WithEVMCoinInfo(coinInfo types.EvmCoinInfo) *BankWrapper {
+	w.coinInfo = &coinInfo
+	return w
+}
+
+// evmCoinInfo returns the EVM coin of the app.
+func (w BankWrapper) evmCoinInfo() types.EvmCoinInfo {
+	if w.coinInfo == nil {
+		return types.GetEVMCoinInfo()
 	}
+	return *w.coinInfo
 }
 
 // ------------------------------------------------------------------------------------------
@@ -37,9 +55,10 @@ func NewBankWrapper(
 // MintAmountToAccount converts the given amount into the evm coin scaling
 // the amount to the original decimals, then mints that amount to the provided account.
 func (w BankWrapper) MintAmountToAccount(ctx context.Context, recipientAddr sdk.AccAddress, amt *big.Int) error {
-	coin := sdk.Coin{Denom: types.GetEVMCoinDenom(), Amount: sdkmath.NewIntFromBigInt(amt)}
+	coinInfo := w.evmCoinInfo()
+	coin := sdk.Coin{Denom: coinInfo.Denom, Amount: sdkmath.NewIntFromBigInt(amt)}
 
-	convertedCoin, err := types.ConvertEvmCoinDenomToExtendedDenom(coin)
+	convertedCoin, err := coinInfo.ConvertEvmCoinDenomToExtendedDenom(coin)
 	if err != nil {
 		return errors.Wrap(err, "failed to mint coin to account in bank wrapper")
 	}
@@ -55,9 +74,10 @@ func (w BankWrapper) MintAmountToAccount(ctx context.Context, recipientAddr sdk.
 // BurnAmountFromAccount converts the given amount into the evm coin scaling
 // the amount to the original decimals, then burns that quantity from the provided account.
 func (w BankWrapper) BurnAmountFromAccount(ctx context.Context, account sdk.AccAddress, amt *big.Int) error {
-	coin := sdk.Coin{Denom: types.GetEVMCoinDenom(), Amount: sdkmath.NewIntFromBigInt(amt)}
+	coinInfo := w.evmCoinInfo()
+	coin := sdk.Coin{Denom: coinInfo.Denom, Amount: sdkmath.NewIntFromBigInt(amt)}
 
-	convertedCoin, err := types.ConvertEvmCoinDenomToExtendedDenom(coin)
+	convertedCoin, err := coinInfo.ConvertEvmCoinDenomToExtendedDenom(coin)
 	if err != nil {
 		return errors.Wrap(err, "failed to burn coins from account in bank wrapper")
 	}
@@ -75,18 +95,19 @@ func (w BankWrapper) BurnAmountFromAccount(ctx context.Context, account sdk.AccA
 
 // GetBalance returns the balance of the given account.
 func (w BankWrapper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
-	if denom != types.GetEVMCoinDenom() {
-		panic(fmt.Sprintf("expected evm denom %s, received %s", types.GetEVMCoinDenom(), denom))
+	coinInfo := w.evmCoinInfo()
+	if denom != coinInfo.Denom {
+		panic(fmt.Sprintf("expected evm denom %s, received %s", coinInfo.Denom, denom))
 	}
 
-	return w.BankKeeper.GetBalance(ctx, addr, types.GetEVMCoinExtendedDenom())
+	return w.BankKeeper.GetBalance(ctx, addr, coinInfo.ExtendedDenom)
 }
 
 // SendCoinsFromAccountToModule wraps around the Cosmos SDK x/bank module's
 // SendCoinsFromAccountToModule method to convert the evm coin, if present in
 // the input, to its original representation.
 func (w BankWrapper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, coins sdk.Coins) error {
-	convertedCoins := types.ConvertCoinsDenomToExtendedDenom(coins)
+	convertedCoins := w.evmCoinInfo().ConvertCoinsDenomToExtendedDenom(coins)
 	if convertedCoins.IsZero() {
 		// if after scaling the coins the amt is zero
 		// then is a no-op.
@@ -102,7 +123,7 @@ func (w BankWrapper) SendCoinsFromAccountToModule(ctx context.Context, senderAdd
 // SendCoinsFromModuleToAccount method to convert the evm coin, if present in
 // the input, to its original representation.
 func (w BankWrapper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, coins sdk.Coins) error {
-	convertedCoins := types.ConvertCoinsDenomToExtendedDenom(coins)
+	convertedCoins := w.evmCoinInfo().ConvertCoinsDenomToExtendedDenom(coins)
 	if convertedCoins.IsZero() {
 		return nil
 	}
@@ -17,6 +17,9 @@ import (
 //     with the bank module decimals (either 6 or 18).
 type FeeMarketWrapper struct {
 	types.FeeMarketKeeper
+
+	// coinInfo is the EVM coin of the app, the one set on startup if nil
+	coinInfo *types.EvmCoinInfo
 }
 
 // NewFeeMarketWrapper creates a new feemarket Keeper wrapper instance.
@@ -25,8 +28,23 @@ func NewFeeMarketWrapper(
 	fk types.FeeMarketKeeper,
 ) *FeeMarketWrapper {
 	return &FeeMarketWrapper{
-		fk,
+		FeeMarketKeeper: fk,
+	}
+}
+
+// WithEVMCoinInfo sets the EVM coin of the app, which defaults to the one set on
+// startup through the EVMConfigurator.
+func (w *FeeMarketWrapper) WithEVMCoinInfo(coinInfo types.EvmCoinInfo) *FeeMarketWrapper {
+	w.coinInfo = &coinInfo
+	return w
+}
+
+// evmCoinInfo returns the EVM coin of the app.
+func (w FeeMarketWrapper) evmCoinInfo() types.EvmCoinInfo {
+	if w.coinInfo == nil {
+		return types.GetEVMCoinInfo()
 	}
+	return *w.coinInfo
 }
 
 // GetBaseFee returns the base fee converted to 18 decimals.
@@ -35,7 +53,7 @@ func (w FeeMarketWrapper) GetBaseFee(ctx sdk.Context) *big.Int {
 	if baseFee.IsNil() {
 		return nil
 	}
-	return types.ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
+	return w.evmCoinInfo().ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
 }
 
 // CalculateBaseFee returns the calculated base fee converted to 18 decimals.
@@ -44,15 +62,15 @@ func (w FeeMarketWrapper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	if baseFee.IsNil() {
 		return nil
 	}
-	return types.ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
+	return w.evmCoinInfo().ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
 }
 
 // GetParams returns the params with associated fees values converted to 18 decimals.
 func (w FeeMarketWrapper) GetParams(ctx sdk.Context) feemarkettypes.Params {
 	params := w.FeeMarketKeeper.GetParams(ctx)
 	if !params.BaseFee.IsNil() {
-		params.BaseFee = types.ConvertAmountTo18DecimalsLegacy(params.BaseFee)
+		params.BaseFee = w.evmCoinInfo().ConvertAmountTo18DecimalsLegacy(params.BaseFee)
 	}
-	params.MinGasPrice = types.ConvertAmountTo18DecimalsLegacy(params.MinGasPrice)
+	params.MinGasPrice = w.evmCoinInfo().ConvertAmountTo18DecimalsLegacy(params.MinGasPrice)
 	return params
 }
@@ -107,6 +107,27 @@ func TestGetBaseFee(t *testing.T) {
 	}
 }
 
+func TestGetBaseFeeWithEVMCoinInfo(t *testing.T) {
+	// Configure the process globals with a different EVM coin than the one of
+	// the wrapper, which must take precedence.
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	err := configurator.WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]).Configure()
+	require.NoError(t, err, "failed to configure EVMConfigurator")
+
+	ctrl := gomock.NewController(t)
+	mockFeeMarketKeeper := testutil.NewMockFeeMarketKeeper(ctrl)
+	mockFeeMarketKeeper.EXPECT().
+		GetBaseFee(gomock.Any()).
+		Return(sdkmath.LegacyNewDec(1_000_000))
+
+	feeMarketWrapper := wrappers.NewFeeMarketWrapper(mockFeeMarketKeeper).
+		WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID])
+	result := feeMarketWrapper.GetBaseFee(sdk.Context{})
+
+	require.Equal(t, big.NewInt(1e18), result) // 1 token in 18 decimals
+}
+
 func TestCalculateBaseFee(t *testing.T) {
 	testCases := []struct {
 		name      string